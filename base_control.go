@@ -19,6 +19,26 @@ type BaseControlInstanceType struct {
 	controlType  string
 }
 
+/*
+ControlInstanceType is a type which represents any control instance, so that it can be given focus or positioned by a
+layout container.
+*/
+type ControlInstanceType interface {
+	getBaseControlInstance() *BaseControlInstanceType
+}
+
+/*
+getBaseControlInstance is a method which allows you to obtain the base control instance of a control, so that any
+control instance can be used wherever a ControlInstanceType is expected.
+
+Example:
+
+	baseControlInstance := control.getBaseControlInstance()
+*/
+func (shared *BaseControlInstanceType) getBaseControlInstance() *BaseControlInstanceType {
+	return shared
+}
+
 /*
 GetAlias is a method which allows you to obtain the alias associated with the control.
 
@@ -42,7 +62,10 @@ func (shared *BaseControlInstanceType) GetLayerAlias() string {
 }
 
 /*
-getBaseControl is a method which allows you to retrieve the underlying base control type for the control instance.
+getBaseControl is a method which allows you to retrieve the underlying base control type for the control instance. In
+addition, the following should be noted:
+
+- If the control no longer exists, nil is returned.
 
 Example:
 
@@ -51,44 +74,72 @@ Example:
 func (shared *BaseControlInstanceType) getBaseControl() *types.BaseControlType {
 	switch shared.controlType {
 	case constants.TYPE_BUTTON:
-		if entry := Buttons.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Buttons.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Buttons.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_CHECKBOX:
-		if entry := Checkboxes.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Checkboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Checkboxes.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_DROPDOWN:
-		if entry := Dropdowns.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Dropdowns.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Dropdowns.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_LABEL:
-		if entry := Labels.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Labels.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Labels.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_PROGRESSBAR:
-		if entry := ProgressBars.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if ProgressBars.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &ProgressBars.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_RADIOBUTTON:
+		if RadioButtons.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &RadioButtons.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_SCROLLBAR:
-		if entry := ScrollBars.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if ScrollBars.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &ScrollBars.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_SELECTOR:
-		if entry := Selectors.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Selectors.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Selectors.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_SLIDER:
+		if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Sliders.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_SPINNER:
+		if Spinners.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Spinners.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_TEXTBOX:
-		if entry := Textboxes.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Textboxes.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_TEXTFIELD:
-		if entry := TextFields.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &TextFields.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_TREEVIEW:
+		if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &TreeViews.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_TABLE:
+		if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Tables.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_TABCONTROL:
+		if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &TabControls.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	case constants.TYPE_TOOLTIP:
-		if entry := Tooltips.Get(shared.layerAlias, shared.controlAlias); entry != nil {
-			return &entry.BaseControlType
+		if Tooltips.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Tooltips.Get(shared.layerAlias, shared.controlAlias).BaseControlType
+		}
+	case constants.TYPE_VIEWPORT:
+		if Viewports.IsExists(shared.layerAlias, shared.controlAlias) {
+			return &Viewports.Get(shared.layerAlias, shared.controlAlias).BaseControlType
 		}
 	}
 	return nil
@@ -109,7 +160,10 @@ func (shared *BaseControlInstanceType) GetBounds() (int, int, int, int) {
 }
 
 /*
-SetPosition is a method which allows you to set the X and Y coordinates of the control.
+SetPosition is a method which allows you to set the X and Y coordinates of the control. In addition, the following
+should be noted:
+
+- Controls embedded in the control, such as the scroll bars of a textbox, are moved along with it.
 
 Example:
 
@@ -119,6 +173,7 @@ func (shared *BaseControlInstanceType) SetPosition(x, y int) *BaseControlInstanc
 	if control := shared.getBaseControl(); control != nil {
 		control.XLocation = x
 		control.YLocation = y
+		shared.updateEmbeddedControlBounds()
 	}
 	return shared
 }
@@ -152,7 +207,10 @@ func (shared *BaseControlInstanceType) GetSize() (int, int) {
 }
 
 /*
-SetSize is a method which allows you to set the width and height of the control.
+SetSize is a method which allows you to set the width and height of the control. In addition, the following should be
+noted:
+
+- Controls embedded in the control, such as the scroll bars of a textbox, are moved and resized along with it.
 
 Example:
 
@@ -162,10 +220,34 @@ func (shared *BaseControlInstanceType) SetSize(width, height int) *BaseControlIn
 	if control := shared.getBaseControl(); control != nil {
 		control.Width = width
 		control.Height = height
+		shared.updateEmbeddedControlBounds()
 	}
 	return shared
 }
 
+/*
+updateEmbeddedControlBounds is a method which allows you to move and resize the controls embedded in a control, such
+as its scroll bars, so that they follow the control after it has been repositioned or resized. Controls which have
+nothing embedded in them are left unchanged.
+
+Example:
+
+	control.updateEmbeddedControlBounds()
+*/
+func (shared *BaseControlInstanceType) updateEmbeddedControlBounds() {
+	switch shared.controlType {
+	case constants.TYPE_TEXTBOX:
+		textbox.updateScrollbarBounds(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_SELECTOR:
+		Selector.updateScrollbarBounds(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_DROPDOWN:
+		Dropdown.updateTrayBounds(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_VIEWPORT:
+		viewport.updateScrollbarBounds(shared.layerAlias, shared.controlAlias)
+		viewport.setViewportMaxScrollBarValues(shared.layerAlias, shared.controlAlias)
+	}
+}
+
 /*
 SetVisible is a method which allows you to toggle the visibility of the control.
 
@@ -375,6 +457,9 @@ Example:
 	control.Delete()
 */
 func (shared *BaseControlInstanceType) Delete() *BaseControlInstanceType {
+	deleteEventHandlers(shared.layerAlias, shared.controlAlias)
+	deleteContextMenu(shared.layerAlias, shared.controlAlias)
+	shortcutRegistry.deleteAll(shared.layerAlias, shared.controlAlias)
 	switch shared.controlType {
 	case constants.TYPE_BUTTON:
 		if Buttons.IsExists(shared.layerAlias, shared.controlAlias) {
@@ -404,14 +489,29 @@ func (shared *BaseControlInstanceType) Delete() *BaseControlInstanceType {
 		if Selectors.IsExists(shared.layerAlias, shared.controlAlias) {
 			Selectors.Remove(shared.layerAlias, shared.controlAlias)
 		}
+	case constants.TYPE_SLIDER:
+		Slider.Delete(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_SPINNER:
+		Spinner.Delete(shared.layerAlias, shared.controlAlias)
 	case constants.TYPE_TEXTBOX:
 		if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
 			Textboxes.Remove(shared.layerAlias, shared.controlAlias)
 		}
+		deleteTextboxSyntax(shared.layerAlias, shared.controlAlias)
 	case constants.TYPE_TEXTFIELD:
+		deleteTextFieldSuggestions(shared.layerAlias, shared.controlAlias)
 		if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
 			TextFields.Remove(shared.layerAlias, shared.controlAlias)
 		}
+		deleteTextFieldValidator(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_TREEVIEW:
+		TreeView.Delete(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_TABLE:
+		Table.Delete(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_TABCONTROL:
+		TabControl.Delete(shared.layerAlias, shared.controlAlias)
+	case constants.TYPE_FILEMENU:
+		FileMenu.Delete(shared.layerAlias, shared.controlAlias)
 	case constants.TYPE_TOOLTIP:
 		if Tooltips.IsExists(shared.layerAlias, shared.controlAlias) {
 			Tooltips.Remove(shared.layerAlias, shared.controlAlias)
@@ -429,13 +529,37 @@ func (shared *BaseControlInstanceType) Delete() *BaseControlInstanceType {
 }
 
 /*
-GetFocus is a method which updates the event manager to set this control as the one currently in focus.
+GetFocus is a method which updates the event manager to set this control as the one currently in focus. In addition,
+the following should be noted:
+
+- If focus moves to this control, the blur and focus handlers are called as if the user had changed focus.
 
 Example:
 
 	control.GetFocus()
 */
 func (shared *BaseControlInstanceType) GetFocus() *BaseControlInstanceType {
+	focusedControl := shared.getFocusIdentifier()
+	previouslyFocusedControl := eventStateMemory.currentlyFocusedControl
+	setFocusedControl(focusedControl.layerAlias, focusedControl.controlAlias, focusedControl.controlType)
+	fireFocusHandlers(previouslyFocusedControl, eventStateMemory.currentlyFocusedControl)
+	return shared
+}
+
+/*
+getFocusIdentifier is a method which allows you to obtain the identifier used by the event manager when the control
+has focus. In addition, the following should be noted:
+
+- A spinner is focused through the text field used to edit its value.
+
+- A viewport is focused as a textbox, since it is drawn with textbox cells.
+
+Example:
+
+	focusedControl := control.getFocusIdentifier()
+*/
+func (shared *BaseControlInstanceType) getFocusIdentifier() controlIdentifierType {
+	controlAlias := shared.controlAlias
 	controlTypeInt := constants.NullControlType
 	switch shared.controlType {
 	case constants.TYPE_BUTTON:
@@ -452,15 +576,32 @@ func (shared *BaseControlInstanceType) GetFocus() *BaseControlInstanceType {
 		controlTypeInt = constants.CellTypeScrollbar
 	case constants.TYPE_SELECTOR:
 		controlTypeInt = constants.CellTypeSelectorItem
+	case constants.TYPE_SLIDER:
+		controlTypeInt = constants.CellTypeSlider
+	case constants.TYPE_SPINNER:
+		// A spinner is focused through the text field used to edit its value.
+		if Spinners.IsExists(shared.layerAlias, shared.controlAlias) {
+			controlAlias = Spinners.Get(shared.layerAlias, shared.controlAlias).TextFieldAlias
+			controlTypeInt = constants.CellTypeTextField
+		}
 	case constants.TYPE_TEXTBOX:
 		controlTypeInt = constants.CellTypeTextbox
 	case constants.TYPE_TEXTFIELD:
 		controlTypeInt = constants.CellTypeTextField
+	case constants.TYPE_TREEVIEW:
+		controlTypeInt = constants.CellTypeTreeView
+	case constants.TYPE_TABLE:
+		controlTypeInt = constants.CellTypeTable
+	case constants.TYPE_TABCONTROL:
+		controlTypeInt = constants.CellTypeTabControl
 	case constants.TYPE_TOOLTIP:
 		controlTypeInt = constants.CellTypeTooltip
 	case constants.TYPE_RADIOBUTTON:
 		controlTypeInt = constants.CellTypeRadioButton
+	case constants.TYPE_VIEWPORT:
+		controlTypeInt = constants.CellTypeTextbox
+	case constants.TYPE_FILEMENU:
+		controlTypeInt = constants.CellTypeFileMenuHeading
 	}
-	setFocusedControl(shared.layerAlias, shared.controlAlias, controlTypeInt)
-	return shared
+	return controlIdentifierType{layerAlias: shared.layerAlias, controlAlias: controlAlias, controlType: controlTypeInt}
 }
@@ -350,6 +350,7 @@ func (shared *buttonType) updateStateMouse() bool {
 			buttonEntry.IsPressed = true
 			buttonEntry.Mutex.Unlock()
 			setFocusedControl(layerAlias, buttonAlias, constants.CellTypeButton)
+			firePressHandler(layerAlias, buttonAlias)
 			isUpdateRequired = true
 		}
 	}
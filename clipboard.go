@@ -0,0 +1,221 @@
+package consolizer
+
+import (
+	"github.com/atotto/clipboard"
+	"sync"
+)
+
+/*
+ClipboardProvider is an interface which allows text controls to copy to and paste from a clipboard. Any type which
+implements it can be installed with SetClipboardProvider, which allows the clipboard to be replaced for testing, or
+with one suited to the environment the application runs in.
+*/
+type ClipboardProvider interface {
+	// ReadText returns the text currently held by the clipboard.
+	ReadText() (string, error)
+	// WriteText replaces the text held by the clipboard.
+	WriteText(text string) error
+}
+
+/*
+SystemClipboardProviderType is a clipboard provider which uses the clipboard of the operating system. On Linux, this
+requires a clipboard utility such as xclip, xsel, or wl-clipboard to be installed.
+*/
+type SystemClipboardProviderType struct{}
+
+/*
+MemoryClipboardProviderType is a clipboard provider which holds its text in memory, so that it is only shared between
+controls of the same application. It is used when no other clipboard is available.
+*/
+type MemoryClipboardProviderType struct {
+	mutex sync.Mutex
+	text  string
+}
+
+/*
+Osc52ClipboardProviderType is a clipboard provider which copies text to the clipboard of the terminal emulator using
+the OSC 52 escape sequence. This allows copying to the local clipboard from an application running over SSH. In
+addition, the following should be noted:
+
+  - Most terminal emulators do not allow applications to read their clipboard, so pasting returns the text last
+    copied by the application instead. Text pasted from outside the application still arrives as a bracketed paste.
+*/
+type Osc52ClipboardProviderType struct {
+	MemoryClipboardProviderType
+}
+
+/*
+clipboardMemory is a variable which holds the clipboard provider currently used by text controls.
+*/
+var clipboardMemory struct {
+	mutex    sync.Mutex
+	provider ClipboardProvider
+}
+
+/*
+NewSystemClipboardProvider is a constructor which allows you to create a clipboard provider which uses the clipboard
+of the operating system.
+
+Example:
+
+	SetClipboardProvider(NewSystemClipboardProvider())
+*/
+func NewSystemClipboardProvider() *SystemClipboardProviderType {
+	return &SystemClipboardProviderType{}
+}
+
+/*
+NewMemoryClipboardProvider is a constructor which allows you to create a clipboard provider which only shares text
+between controls of the same application.
+
+Example:
+
+	SetClipboardProvider(NewMemoryClipboardProvider())
+*/
+func NewMemoryClipboardProvider() *MemoryClipboardProviderType {
+	return &MemoryClipboardProviderType{}
+}
+
+/*
+NewOsc52ClipboardProvider is a constructor which allows you to create a clipboard provider which copies text to the
+clipboard of the terminal emulator, which is useful for applications used over SSH.
+
+Example:
+
+	SetClipboardProvider(NewOsc52ClipboardProvider())
+*/
+func NewOsc52ClipboardProvider() *Osc52ClipboardProviderType {
+	return &Osc52ClipboardProviderType{}
+}
+
+/*
+ReadText is a method which allows you to obtain the text held by the clipboard of the operating system.
+
+Example:
+
+	text, err := provider.ReadText()
+*/
+func (shared *SystemClipboardProviderType) ReadText() (string, error) {
+	return clipboard.ReadAll()
+}
+
+/*
+WriteText is a method which allows you to replace the text held by the clipboard of the operating system.
+
+Example:
+
+	err := provider.WriteText("Hello")
+*/
+func (shared *SystemClipboardProviderType) WriteText(text string) error {
+	return clipboard.WriteAll(text)
+}
+
+/*
+ReadText is a method which allows you to obtain the text last written to the clipboard provider.
+
+Example:
+
+	text, err := provider.ReadText()
+*/
+func (shared *MemoryClipboardProviderType) ReadText() (string, error) {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+	return shared.text, nil
+}
+
+/*
+WriteText is a method which allows you to replace the text held by the clipboard provider.
+
+Example:
+
+	err := provider.WriteText("Hello")
+*/
+func (shared *MemoryClipboardProviderType) WriteText(text string) error {
+	shared.mutex.Lock()
+	shared.text = text
+	shared.mutex.Unlock()
+	return nil
+}
+
+/*
+WriteText is a method which allows you to copy text to the clipboard of the terminal emulator. The text is also kept
+in memory, so that it can be pasted back into the application. If no terminal screen is active, the text is only
+kept in memory.
+
+Example:
+
+	err := provider.WriteText("Hello")
+*/
+func (shared *Osc52ClipboardProviderType) WriteText(text string) error {
+	if commonResource.screen != nil {
+		commonResource.screen.SetClipboard([]byte(text))
+	}
+	return shared.MemoryClipboardProviderType.WriteText(text)
+}
+
+/*
+SetClipboardProvider is a method which allows you to change the clipboard used by text controls when copying, cutting,
+and pasting. In addition, the following should be noted:
+
+  - By default, the clipboard of the operating system is used. If no clipboard utility can be found, an in-process
+    clipboard is used instead.
+
+- Passing nil restores the default clipboard provider.
+
+Example:
+
+	SetClipboardProvider(NewOsc52ClipboardProvider())
+*/
+func SetClipboardProvider(provider ClipboardProvider) {
+	clipboardMemory.mutex.Lock()
+	clipboardMemory.provider = provider
+	clipboardMemory.mutex.Unlock()
+}
+
+/*
+GetClipboardProvider is a method which allows you to obtain the clipboard provider currently used by text controls.
+
+Example:
+
+	provider := GetClipboardProvider()
+*/
+func GetClipboardProvider() ClipboardProvider {
+	clipboardMemory.mutex.Lock()
+	defer clipboardMemory.mutex.Unlock()
+	if clipboardMemory.provider == nil {
+		if clipboard.Unsupported {
+			clipboardMemory.provider = NewMemoryClipboardProvider()
+		} else {
+			clipboardMemory.provider = NewSystemClipboardProvider()
+		}
+	}
+	return clipboardMemory.provider
+}
+
+/*
+getClipboardText is a method which allows you to obtain the text held by the current clipboard provider. If the
+clipboard can not be read, an empty string is returned, so that pasting simply does nothing.
+
+Example:
+
+	text := getClipboardText()
+*/
+func getClipboardText() string {
+	text, err := GetClipboardProvider().ReadText()
+	if err != nil {
+		return ""
+	}
+	return text
+}
+
+/*
+setClipboardText is a method which allows you to replace the text held by the current clipboard provider. Errors are
+ignored, since a failed copy should never interrupt the user.
+
+Example:
+
+	setClipboardText("Hello")
+*/
+func setClipboardText(text string) {
+	_ = GetClipboardProvider().WriteText(text)
+}
@@ -0,0 +1,116 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+/*
+TestClipboardProviders is a test which verifies that text controls copy, cut, and paste through the clipboard
+provider installed.
+
+Example:
+
+	Expected Inputs:
+	    A text field and a textbox which copy, cut, and paste using an in-process clipboard provider and an OSC 52
+	    clipboard provider.
+
+	Expected Outputs:
+	    Highlighted text is written to the provider, cutting removes it, pasting inserts the text of the provider as a
+	    single edit, and the OSC 52 provider also sends copied text to the terminal.
+*/
+func TestClipboardProviders(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(30, 10)
+	defer SetClipboardProvider(nil)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 30, 10, 1, nil)
+	textField := layer1.AddTextField(styleEntry, 0, 0, 20, 20, false, "hello world", true)
+	textboxInstance := layer1.AddTextbox(styleEntry, 0, 2, 20, 6, false)
+	textboxInstance.SetText("one")
+	memoryProvider := NewMemoryClipboardProvider()
+	SetClipboardProvider(memoryProvider)
+	assert.Equalf(test, ClipboardProvider(memoryProvider), GetClipboardProvider(), "The clipboard provider installed was not used!")
+
+	textFieldEntry := TextFields.Get(layer1.layerAlias, textField.controlAlias)
+	textFieldEntry.CursorPosition = 0
+	textFieldEntry.HighlightStart = 0
+	textFieldEntry.HighlightEnd = 4
+	textFieldEntry.IsHighlightActive = true
+	textField.Copy()
+	clipboardText, _ := memoryProvider.ReadText()
+	assert.Equalf(test, "hello", clipboardText, "Copying did not write the highlighted text to the clipboard provider!")
+	textField.Cut()
+	assert.Equalf(test, " world", textField.GetValue(), "Cutting did not remove the highlighted text!")
+	memoryProvider.WriteText("big\nignored")
+	textField.Paste()
+	assert.Equalf(test, "big world", textField.GetValue(), "Pasting into a text field did not insert the first line of the clipboard!")
+	textField.Undo()
+	assert.Equalf(test, " world", textField.GetValue(), "The paste was not undone all at once!")
+
+	memoryProvider.WriteText("a\r\nb")
+	textboxInstance.Paste()
+	assert.Equalf(test, "a \nb \none", textboxInstance.GetText(), "Pasting into a textbox did not insert every line of the clipboard!")
+	textboxInstance.Undo()
+	assert.Equalf(test, " \none", textboxInstance.GetText(), "The paste was not undone all at once!")
+
+	osc52Provider := NewOsc52ClipboardProvider()
+	SetClipboardProvider(osc52Provider)
+	textFieldEntry.HighlightStart = 1
+	textFieldEntry.HighlightEnd = 5
+	textFieldEntry.IsHighlightActive = true
+	textField.Copy()
+	assert.Equalf(test, "world", string(screen.GetClipboardData()), "The OSC 52 provider did not send the copied text to the terminal!")
+	clipboardText, _ = osc52Provider.ReadText()
+	assert.Equalf(test, "world", clipboardText, "The OSC 52 provider did not keep the copied text for pasting!")
+	SetClipboardProvider(nil)
+	assert.NotNilf(test, GetClipboardProvider(), "No default clipboard provider was chosen!")
+}
+
+/*
+TestClipboardBracketedPaste is a test which verifies that text received as a bracketed paste is inserted all at once.
+
+Example:
+
+	Expected Inputs:
+	    Bracketed pastes holding several lines, dispatched while a textbox, a text field, and no text control have focus.
+
+	Expected Outputs:
+	    The textbox receives every line and undoes the paste in one step, a disabled textbox is left unchanged, the
+	    text field receives the first line, and without a text control the text is added to the keyboard buffer as
+	    if it had been typed.
+*/
+func TestClipboardBracketedPaste(test *testing.T) {
+	InitializeTerminalWithSimulationScreen(30, 10)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 30, 10, 1, nil)
+	textField := layer1.AddTextField(styleEntry, 0, 0, 20, 20, false, "", true)
+	textboxInstance := layer1.AddTextbox(styleEntry, 0, 2, 20, 6, false)
+	dispatchBracketedPaste := func() {
+		dispatchEvent(tcell.NewEventPaste(true))
+		for _, currentCharacter := range "ab" {
+			dispatchEvent(tcell.NewEventKey(tcell.KeyRune, currentCharacter, tcell.ModNone))
+		}
+		dispatchEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		dispatchEvent(tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone))
+		dispatchEvent(tcell.NewEventPaste(false))
+	}
+	textboxInstance.GetFocus()
+	dispatchBracketedPaste()
+	assert.Equalf(test, "ab \nc ", textboxInstance.GetText(), "The bracketed paste was not inserted into the textbox!")
+	assert.Truef(test, textboxInstance.Undo(), "The bracketed paste could not be undone!")
+	assert.Equalf(test, " ", textboxInstance.GetText(), "The bracketed paste was not undone all at once!")
+	textboxInstance.SetEnabled(false)
+	dispatchBracketedPaste()
+	assert.Equalf(test, " ", textboxInstance.GetText(), "The bracketed paste changed a disabled textbox!")
+	textboxInstance.SetEnabled(true)
+
+	textField.GetFocus()
+	dispatchBracketedPaste()
+	assert.Equalf(test, "ab", textField.GetValue(), "The first line of the bracketed paste was not inserted into the text field!")
+
+	setFocusedControl("", "", 0)
+	dispatchBracketedPaste()
+	assert.Equalf(test, []string{"a", "b", "enter", "c"}, []string{string(KeyboardMemory.GetFromBuffer()), string(KeyboardMemory.GetFromBuffer()),
+		string(KeyboardMemory.GetFromBuffer()), string(KeyboardMemory.GetFromBuffer())}, "The bracketed paste was not typed without a text control!")
+}
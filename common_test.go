@@ -3,6 +3,7 @@ package consolizer
 import (
 	"encoding/base64"
 	"fmt"
+	"github.com/gdamore/tcell/v2"
 	"github.com/supercom32/consolizer/constants"
 	"github.com/supercom32/consolizer/types"
 	"os"
@@ -111,3 +112,21 @@ func CommonTestSetupHighResolutionImages() (*LayerInstanceType, *LayerInstanceTy
 	layer3 := AddLayer(0, 0, layerWidth, layerHeight, 3, nil)
 	return layer1, layer2, layer3, styleEntry
 }
+
+/*
+GetSimulationScreenRow is a method which allows you to obtain the text that a simulation screen actually received
+for a given row. This is useful for end-to-end tests which need to verify what tcell would display.
+
+Example:
+
+	rowText := GetSimulationScreenRow(screen, 0)
+*/
+func GetSimulationScreenRow(screen tcell.SimulationScreen, yLocation int) string {
+	width, _ := screen.Size()
+	rowText := make([]rune, 0, width)
+	for currentXLocation := 0; currentXLocation < width; currentXLocation++ {
+		mainCharacter, _, _, _ := screen.GetContent(currentXLocation, yLocation)
+		rowText = append(rowText, mainCharacter)
+	}
+	return string(rowText)
+}
@@ -113,6 +113,8 @@ var CP437ToUnicode = [256]rune{
 
 const (
 	CharDot                                              = '\u2022' // •
+	CharMultiplicationSign                               = '\u00D7' // ×
+	CharCheckMark                                        = '\u2713' // ✓
 	CharArrowLeft                                        = '\u2190' // ←
 	CharArrowUp                                          = '\u2191' // ↑
 	CharArrowDown                                        = '\u2193' // ↓
@@ -312,6 +314,19 @@ const AlignmentNoPadding = 3
 const FrameStyleNormal = 0
 const FrameStyleRaised = 1
 const FrameStyleSunken = 2
+const AnchorNone = 0
+const AnchorLeft = 1
+const AnchorTop = 2
+const AnchorRight = 4
+const AnchorBottom = 8
+const AnchorAll = AnchorLeft | AnchorTop | AnchorRight | AnchorBottom
+const LayoutLengthAuto = 0
+const LayoutLengthFill = -1
+const DockFill = 0
+const DockTop = 1
+const DockBottom = 2
+const DockLeft = 3
+const DockRight = 4
 
 const CellTypeButton = 1
 const CellTypeTextField = 2
@@ -328,6 +343,12 @@ const CellTypeTooltip = 12
 const CellTypeFileMenuHeading = 13
 const CellTypeFileMenuItem = 14
 const CellTypeShadow = 15
+const CellTypeSpinner = 16
+const CellTypeSlider = 17
+const CellTypeTreeView = 18
+const CellTypeTable = 19
+const CellTypeTabControl = 20
+const CellTypeMenuItem = 21
 
 const CellControlIdUpScrollArrow = -1
 const CellControlIdDownScrollArrow = -2
@@ -342,6 +363,10 @@ const EventStateNone = 0
 const EventStateDragAndDrop = 1
 const EventStateDragAndDropScrollbar = 2
 const NullControlType = 0
+const RecordedEventKey = "key"
+const RecordedEventMouse = "mouse"
+const RecordedEventPasteStart = "pasteStart"
+const RecordedEventPasteEnd = "pasteEnd"
 
 const NullScrollbarValue = -1
 
@@ -360,11 +385,67 @@ const TYPE_TOOLTIP = "tooltip"
 const TYPE_RADIOBUTTON = "radiobutton"
 const TYPE_VIEWPORT = "viewport"
 const TYPE_FILEMENU = "filemenu"
+const TYPE_SPINNER = "spinner"
+const TYPE_SLIDER = "slider"
+const TYPE_TREEVIEW = "treeview"
+const TYPE_TABLE = "table"
+const TYPE_TABCONTROL = "tabcontrol"
 
 const DefaultTooltipHoverTime = 1000
+const DefaultSpinnerRepeatDelay = 500
+const DefaultSpinnerRepeatInterval = 100
+const DefaultSpinnerMaxLength = 32
+const SliderHandleLower = 0
+const SliderHandleUpper = 1
 const SELECTED_NONE = -1
 
 const MasterImagesPath = "./test_data/master_images/"
+const RecordingsPath = "./test_data/recordings/"
+
+const ThemeClassicDosBlue = "classic-dos-blue"
+const ThemeDark = "dark"
+const ThemeHighContrast = "high-contrast"
+const ThemeColorDefault = "default"
+
+const DefaultModalDimAlphaValue = 0.5
+const DefaultInputPromptMaxLength = 256
+const DialogResultNone = 0
+const DialogResultOk = 1
+const DialogResultYes = 2
+const DialogResultNo = 3
+const DialogResultCancel = 4
+
+const DefaultDoubleClickInterval = 500
+const TextSelectionUnitCharacter = 0
+const TextSelectionUnitWord = 1
+const TextSelectionUnitLine = 2
+
+const ShortcutScopeGlobal = 0
+const ShortcutScopeLayer = 1
+const ShortcutScopeControl = 2
+
+const DefaultUndoHistoryLimit = 200
+const EditTypeNone = 0
+const EditTypeInsert = 1
+const EditTypeDelete = 2
+const EditTypeBackspace = 3
+const EditTypeReplace = 4
+
+const LineMarkerNone = 0
+const LineMarkerError = 1
+const LineMarkerWarning = 2
+const LineMarkerBookmark = 3
+
+const CharacterFilterNone = 0
+const CharacterFilterDigits = 1
+const CharacterFilterHex = 2
+const CharacterFilterAlphanumeric = 3
+const CharacterFilterInteger = 4
+const CharacterFilterDecimal = 5
+
+const InputMaskDate = "####-##-##"
+const InputMaskTime = "##:##"
+const InputMaskPhoneNumber = "(###) ###-####"
 
 const (
 	ButtonStateUnpressed = iota
@@ -372,9 +453,10 @@ const (
 	ButtonStateHovering
 )
 
+// Mouse buttons are numbered in the same order tcell reports them, which places the right button before the middle one.
 const (
 	_ = iota
 	MouseButtonLeft
-	MouseButtonMiddle
 	MouseButtonRight
+	MouseButtonMiddle
 )
@@ -0,0 +1,702 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+	"unicode"
+)
+
+/*
+MenuSelectHandlerType is a type which represents a callback that is called when an item of a menu is selected. The
+item is passed in as it is after being selected, so a checkable item already shows its new check state.
+*/
+type MenuSelectHandlerType func(menuItem types.MenuItemEntryType)
+
+/*
+contextMenuEntryType is a structure which holds the context menu assigned to a layer or control.
+*/
+type contextMenuEntryType struct {
+	styleEntry types.TuiStyleEntryType
+	menuEntry  types.MenuEntryType
+	handler    MenuSelectHandlerType
+}
+
+/*
+openMenuEntryType is a structure which represents a menu currently shown on screen, along with the layer it is drawn
+on. A context menu is made up of one open menu for itself, followed by one for each nested menu opened from it.
+*/
+type openMenuEntryType struct {
+	layerAlias      string
+	menuItems       []types.MenuItemEntryType
+	itemHighlighted int
+}
+
+/*
+contextMenuType is a structure which holds the context menu currently shown on screen. Only one context menu can be
+shown at a time. The close handler, if any, is called once the context menu is closed entirely, so that a file menu
+can tell when the menu it opened is gone.
+*/
+type contextMenuType struct {
+	styleEntry   types.TuiStyleEntryType
+	handler      MenuSelectHandlerType
+	closeHandler func()
+	openMenus    []openMenuEntryType
+}
+
+/*
+layerContextMenuAlias is the control alias used to store the context menu assigned to a layer itself, rather than to
+one of its controls.
+*/
+const layerContextMenuAlias = ""
+
+var contextMenu contextMenuType
+
+/*
+contextMenus is a variable which holds the context menu of every layer and control which has one assigned, grouped
+by layer.
+*/
+var contextMenus = memory.NewControlMemoryManager[contextMenuEntryType]()
+
+/*
+SetContextMenu is a method which allows you to assign a context menu to the current layer. The context menu opens at
+the mouse cursor when the right mouse button is clicked anywhere on the layer, except over a control which has a
+context menu of its own. In addition, the following should be noted:
+
+- Child layers without a context menu of their own use the context menu of their parent.
+
+  - The items of the menu entry are shared rather than copied, so items disabled or checked through the menu entry
+    provided are shown that way the next time the context menu opens.
+
+- The handler is called with the item selected. Passing nil removes the context menu from the layer.
+
+Example:
+
+	menuEntry := types.NewMenuEntry()
+	menuEntry.AddItem("refresh", "&Refresh", "F5")
+	layerInstance.SetContextMenu(style, menuEntry, func(menuItem types.MenuItemEntryType) {
+		refreshWindow()
+	})
+*/
+func (shared *LayerInstanceType) SetContextMenu(styleEntry types.TuiStyleEntryType, menuEntry types.MenuEntryType, handler MenuSelectHandlerType) {
+	validateLayer(shared.layerAlias)
+	setContextMenu(shared.layerAlias, layerContextMenuAlias, styleEntry, menuEntry, handler)
+}
+
+/*
+SetContextMenu is a method which allows you to assign a context menu to a control. The context menu opens at the
+mouse cursor when the right mouse button is clicked over the control, which also gives the control focus. If the
+control instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+  - The items of the menu entry are shared rather than copied, so items disabled or checked through the menu entry
+    provided are shown that way the next time the context menu opens.
+
+- The handler is called with the item selected. Passing nil removes the context menu from the control.
+
+Example:
+
+	menuEntry := types.NewMenuEntry()
+	menuEntry.AddItem("cut", "Cu&t", "Ctrl+X")
+	menuEntry.AddItem("copy", "&Copy", "Ctrl+C")
+	textField.SetContextMenu(style, menuEntry, func(menuItem types.MenuItemEntryType) {
+		handleEditCommand(menuItem.Alias)
+	})
+*/
+func (shared *BaseControlInstanceType) SetContextMenu(styleEntry types.TuiStyleEntryType, menuEntry types.MenuEntryType, handler MenuSelectHandlerType) *BaseControlInstanceType {
+	if shared.getBaseControl() != nil {
+		setContextMenu(shared.layerAlias, shared.controlAlias, styleEntry, menuEntry, handler)
+	}
+	return shared
+}
+
+/*
+ShowContextMenu is a method which allows you to open a context menu at a given location on the screen, such as when
+the user presses a key which should show one. In addition, the following should be noted:
+
+- The first item of the menu is highlighted, so that it can be used with the keyboard straight away.
+
+  - If the menu does not fit at the location provided, it is moved so that it does. Any context menu already open is
+    closed first.
+
+- The handler is called with the item selected, and can be nil.
+
+Example:
+
+	ShowContextMenu(style, menuEntry, 10, 5, func(menuItem types.MenuItemEntryType) {
+		handleCommand(menuItem.Alias)
+	})
+*/
+func ShowContextMenu(styleEntry types.TuiStyleEntryType, menuEntry types.MenuEntryType, xLocation int, yLocation int, handler MenuSelectHandlerType) {
+	validateMenuItems(menuEntry.Items)
+	contextMenu.open(styleEntry, menuEntry.Items, xLocation, yLocation, handler, true)
+}
+
+/*
+CloseContextMenu is a method which allows you to close the context menu currently open, without selecting any of its
+items. If no context menu is open, then no operation takes place.
+
+Example:
+
+	CloseContextMenu()
+*/
+func CloseContextMenu() {
+	contextMenu.closeMenusAfter(-1)
+}
+
+/*
+IsContextMenuOpen is a method which allows you to detect if a context menu is currently open.
+
+Example:
+
+	if IsContextMenuOpen() {
+		return
+	}
+*/
+func IsContextMenuOpen() bool {
+	return len(contextMenu.openMenus) > 0
+}
+
+/*
+setContextMenu is a method which allows you to store the context menu of a layer or control, replacing any assigned
+previously. If the handler provided is nil, the context menu is removed instead.
+
+Example:
+
+	setContextMenu("layer1", "textField1", style, menuEntry, handler)
+*/
+func setContextMenu(layerAlias string, controlAlias string, styleEntry types.TuiStyleEntryType, menuEntry types.MenuEntryType, handler MenuSelectHandlerType) {
+	deleteContextMenu(layerAlias, controlAlias)
+	if handler != nil {
+		validateMenuItems(menuEntry.Items)
+		contextMenus.Add(layerAlias, controlAlias, &contextMenuEntryType{styleEntry: styleEntry, menuEntry: menuEntry, handler: handler})
+	}
+}
+
+/*
+deleteContextMenu is a method which allows you to remove the context menu assigned to a layer or control, if any.
+
+Example:
+
+	deleteContextMenu("layer1", "textField1")
+*/
+func deleteContextMenu(layerAlias string, controlAlias string) {
+	if contextMenus.IsExists(layerAlias, controlAlias) {
+		contextMenus.Remove(layerAlias, controlAlias)
+	}
+}
+
+/*
+removeLayerContextMenu is a method which allows you to remove every context menu assigned to a layer or its controls.
+If the layer is one an open menu is drawn on, that menu and every menu nested in it are closed as well.
+
+Example:
+
+	removeLayerContextMenu("layer1")
+*/
+func removeLayerContextMenu(layerAlias string) {
+	contextMenus.RemoveAll(layerAlias)
+	if menuIndex := contextMenu.getOpenMenuIndex(layerAlias); menuIndex != -1 {
+		contextMenu.closeMenusAfter(menuIndex)
+		contextMenu.openMenus = contextMenu.openMenus[:menuIndex]
+		// Nothing is left to delete, but the close handler still needs to be told the context menu is gone.
+		if menuIndex == 0 {
+			contextMenu.closeMenusAfter(-1)
+		}
+	}
+}
+
+/*
+getContextMenu is a method which allows you to find the context menu which should open for a given cell. The context
+menu of the control is used if it has one, otherwise the context menu of the layer or its closest parent which has
+one is used. If there is no such context menu, nil is returned.
+
+Example:
+
+	contextMenuEntry := contextMenu.getContextMenu("layer1", "textField1")
+*/
+func (shared *contextMenuType) getContextMenu(layerAlias string, controlAlias string) *contextMenuEntryType {
+	if controlAlias != layerContextMenuAlias && contextMenus.IsExists(layerAlias, controlAlias) {
+		return contextMenus.Get(layerAlias, controlAlias)
+	}
+	for currentLayerAlias := layerAlias; currentLayerAlias != "" && Layers.IsExists(currentLayerAlias); currentLayerAlias = Layers.Get(currentLayerAlias).ParentAlias {
+		if contextMenus.IsExists(currentLayerAlias, layerContextMenuAlias) {
+			return contextMenus.Get(currentLayerAlias, layerContextMenuAlias)
+		}
+	}
+	return nil
+}
+
+/*
+open is a method which allows you to open a context menu at a given location on the screen, closing any context menu
+already open.
+
+Example:
+
+	contextMenu.open(style, menuItems, 10, 5, handler, false)
+*/
+func (shared *contextMenuType) open(styleEntry types.TuiStyleEntryType, menuItems []types.MenuItemEntryType, xLocation int, yLocation int, handler MenuSelectHandlerType, isFirstItemHighlighted bool) {
+	shared.closeMenusAfter(-1)
+	shared.styleEntry = styleEntry
+	shared.handler = handler
+	shared.closeHandler = nil
+	width, height := shared.getMenuSize(menuItems)
+	shared.addOpenMenu(menuItems, xLocation, yLocation, xLocation, width, height, isFirstItemHighlighted)
+}
+
+/*
+openSubMenu is a method which allows you to open the nested menu of an item, to the right of the menu holding it. If
+there is no room to the right, the nested menu is opened to the left instead.
+
+Example:
+
+	contextMenu.openSubMenu(0, 2, true)
+*/
+func (shared *contextMenuType) openSubMenu(menuIndex int, itemIndex int, isFirstItemHighlighted bool) {
+	shared.closeMenusAfter(menuIndex)
+	parentLayerEntry := Layers.Get(shared.openMenus[menuIndex].layerAlias)
+	menuItems := shared.openMenus[menuIndex].menuItems[itemIndex].SubMenuItems
+	width, height := shared.getMenuSize(menuItems)
+	xLocation := parentLayerEntry.ScreenXLocation + parentLayerEntry.Width - 1
+	alternateXLocation := parentLayerEntry.ScreenXLocation - width + 1
+	shared.addOpenMenu(menuItems, xLocation, parentLayerEntry.ScreenYLocation+itemIndex, alternateXLocation, width, height, isFirstItemHighlighted)
+}
+
+/*
+addOpenMenu is a method which allows you to create the layer a menu is drawn on, and add it to the menus currently
+open. In addition, the following should be noted:
+
+  - If the menu does not fit on screen at the location provided, the alternate horizontal location is tried before
+    the menu is simply moved back on screen.
+
+Example:
+
+	contextMenu.addOpenMenu(menuItems, 10, 5, 10, 20, 6, false)
+*/
+func (shared *contextMenuType) addOpenMenu(menuItems []types.MenuItemEntryType, xLocation int, yLocation int, alternateXLocation int, width int, height int, isFirstItemHighlighted bool) {
+	if xLocation+width > commonResource.terminalWidth {
+		xLocation = alternateXLocation
+	}
+	if xLocation+width > commonResource.terminalWidth {
+		xLocation = commonResource.terminalWidth - width
+	}
+	if xLocation < 0 {
+		xLocation = 0
+	}
+	if yLocation+height > commonResource.terminalHeight {
+		yLocation = commonResource.terminalHeight - height
+	}
+	if yLocation < 0 {
+		yLocation = 0
+	}
+	openMenuEntry := openMenuEntryType{layerAlias: getUUID(), menuItems: menuItems, itemHighlighted: constants.NullCellId}
+	layer.Add(openMenuEntry.layerAlias, xLocation, yLocation, width, height, -1, "")
+	shared.openMenus = append(shared.openMenus, openMenuEntry)
+	if isFirstItemHighlighted {
+		shared.moveHighlight(len(shared.openMenus)-1, 1)
+	}
+}
+
+/*
+closeMenusAfter is a method which allows you to close every open menu nested deeper than the one at the index
+provided. Passing -1 closes the context menu entirely, and calls its close handler if it has one.
+
+Example:
+
+	contextMenu.closeMenusAfter(0)
+*/
+func (shared *contextMenuType) closeMenusAfter(menuIndex int) {
+	for len(shared.openMenus) > menuIndex+1 {
+		layerAlias := shared.openMenus[len(shared.openMenus)-1].layerAlias
+		shared.openMenus = shared.openMenus[:len(shared.openMenus)-1]
+		if Layers.IsExists(layerAlias) {
+			layer.Delete(layerAlias)
+		}
+	}
+	if menuIndex == -1 && shared.closeHandler != nil {
+		closeHandler := shared.closeHandler
+		shared.closeHandler = nil
+		closeHandler()
+	}
+}
+
+/*
+getOpenMenuIndex is a method which allows you to obtain the index of the open menu drawn on a given layer. If no open
+menu is drawn on the layer, -1 is returned.
+
+Example:
+
+	menuIndex := contextMenu.getOpenMenuIndex("layer1")
+*/
+func (shared *contextMenuType) getOpenMenuIndex(layerAlias string) int {
+	for currentIndex, openMenuEntry := range shared.openMenus {
+		if openMenuEntry.layerAlias == layerAlias {
+			return currentIndex
+		}
+	}
+	return -1
+}
+
+/*
+getMenuLabel is a method which allows you to obtain the text of a menu label as it is drawn, along with its keyboard
+accelerator. An ampersand marks the letter after it as the accelerator, while two ampersands are drawn as one. If the
+label has no accelerator, 0 is returned for it and -1 for its position.
+
+Example:
+
+	labelText, accelerator, acceleratorIndex := getMenuLabel("&Open")
+*/
+func getMenuLabel(label string) ([]rune, rune, int) {
+	var labelText []rune
+	accelerator := rune(0)
+	acceleratorIndex := -1
+	labelRunes := stringformat.GetRunesFromString(label)
+	for currentIndex := 0; currentIndex < len(labelRunes); currentIndex++ {
+		if labelRunes[currentIndex] == '&' && currentIndex+1 < len(labelRunes) {
+			currentIndex++
+			if labelRunes[currentIndex] != '&' && acceleratorIndex == -1 {
+				accelerator = unicode.ToLower(labelRunes[currentIndex])
+				acceleratorIndex = len(labelText)
+			}
+		}
+		labelText = append(labelText, labelRunes[currentIndex])
+	}
+	return labelText, accelerator, acceleratorIndex
+}
+
+/*
+getMenuColumnWidths is a method which allows you to obtain the widths of the widest label and the widest shortcut
+hint of a list of menu items.
+
+Example:
+
+	labelWidth, shortcutHintWidth := getMenuColumnWidths(menuItems)
+*/
+func getMenuColumnWidths(menuItems []types.MenuItemEntryType) (int, int) {
+	labelWidth := 0
+	shortcutHintWidth := 0
+	for _, menuItem := range menuItems {
+		labelText, _, _ := getMenuLabel(menuItem.Label)
+		if width := stringformat.GetWidthOfRunesWhenPrinted(labelText); width > labelWidth {
+			labelWidth = width
+		}
+		if width := stringformat.GetWidthOfRunesWhenPrinted([]rune(menuItem.ShortcutHint)); width > shortcutHintWidth {
+			shortcutHintWidth = width
+		}
+	}
+	return labelWidth, shortcutHintWidth
+}
+
+/*
+getMenuItemText is a method which allows you to obtain the text drawn for a menu item, made up of its check mark,
+label, shortcut hint, and nested menu indicator, padded so that every item of the menu lines up. The position of its
+keyboard accelerator within the text is also returned, or -1 if it has none.
+
+Example:
+
+	itemText, acceleratorIndex := getMenuItemText(style, menuItem, 12, 6)
+*/
+func getMenuItemText(styleEntry types.TuiStyleEntryType, menuItem types.MenuItemEntryType, labelWidth int, shortcutHintWidth int) ([]rune, int) {
+	checkMark := ' '
+	if menuItem.IsCheckable && menuItem.IsChecked {
+		checkMark = styleEntry.Menu.CheckMark
+	}
+	subMenuIndicator := ' '
+	if len(menuItem.SubMenuItems) > 0 {
+		subMenuIndicator = styleEntry.Menu.SubMenuIndicator
+	}
+	labelText, _, acceleratorIndex := getMenuLabel(menuItem.Label)
+	itemText := []rune{' ', checkMark, ' '}
+	if acceleratorIndex != -1 {
+		acceleratorIndex += len(itemText)
+	}
+	itemText = append(itemText, labelText...)
+	itemText = append(itemText, stringformat.GetFilledRuneArray(labelWidth-stringformat.GetWidthOfRunesWhenPrinted(labelText), ' ')...)
+	if shortcutHintWidth > 0 {
+		shortcutHint := []rune(menuItem.ShortcutHint)
+		itemText = append(itemText, stringformat.GetFilledRuneArray(shortcutHintWidth-stringformat.GetWidthOfRunesWhenPrinted(shortcutHint)+2, ' ')...)
+		itemText = append(itemText, shortcutHint...)
+	}
+	return append(itemText, ' ', subMenuIndicator, ' '), acceleratorIndex
+}
+
+/*
+getMenuSize is a method which allows you to obtain the width and height of a menu, including its border.
+
+Example:
+
+	width, height := contextMenu.getMenuSize(menuItems)
+*/
+func (shared *contextMenuType) getMenuSize(menuItems []types.MenuItemEntryType) (int, int) {
+	labelWidth, shortcutHintWidth := getMenuColumnWidths(menuItems)
+	itemText, _ := getMenuItemText(shared.styleEntry, types.MenuItemEntryType{}, labelWidth, shortcutHintWidth)
+	return len(itemText) + 2, len(menuItems) + 2
+}
+
+/*
+isMenuItemSelectable is a method which allows you to detect if a menu item can be highlighted and selected. Separators
+and disabled items can not.
+
+Example:
+
+	isSelectable := isMenuItemSelectable(menuItem)
+*/
+func isMenuItemSelectable(menuItem types.MenuItemEntryType) bool {
+	return !menuItem.IsSeparator && !menuItem.IsDisabled
+}
+
+/*
+drawOnLayer is a method which draws the open menu of the context menu which belongs to a given text layer, if any.
+
+Example:
+
+	contextMenu.drawOnLayer(layerEntry)
+*/
+func (shared *contextMenuType) drawOnLayer(layerEntry types.LayerEntryType) {
+	if menuIndex := shared.getOpenMenuIndex(layerEntry.LayerAlias); menuIndex != -1 {
+		shared.draw(&layerEntry, shared.openMenus[menuIndex])
+	}
+}
+
+/*
+draw is a method which draws an open menu on the text layer created for it. In addition, the following should be
+noted:
+
+- The border and colors of the menu are the same as those of a selector.
+
+  - Separators are joined to the border with the connector characters of the frame style, and the keyboard
+    accelerator of each item is underlined.
+
+  - Each cell of an item stores the index of the item in its control ID. Every other cell of the menu stores
+    constants.NullCellId, so that clicks on the border do not close the menu.
+
+Example:
+
+	contextMenu.draw(&layerEntry, openMenuEntry)
+*/
+func (shared *contextMenuType) draw(layerEntry *types.LayerEntryType, openMenuEntry openMenuEntryType) {
+	styleEntry := shared.styleEntry
+	menuAttributeEntry, highlightAttributeEntry := Selector.setupSelectorAttributes(styleEntry)
+	menuAttributeEntry.CellType = constants.CellTypeMenuItem
+	menuAttributeEntry.CellControlId = constants.NullCellId
+	highlightAttributeEntry.CellType = constants.CellTypeMenuItem
+	Selector.drawSelectorBorder(layerEntry, styleEntry, menuAttributeEntry, 1, 1, layerEntry.Width-2, layerEntry.Height-2)
+	labelWidth, shortcutHintWidth := getMenuColumnWidths(openMenuEntry.menuItems)
+	for currentIndex, menuItem := range openMenuEntry.menuItems {
+		if menuItem.IsSeparator {
+			separatorText := stringformat.GetFilledRuneArray(layerEntry.Width, styleEntry.Frame.HorizontalLine)
+			separatorText[0] = styleEntry.Frame.RightSideTConnector
+			separatorText[layerEntry.Width-1] = styleEntry.Frame.LeftSideTConnector
+			printLayer(layerEntry, menuAttributeEntry, 0, currentIndex+1, separatorText)
+			continue
+		}
+		attributeEntry := menuAttributeEntry
+		if currentIndex == openMenuEntry.itemHighlighted {
+			attributeEntry = highlightAttributeEntry
+		}
+		if menuItem.IsDisabled {
+			attributeEntry.ForegroundColor = styleEntry.Menu.DisabledForegroundColor
+		}
+		attributeEntry.CellControlId = currentIndex
+		itemText, acceleratorIndex := getMenuItemText(styleEntry, menuItem, labelWidth, shortcutHintWidth)
+		printLayer(layerEntry, attributeEntry, 1, currentIndex+1, itemText)
+		if acceleratorIndex != -1 && !menuItem.IsDisabled {
+			attributeEntry.IsUnderlined = true
+			printLayer(layerEntry, attributeEntry, 1+acceleratorIndex, currentIndex+1, []rune{itemText[acceleratorIndex]})
+		}
+	}
+}
+
+/*
+moveHighlight is a method which allows you to move the highlight of an open menu to the next selectable item in a
+given direction, wrapping around at either end. Separators and disabled items are skipped.
+
+Example:
+
+	contextMenu.moveHighlight(0, 1)
+*/
+func (shared *contextMenuType) moveHighlight(menuIndex int, direction int) {
+	openMenuEntry := &shared.openMenus[menuIndex]
+	numberOfItems := len(openMenuEntry.menuItems)
+	itemIndex := openMenuEntry.itemHighlighted
+	if itemIndex == constants.NullCellId && direction < 0 {
+		itemIndex = numberOfItems
+	}
+	for attempt := 0; attempt < numberOfItems; attempt++ {
+		itemIndex = (itemIndex + direction + numberOfItems) % numberOfItems
+		if isMenuItemSelectable(openMenuEntry.menuItems[itemIndex]) {
+			openMenuEntry.itemHighlighted = itemIndex
+			return
+		}
+	}
+}
+
+/*
+selectItem is a method which allows you to select an item of an open menu. In addition, the following should be
+noted:
+
+  - Selecting an item with a nested menu opens it. Otherwise, the context menu is closed, the check mark of a
+    checkable item is toggled, and the handler of the context menu is called with the item.
+
+- Separators and disabled items can not be selected.
+
+Example:
+
+	contextMenu.selectItem(0, 2, false)
+*/
+func (shared *contextMenuType) selectItem(menuIndex int, itemIndex int, isFirstItemHighlighted bool) {
+	menuItem := &shared.openMenus[menuIndex].menuItems[itemIndex]
+	if !isMenuItemSelectable(*menuItem) {
+		return
+	}
+	shared.openMenus[menuIndex].itemHighlighted = itemIndex
+	if len(menuItem.SubMenuItems) > 0 {
+		shared.openSubMenu(menuIndex, itemIndex, isFirstItemHighlighted)
+		return
+	}
+	if menuItem.IsCheckable {
+		menuItem.IsChecked = !menuItem.IsChecked
+	}
+	handler := shared.handler
+	shared.closeMenusAfter(-1)
+	if handler != nil {
+		handler(*menuItem)
+	}
+}
+
+/*
+updateKeyboardEvent is a method which updates the context menu currently open according to the current keyboard
+event. While a context menu is open, it captures every keystroke. In addition, the following should be noted:
+
+- Up and down move the highlight, while enter or space selects the item highlighted.
+
+- Right opens the nested menu of the item highlighted, while left closes the innermost nested menu.
+
+- Escape closes the innermost menu, which closes the context menu entirely once no nested menus are left.
+
+- Typing the keyboard accelerator of an item selects it.
+
+Example:
+
+	isUpdate, isConsumed := contextMenu.updateKeyboardEvent(keystroke)
+*/
+func (shared *contextMenuType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	if len(shared.openMenus) == 0 || keystroke == nil {
+		return false, false
+	}
+	menuIndex := len(shared.openMenus) - 1
+	openMenuEntry := shared.openMenus[menuIndex]
+	isItemHighlighted := openMenuEntry.itemHighlighted != constants.NullCellId
+	keystrokeAsString := string(keystroke)
+	switch keystrokeAsString {
+	case "up":
+		shared.moveHighlight(menuIndex, -1)
+	case "down":
+		shared.moveHighlight(menuIndex, 1)
+	case "right":
+		if isItemHighlighted && len(openMenuEntry.menuItems[openMenuEntry.itemHighlighted].SubMenuItems) > 0 {
+			shared.openSubMenu(menuIndex, openMenuEntry.itemHighlighted, true)
+		}
+	case "left":
+		if menuIndex > 0 {
+			shared.closeMenusAfter(menuIndex - 1)
+		}
+	case "esc", "escape":
+		shared.closeMenusAfter(menuIndex - 1)
+	case "enter", " ":
+		if isItemHighlighted {
+			shared.selectItem(menuIndex, openMenuEntry.itemHighlighted, true)
+		}
+	default:
+		if len(keystroke) != 1 {
+			break
+		}
+		for currentIndex, menuItem := range openMenuEntry.menuItems {
+			if _, accelerator, _ := getMenuLabel(menuItem.Label); accelerator != 0 && accelerator == unicode.ToLower(keystroke[0]) && isMenuItemSelectable(menuItem) {
+				shared.selectItem(menuIndex, currentIndex, true)
+				break
+			}
+		}
+	}
+	return true, true
+}
+
+/*
+updateMouseEvent is a method which updates the context menu according to the current mouse event, and reports if
+the mouse event was used by it. In addition, the following should be noted:
+
+  - Clicking the right mouse button opens the context menu of the control or layer under the mouse cursor, which also
+    gives the control focus.
+
+  - While a context menu is open, it captures every mouse event. Moving over an item highlights it and opens its
+    nested menu if it has one, and clicking an item selects it.
+
+  - Clicking outside the context menu closes it. If the right mouse button was used, the context menu of whatever was
+    clicked is then opened instead.
+
+Example:
+
+	isUpdateRequired := contextMenu.updateMouseEvent()
+*/
+func (shared *contextMenuType) updateMouseEvent() bool {
+	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	isNewClick := buttonPressed != 0 && previousButtonPressed == 0
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	if len(shared.openMenus) > 0 {
+		menuIndex := shared.getOpenMenuIndex(characterEntry.LayerAlias)
+		if menuIndex != -1 {
+			shared.updateOpenMenuMouseEvent(menuIndex, characterEntry.AttributeEntry.CellControlId, isNewClick)
+			return true
+		}
+		if !isNewClick {
+			return true
+		}
+		shared.closeMenusAfter(-1)
+		if buttonPressed != constants.MouseButtonRight {
+			return true
+		}
+	}
+	if !isNewClick || buttonPressed != constants.MouseButtonRight || !isLayerWithinActiveModal(characterEntry.LayerAlias) {
+		return false
+	}
+	layerAlias := characterEntry.LayerAlias
+	controlAlias := characterEntry.AttributeEntry.CellControlAlias
+	contextMenuEntry := shared.getContextMenu(layerAlias, controlAlias)
+	if contextMenuEntry == nil {
+		return false
+	}
+	if contextMenus.IsExists(layerAlias, controlAlias) && controlAlias != layerContextMenuAlias {
+		setFocusedControl(layerAlias, controlAlias, characterEntry.AttributeEntry.CellType)
+	}
+	shared.open(contextMenuEntry.styleEntry, contextMenuEntry.menuEntry.Items, mouseXLocation, mouseYLocation, contextMenuEntry.handler, false)
+	return true
+}
+
+/*
+updateOpenMenuMouseEvent is a method which updates an open menu according to a mouse event over one of its cells.
+Moving over an item highlights it, opening its nested menu or closing any nested menu opened from another item, and
+clicking the item selects it.
+
+Example:
+
+	contextMenu.updateOpenMenuMouseEvent(0, 2, true)
+*/
+func (shared *contextMenuType) updateOpenMenuMouseEvent(menuIndex int, itemIndex int, isNewClick bool) {
+	openMenuEntry := &shared.openMenus[menuIndex]
+	if itemIndex < 0 || itemIndex >= len(openMenuEntry.menuItems) || !isMenuItemSelectable(openMenuEntry.menuItems[itemIndex]) {
+		return
+	}
+	isSubMenuOpen := len(shared.openMenus) > menuIndex+1 && openMenuEntry.itemHighlighted == itemIndex
+	openMenuEntry.itemHighlighted = itemIndex
+	if len(openMenuEntry.menuItems[itemIndex].SubMenuItems) > 0 {
+		if !isSubMenuOpen {
+			shared.openSubMenu(menuIndex, itemIndex, false)
+		}
+		return
+	}
+	shared.closeMenusAfter(menuIndex)
+	if isNewClick {
+		shared.selectItem(menuIndex, itemIndex, false)
+	}
+}
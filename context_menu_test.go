@@ -0,0 +1,167 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+getTestContextMenuEntry is a method which returns the menu shared by the context menu tests, made up of an item with a
+shortcut hint, a separator, a disabled item, a checkable item, and a nested menu.
+
+Example:
+
+	menuEntry := getTestContextMenuEntry()
+*/
+func getTestContextMenuEntry() types.MenuEntryType {
+	sortMenuEntry := types.NewMenuEntry()
+	sortMenuEntry.AddItem("name", "&Name", "")
+	sortMenuEntry.AddItem("date", "&Date", "")
+	menuEntry := types.NewMenuEntry()
+	menuEntry.AddItem("cut", "Cu&t", "Ctrl+X")
+	menuEntry.AddSeparator()
+	menuEntry.AddItem("paste", "&Paste", "")
+	menuEntry.GetItem("paste").IsDisabled = true
+	menuEntry.AddCheckableItem("wrap", "&Word Wrap", "", false)
+	menuEntry.AddSubMenu("&Sort By", sortMenuEntry)
+	return menuEntry
+}
+
+/*
+TestContextMenuMouse is a test which verifies that context menus open at the mouse cursor when the right mouse button
+is clicked, and that their items and nested menus can be used with the mouse.
+
+Example:
+
+	Expected Inputs:
+	    A layer and a button which each have a context menu, right clicked, with the mouse moved over a nested menu and
+	    clicked on disabled, checkable, and nested items, and outside the context menu.
+
+	Expected Outputs:
+	    The context menu of whatever was clicked opens at the mouse cursor with its border, separators, hints, and
+	    indicators drawn, the nested menu opens beside it, disabled items are ignored, selecting an item closes the
+	    context menu and calls the handler, and clicking outside closes it without calling the handler.
+*/
+func TestContextMenuMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	buttonInstance := layer1.AddButton("Button", styleEntry, 2, 2, 10, 3, true)
+	var selectedItems []types.MenuItemEntryType
+	buttonInstance.SetContextMenu(styleEntry, getTestContextMenuEntry(), func(menuItem types.MenuItemEntryType) {
+		selectedItems = append(selectedItems, menuItem)
+	})
+	layerMenuEntry := types.NewMenuEntry()
+	layerMenuEntry.AddItem("refresh", "&Refresh", "")
+	layer1.SetContextMenu(styleEntry, layerMenuEntry, func(menuItem types.MenuItemEntryType) {
+		selectedItems = append(selectedItems, menuItem)
+	})
+	UpdateDisplay(false)
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(4, 3, 2, "")
+	assert.Truef(test, contextMenu.updateMouseEvent(), "Right clicking a control with a context menu did not use the mouse event!")
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	assert.Equalf(test, buttonInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Right clicking a control did not focus it!")
+	assert.Equalf(test, styleEntry.Frame.UpperLeftCorner, layerEntry.CharacterMemory[3][4].Character, "The context menu was not opened at the mouse cursor!")
+	assert.Equalf(test, 'C', layerEntry.CharacterMemory[4][8].Character, "The label of an item was not drawn!")
+	assert.Truef(test, layerEntry.CharacterMemory[4][10].AttributeEntry.IsUnderlined, "The keyboard accelerator of an item was not underlined!")
+	assert.Equalf(test, 'X', layerEntry.CharacterMemory[4][24].Character, "The shortcut hint of an item was not drawn at the right of the menu!")
+	assert.Equalf(test, styleEntry.Frame.RightSideTConnector, layerEntry.CharacterMemory[5][4].Character, "The separator was not joined to the border!")
+	assert.Equalf(test, styleEntry.Menu.DisabledForegroundColor, layerEntry.CharacterMemory[6][8].AttributeEntry.ForegroundColor, "The disabled item was not drawn in the disabled color!")
+	assert.Equalf(test, styleEntry.Menu.SubMenuIndicator, layerEntry.CharacterMemory[8][26].Character, "The nested menu indicator was not drawn!")
+
+	SetMouseStatus(4, 3, 0, "")
+	SetMouseStatus(10, 8, 0, "")
+	contextMenu.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, 'N', layerEntry.CharacterMemory[8][32].Character, "Moving over an item with a nested menu did not open it beside the item!")
+	SetMouseStatus(8, 6, 1, "")
+	contextMenu.updateMouseEvent()
+	assert.Truef(test, IsContextMenuOpen(), "Clicking a disabled item closed the context menu!")
+	assert.Equalf(test, 2, len(contextMenu.openMenus), "Clicking a disabled item closed the nested menu!")
+	SetMouseStatus(8, 6, 0, "")
+	SetMouseStatus(32, 9, 0, "")
+	contextMenu.updateMouseEvent()
+	SetMouseStatus(32, 9, 1, "")
+	contextMenu.updateMouseEvent()
+	assert.Falsef(test, IsContextMenuOpen(), "Selecting an item did not close the context menu!")
+	assert.Equalf(test, 1, len(selectedItems), "Selecting an item did not call the handler!")
+	assert.Equalf(test, "date", selectedItems[0].Alias, "The handler was not given the item of the nested menu selected!")
+
+	UpdateDisplay(false)
+	SetMouseStatus(32, 9, 0, "")
+	SetMouseStatus(4, 3, 2, "")
+	contextMenu.updateMouseEvent()
+	UpdateDisplay(false)
+	SetMouseStatus(8, 7, 0, "")
+	SetMouseStatus(8, 7, 1, "")
+	contextMenu.updateMouseEvent()
+	assert.Truef(test, selectedItems[1].IsChecked, "Selecting a checkable item did not check it!")
+	UpdateDisplay(false)
+	SetMouseStatus(8, 7, 0, "")
+	SetMouseStatus(30, 5, 2, "")
+	contextMenu.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, 'R', layerEntry.CharacterMemory[6][29].Character, "Right clicking the layer did not open its context menu!")
+	SetMouseStatus(30, 5, 0, "")
+	SetMouseStatus(0, 19, 1, "")
+	assert.Truef(test, contextMenu.updateMouseEvent(), "Clicking outside the context menu was passed on to the controls behind it!")
+	assert.Falsef(test, IsContextMenuOpen(), "Clicking outside the context menu did not close it!")
+	assert.Equalf(test, 2, len(selectedItems), "Closing the context menu called the handler!")
+}
+
+/*
+TestContextMenuKeyboard is a test which verifies that context menus can be used with the keyboard.
+
+Example:
+
+	Expected Inputs:
+	    A context menu shown at a location where it does not fit, navigated with the arrow keys, enter, escape, and
+	    keyboard accelerators.
+
+	Expected Outputs:
+	    The context menu is moved on screen, the highlight skips separators and disabled items, nested menus open and
+	    close with the arrow keys, accelerators select items, and every keystroke is captured while it is open.
+*/
+func TestContextMenuKeyboard(test *testing.T) {
+	_, _, _, styleEntry := CommonTestSetup()
+	menuEntry := getTestContextMenuEntry()
+	var selectedItems []types.MenuItemEntryType
+	handler := func(menuItem types.MenuItemEntryType) {
+		selectedItems = append(selectedItems, menuItem)
+	}
+	ShowContextMenu(styleEntry, menuEntry, 35, 18, handler)
+	rootLayerEntry := Layers.Get(contextMenu.openMenus[0].layerAlias)
+	assert.Equalf(test, []int{15, 13}, []int{rootLayerEntry.ScreenXLocation, rootLayerEntry.ScreenYLocation}, "The context menu was not moved on screen!")
+	assert.Equalf(test, 0, contextMenu.openMenus[0].itemHighlighted, "The first item was not highlighted!")
+	contextMenu.updateKeyboardEvent([]rune("down"))
+	assert.Equalf(test, 3, contextMenu.openMenus[0].itemHighlighted, "The highlight did not skip the separator and the disabled item!")
+	contextMenu.updateKeyboardEvent([]rune("down"))
+	contextMenu.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 2, len(contextMenu.openMenus), "Right did not open the nested menu!")
+	assert.Equalf(test, 0, contextMenu.openMenus[1].itemHighlighted, "The first item of the nested menu was not highlighted!")
+	subMenuLayerEntry := Layers.Get(contextMenu.openMenus[1].layerAlias)
+	assert.Lessf(test, subMenuLayerEntry.ScreenXLocation, rootLayerEntry.ScreenXLocation, "The nested menu was not opened to the left when it did not fit to the right!")
+	contextMenu.updateKeyboardEvent([]rune("left"))
+	assert.Equalf(test, 1, len(contextMenu.openMenus), "Left did not close the nested menu!")
+	assert.Falsef(test, Layers.IsExists(subMenuLayerEntry.LayerAlias), "The layer of the closed nested menu was not deleted!")
+	_, isConsumed := contextMenu.updateKeyboardEvent([]rune("x"))
+	assert.Truef(test, isConsumed, "A keystroke was not captured while the context menu was open!")
+	contextMenu.updateKeyboardEvent([]rune("S"))
+	contextMenu.updateKeyboardEvent([]rune("enter"))
+	assert.Equalf(test, []string{"name"}, []string{selectedItems[0].Alias}, "Enter did not select the item highlighted in the nested menu!")
+
+	ShowContextMenu(styleEntry, menuEntry, 0, 0, handler)
+	contextMenu.updateKeyboardEvent([]rune("w"))
+	assert.Truef(test, menuEntry.GetItem("wrap").IsChecked, "The accelerator did not check the checkable item!")
+	ShowContextMenu(styleEntry, menuEntry, 0, 0, handler)
+	contextMenu.updateKeyboardEvent([]rune("p"))
+	assert.Truef(test, IsContextMenuOpen(), "The accelerator of a disabled item selected it!")
+	contextMenu.updateKeyboardEvent([]rune("escape"))
+	assert.Falsef(test, IsContextMenuOpen(), "Escape did not close the context menu!")
+	isUpdated, _ := contextMenu.updateKeyboardEvent([]rune("down"))
+	assert.Falsef(test, isUpdated, "A keystroke was captured while no context menu was open!")
+	assert.Panicsf(test, func() {
+		ShowContextMenu(styleEntry, types.MenuEntryType{Items: []types.MenuItemEntryType{{Alias: "blank"}}}, 0, 0, handler)
+	}, "An item without a label did not panic!")
+}
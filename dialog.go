@@ -86,6 +86,7 @@ func printDialog(layerEntry *types.LayerEntryType, attributeEntry types.Attribut
 
 			characterMemory[cursorYLocation][cursorXLocation].AttributeEntry = types.NewAttributeEntry(&currentAttributeEntry)
 			characterMemory[cursorYLocation][cursorXLocation].Character = currentCharacter
+			layerEntry.MarkDirtyRegion(cursorXLocation, cursorYLocation, 1, 1)
 
 			if stringformat.IsRuneCharacterWide(currentCharacter) {
 				cursorXLocation++
@@ -96,6 +97,7 @@ func printDialog(layerEntry *types.LayerEntryType, attributeEntry types.Attribut
 				}
 				characterMemory[cursorYLocation][cursorXLocation].AttributeEntry = types.NewAttributeEntry(&currentAttributeEntry)
 				characterMemory[cursorYLocation][cursorXLocation].Character = ' '
+				layerEntry.MarkDirtyRegion(cursorXLocation, cursorYLocation, 1, 1)
 			}
 
 			if characterMemory[cursorYLocation][cursorXLocation].AttributeEntry.IsBackgroundTransparent {
@@ -126,6 +126,27 @@ var Dropdowns = memory.NewControlMemoryManager[types.DropdownEntryType]()
 // REGULAR ENTRY
 // ============================================================================
 
+/*
+updateTrayBounds is a method which allows you to place the tray of a dropdown, along with its scroll bar, just below
+and inside the dropdown, based on its current position. If the dropdown no longer exists, then no operation takes
+place.
+
+Example:
+
+	Dropdown.updateTrayBounds("layer1", "myDropdown")
+*/
+func (shared *dropdownType) updateTrayBounds(layerAlias string, dropdownAlias string) {
+	dropdownEntry := Dropdowns.Get(layerAlias, dropdownAlias)
+	if dropdownEntry == nil || !Selectors.IsExists(layerAlias, dropdownEntry.SelectorAlias) {
+		return
+	}
+	selectorEntry := Selectors.Get(layerAlias, dropdownEntry.SelectorAlias)
+	// Here we add +1 to x and y to account for borders around the selection.
+	selectorEntry.XLocation = dropdownEntry.XLocation + 1
+	selectorEntry.YLocation = dropdownEntry.YLocation + 1
+	Selector.updateScrollbarBounds(layerAlias, dropdownEntry.SelectorAlias)
+}
+
 /*
 Delete is a method which removes a dropdown from a text layer. In addition, the following should be noted:
 
@@ -0,0 +1,39 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"unicode"
+)
+
+/*
+isWordCharacter is a method which allows you to detect if a character forms part of a word, as opposed to whitespace
+or punctuation.
+
+Example:
+
+	isWordCharacter('a')
+*/
+func isWordCharacter(character rune) bool {
+	return unicode.IsLetter(character) || unicode.IsDigit(character) || character == '_'
+}
+
+/*
+isWordBoundaryEdit is a method which allows you to detect if typing a character should begin a new undo transaction.
+In addition, the following should be noted:
+
+  - Typing is grouped into word sized transactions. A new transaction begins whenever a word character is typed
+    directly after a character which is not part of a word, such as a space or the start of a line.
+
+  - Any edit other than an insert never begins a transaction because of a word boundary, since those are grouped by
+    type instead.
+
+Example:
+
+	isWordBoundaryEdit(constants.EditTypeInsert, ' ', 'a')
+*/
+func isWordBoundaryEdit(editType int, previousCharacter rune, insertedCharacter rune) bool {
+	if editType != constants.EditTypeInsert {
+		return false
+	}
+	return isWordCharacter(insertedCharacter) && !isWordCharacter(previousCharacter)
+}
@@ -0,0 +1,962 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell/v2"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+)
+
+/*
+EventHandlerType is a type which represents a callback that is invoked when a control event occurs. The control which
+triggered the event is passed in so that a single handler can be shared by multiple controls.
+*/
+type EventHandlerType func(control *BaseControlInstanceType)
+
+/*
+eventHandlerEntryType is a structure which holds all event handlers registered for a single control.
+*/
+type eventHandlerEntryType struct {
+	control  BaseControlInstanceType
+	onPress  EventHandlerType
+	onChange EventHandlerType
+	onFocus  EventHandlerType
+	onBlur   EventHandlerType
+	onSubmit EventHandlerType
+}
+
+/*
+eventHandlers is a variable which holds the event handlers registered for each control, grouped by layer.
+*/
+var eventHandlers = memory.NewControlMemoryManager[eventHandlerEntryType]()
+
+/*
+OnPress is a method which allows you to register a handler that is called whenever the control is pressed. In
+addition, the following should be noted:
+
+- Only buttons generate press events.
+
+- Registering a new handler replaces any handler registered previously. Passing in nil removes it.
+
+- Polling methods such as IsPressed continue to work as normal.
+
+Example:
+
+	button.OnPress(func(control *BaseControlInstanceType) { isSaveRequested = true })
+*/
+func (shared *BaseControlInstanceType) OnPress(handler EventHandlerType) *BaseControlInstanceType {
+	shared.getEventHandlerEntry().onPress = handler
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the value of the control is changed
+by the user. In addition, the following should be noted:
+
+- Changes made programmatically, such as by calling SetValue, do not trigger this handler.
+
+- The handler is called once per keyboard or mouse event, after all controls have processed it.
+
+- Registering a new handler replaces any handler registered previously. Passing in nil removes it.
+
+Example:
+
+	checkbox.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *BaseControlInstanceType) OnChange(handler EventHandlerType) *BaseControlInstanceType {
+	shared.getEventHandlerEntry().onChange = handler
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the control gains focus.
+
+Example:
+
+	textField.OnFocus(func(control *BaseControlInstanceType) { statusLabel.SetValue("Enter a name") })
+*/
+func (shared *BaseControlInstanceType) OnFocus(handler EventHandlerType) *BaseControlInstanceType {
+	shared.getEventHandlerEntry().onFocus = handler
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the control loses focus.
+
+Example:
+
+	textField.OnBlur(func(control *BaseControlInstanceType) { validateForm() })
+*/
+func (shared *BaseControlInstanceType) OnBlur(handler EventHandlerType) *BaseControlInstanceType {
+	shared.getEventHandlerEntry().onBlur = handler
+	return shared
+}
+
+/*
+OnSubmit is a method which allows you to register a handler that is called whenever the user confirms the control. In
+addition, the following should be noted:
+
+- Text fields are submitted when enter is pressed while they have focus.
+
+- Selectors are submitted when enter is pressed to select the highlighted item.
+
+Example:
+
+	textField.OnSubmit(func(control *BaseControlInstanceType) { login() })
+*/
+func (shared *BaseControlInstanceType) OnSubmit(handler EventHandlerType) *BaseControlInstanceType {
+	shared.getEventHandlerEntry().onSubmit = handler
+	return shared
+}
+
+/*
+OnPress is a method which allows you to register a handler that is called whenever the button is pressed. It behaves the
+same as BaseControlInstanceType.OnPress, but returns the button instance so that calls can be chained.
+
+Example:
+
+	button.OnPress(func(control *BaseControlInstanceType) { isSaveRequested = true })
+*/
+func (shared *ButtonInstanceType) OnPress(handler EventHandlerType) *ButtonInstanceType {
+	shared.BaseControlInstanceType.OnPress(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the checkbox has its value changed
+by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the checkbox instance so that calls
+can be chained.
+
+Example:
+
+	checkbox.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *CheckboxInstanceType) OnChange(handler EventHandlerType) *CheckboxInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the radio button has its value
+changed by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the radio button instance so
+that calls can be chained.
+
+Example:
+
+	radioButton.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *RadioButtonInstanceType) OnChange(handler EventHandlerType) *RadioButtonInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the dropdown has its value changed
+by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the dropdown instance so that calls
+can be chained.
+
+Example:
+
+	dropdown.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *DropdownInstanceType) OnChange(handler EventHandlerType) *DropdownInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the selector has its value changed
+by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the selector instance so that calls
+can be chained.
+
+Example:
+
+	selector.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *SelectorInstanceType) OnChange(handler EventHandlerType) *SelectorInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the scrollbar has its value changed
+by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the scrollbar instance so that calls
+can be chained.
+
+Example:
+
+	scrollbar.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *ScrollbarInstanceType) OnChange(handler EventHandlerType) *ScrollbarInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the slider has its value changed by
+the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the slider instance so that calls can be
+chained.
+
+Example:
+
+	slider.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *SliderInstanceType) OnChange(handler EventHandlerType) *SliderInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the spinner has its value changed by
+the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the spinner instance so that calls can be
+chained.
+
+Example:
+
+	spinner.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *SpinnerInstanceType) OnChange(handler EventHandlerType) *SpinnerInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the text field has its value changed
+by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the text field instance so that calls
+can be chained.
+
+Example:
+
+	textField.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *TextFieldInstanceType) OnChange(handler EventHandlerType) *TextFieldInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the textbox has its value changed by
+the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the textbox instance so that calls can be
+chained.
+
+Example:
+
+	textbox.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *TextboxInstanceType) OnChange(handler EventHandlerType) *TextboxInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the tree view has its value changed
+by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the tree view instance so that calls
+can be chained.
+
+Example:
+
+	treeView.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *TreeViewInstanceType) OnChange(handler EventHandlerType) *TreeViewInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the table has its value changed by
+the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the table instance so that calls can be
+chained.
+
+Example:
+
+	table.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *TableInstanceType) OnChange(handler EventHandlerType) *TableInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnChange is a method which allows you to register a handler that is called whenever the tab control has its value
+changed by the user. It behaves the same as BaseControlInstanceType.OnChange, but returns the tab control instance so
+that calls can be chained.
+
+Example:
+
+	tabControl.OnChange(func(control *BaseControlInstanceType) { isDirty = true })
+*/
+func (shared *TabControlInstanceType) OnChange(handler EventHandlerType) *TabControlInstanceType {
+	shared.BaseControlInstanceType.OnChange(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the button gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the button instance so that calls can be chained.
+
+Example:
+
+	button.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *ButtonInstanceType) OnFocus(handler EventHandlerType) *ButtonInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the checkbox gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the checkbox instance so that calls can be chained.
+
+Example:
+
+	checkbox.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *CheckboxInstanceType) OnFocus(handler EventHandlerType) *CheckboxInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the dropdown gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the dropdown instance so that calls can be chained.
+
+Example:
+
+	dropdown.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *DropdownInstanceType) OnFocus(handler EventHandlerType) *DropdownInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the radio button gains focus. It
+behaves the same as BaseControlInstanceType.OnFocus, but returns the radio button instance so that calls can be chained.
+
+Example:
+
+	radioButton.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *RadioButtonInstanceType) OnFocus(handler EventHandlerType) *RadioButtonInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the scrollbar gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the scrollbar instance so that calls can be chained.
+
+Example:
+
+	scrollbar.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *ScrollbarInstanceType) OnFocus(handler EventHandlerType) *ScrollbarInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the selector gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the selector instance so that calls can be chained.
+
+Example:
+
+	selector.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *SelectorInstanceType) OnFocus(handler EventHandlerType) *SelectorInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the slider gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the slider instance so that calls can be chained.
+
+Example:
+
+	slider.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *SliderInstanceType) OnFocus(handler EventHandlerType) *SliderInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the spinner gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the spinner instance so that calls can be chained.
+
+Example:
+
+	spinner.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *SpinnerInstanceType) OnFocus(handler EventHandlerType) *SpinnerInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the text field gains focus. It
+behaves the same as BaseControlInstanceType.OnFocus, but returns the text field instance so that calls can be chained.
+
+Example:
+
+	textField.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *TextFieldInstanceType) OnFocus(handler EventHandlerType) *TextFieldInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the textbox gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the textbox instance so that calls can be chained.
+
+Example:
+
+	textbox.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *TextboxInstanceType) OnFocus(handler EventHandlerType) *TextboxInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the tree view gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the tree view instance so that calls can be chained.
+
+Example:
+
+	treeView.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *TreeViewInstanceType) OnFocus(handler EventHandlerType) *TreeViewInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the table gains focus. It behaves the
+same as BaseControlInstanceType.OnFocus, but returns the table instance so that calls can be chained.
+
+Example:
+
+	table.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *TableInstanceType) OnFocus(handler EventHandlerType) *TableInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the tab control gains focus. It
+behaves the same as BaseControlInstanceType.OnFocus, but returns the tab control instance so that calls can be chained.
+
+Example:
+
+	tabControl.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *TabControlInstanceType) OnFocus(handler EventHandlerType) *TabControlInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnFocus is a method which allows you to register a handler that is called whenever the viewport gains focus. It behaves
+the same as BaseControlInstanceType.OnFocus, but returns the viewport instance so that calls can be chained.
+
+Example:
+
+	viewport.OnFocus(func(control *BaseControlInstanceType) { showHelp() })
+*/
+func (shared *ViewportInstanceType) OnFocus(handler EventHandlerType) *ViewportInstanceType {
+	shared.BaseControlInstanceType.OnFocus(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the button loses focus. It behaves the
+same as BaseControlInstanceType.OnBlur, but returns the button instance so that calls can be chained.
+
+Example:
+
+	button.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *ButtonInstanceType) OnBlur(handler EventHandlerType) *ButtonInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the checkbox loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the checkbox instance so that calls can be chained.
+
+Example:
+
+	checkbox.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *CheckboxInstanceType) OnBlur(handler EventHandlerType) *CheckboxInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the dropdown loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the dropdown instance so that calls can be chained.
+
+Example:
+
+	dropdown.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *DropdownInstanceType) OnBlur(handler EventHandlerType) *DropdownInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the radio button loses focus. It
+behaves the same as BaseControlInstanceType.OnBlur, but returns the radio button instance so that calls can be chained.
+
+Example:
+
+	radioButton.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *RadioButtonInstanceType) OnBlur(handler EventHandlerType) *RadioButtonInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the scrollbar loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the scrollbar instance so that calls can be chained.
+
+Example:
+
+	scrollbar.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *ScrollbarInstanceType) OnBlur(handler EventHandlerType) *ScrollbarInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the selector loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the selector instance so that calls can be chained.
+
+Example:
+
+	selector.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *SelectorInstanceType) OnBlur(handler EventHandlerType) *SelectorInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the slider loses focus. It behaves the
+same as BaseControlInstanceType.OnBlur, but returns the slider instance so that calls can be chained.
+
+Example:
+
+	slider.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *SliderInstanceType) OnBlur(handler EventHandlerType) *SliderInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the spinner loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the spinner instance so that calls can be chained.
+
+Example:
+
+	spinner.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *SpinnerInstanceType) OnBlur(handler EventHandlerType) *SpinnerInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the text field loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the text field instance so that calls can be chained.
+
+Example:
+
+	textField.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *TextFieldInstanceType) OnBlur(handler EventHandlerType) *TextFieldInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the textbox loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the textbox instance so that calls can be chained.
+
+Example:
+
+	textbox.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *TextboxInstanceType) OnBlur(handler EventHandlerType) *TextboxInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the tree view loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the tree view instance so that calls can be chained.
+
+Example:
+
+	treeView.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *TreeViewInstanceType) OnBlur(handler EventHandlerType) *TreeViewInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the table loses focus. It behaves the
+same as BaseControlInstanceType.OnBlur, but returns the table instance so that calls can be chained.
+
+Example:
+
+	table.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *TableInstanceType) OnBlur(handler EventHandlerType) *TableInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the tab control loses focus. It
+behaves the same as BaseControlInstanceType.OnBlur, but returns the tab control instance so that calls can be chained.
+
+Example:
+
+	tabControl.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *TabControlInstanceType) OnBlur(handler EventHandlerType) *TabControlInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnBlur is a method which allows you to register a handler that is called whenever the viewport loses focus. It behaves
+the same as BaseControlInstanceType.OnBlur, but returns the viewport instance so that calls can be chained.
+
+Example:
+
+	viewport.OnBlur(func(control *BaseControlInstanceType) { hideHelp() })
+*/
+func (shared *ViewportInstanceType) OnBlur(handler EventHandlerType) *ViewportInstanceType {
+	shared.BaseControlInstanceType.OnBlur(handler)
+	return shared
+}
+
+/*
+OnSubmit is a method which allows you to register a handler that is called whenever the text field is confirmed by the
+user. It behaves the same as BaseControlInstanceType.OnSubmit, but returns the text field instance so that calls can be
+chained.
+
+Example:
+
+	textField.OnSubmit(func(control *BaseControlInstanceType) { submitForm() })
+*/
+func (shared *TextFieldInstanceType) OnSubmit(handler EventHandlerType) *TextFieldInstanceType {
+	shared.BaseControlInstanceType.OnSubmit(handler)
+	return shared
+}
+
+/*
+OnSubmit is a method which allows you to register a handler that is called whenever the selector is confirmed by the
+user. It behaves the same as BaseControlInstanceType.OnSubmit, but returns the selector instance so that calls can be
+chained.
+
+Example:
+
+	selector.OnSubmit(func(control *BaseControlInstanceType) { submitForm() })
+*/
+func (shared *SelectorInstanceType) OnSubmit(handler EventHandlerType) *SelectorInstanceType {
+	shared.BaseControlInstanceType.OnSubmit(handler)
+	return shared
+}
+
+/*
+OnSubmit is a method which allows you to register a handler that is called whenever the tree view is confirmed by the
+user. It behaves the same as BaseControlInstanceType.OnSubmit, but returns the tree view instance so that calls can be
+chained.
+
+Example:
+
+	treeView.OnSubmit(func(control *BaseControlInstanceType) { submitForm() })
+*/
+func (shared *TreeViewInstanceType) OnSubmit(handler EventHandlerType) *TreeViewInstanceType {
+	shared.BaseControlInstanceType.OnSubmit(handler)
+	return shared
+}
+
+/*
+OnSubmit is a method which allows you to register a handler that is called whenever the table is confirmed by the user.
+It behaves the same as BaseControlInstanceType.OnSubmit, but returns the table instance so that calls can be chained.
+
+Example:
+
+	table.OnSubmit(func(control *BaseControlInstanceType) { submitForm() })
+*/
+func (shared *TableInstanceType) OnSubmit(handler EventHandlerType) *TableInstanceType {
+	shared.BaseControlInstanceType.OnSubmit(handler)
+	return shared
+}
+
+/*
+getEventHandlerEntry is a method which allows you to obtain the event handlers registered for a control. If none
+exist yet, a new empty entry is created.
+
+Example:
+
+	handlerEntry := control.getEventHandlerEntry()
+*/
+func (shared *BaseControlInstanceType) getEventHandlerEntry() *eventHandlerEntryType {
+	if !eventHandlers.IsExists(shared.layerAlias, shared.controlAlias) {
+		handlerEntry := eventHandlerEntryType{control: *shared}
+		eventHandlers.Add(shared.layerAlias, shared.controlAlias, &handlerEntry)
+	}
+	return eventHandlers.Get(shared.layerAlias, shared.controlAlias)
+}
+
+/*
+deleteEventHandlers is a method which allows you to remove all event handlers registered for a control. In addition,
+the following should be noted:
+
+- If no handlers are registered, then no operation will be performed.
+
+Example:
+
+	deleteEventHandlers("layer1", "button1")
+*/
+func deleteEventHandlers(layerAlias string, controlAlias string) {
+	if eventHandlers.IsExists(layerAlias, controlAlias) {
+		eventHandlers.Remove(layerAlias, controlAlias)
+	}
+}
+
+/*
+fireEventHandler is a method which allows you to invoke a registered handler for a control. The selector function
+picks which of the control's handlers should be called. In addition, the following should be noted:
+
+- If the control has no handlers, or the selected handler is nil, then no operation will be performed.
+
+Example:
+
+	fireEventHandler("layer1", "button1", func(entry *eventHandlerEntryType) EventHandlerType { return entry.onPress })
+*/
+func fireEventHandler(layerAlias string, controlAlias string, getHandler func(handlerEntry *eventHandlerEntryType) EventHandlerType) {
+	if !eventHandlers.IsExists(layerAlias, controlAlias) {
+		return
+	}
+	handlerEntry := eventHandlers.Get(layerAlias, controlAlias)
+	if handler := getHandler(handlerEntry); handler != nil {
+		control := handlerEntry.control
+		handler(&control)
+	}
+}
+
+/*
+firePressHandler is a method which allows you to invoke the press handler registered for a control.
+
+Example:
+
+	firePressHandler("layer1", "button1")
+*/
+func firePressHandler(layerAlias string, controlAlias string) {
+	fireEventHandler(layerAlias, controlAlias, func(handlerEntry *eventHandlerEntryType) EventHandlerType {
+		return handlerEntry.onPress
+	})
+}
+
+/*
+fireSubmitHandler is a method which allows you to invoke the submit handler registered for a control.
+
+Example:
+
+	fireSubmitHandler("layer1", "textField1")
+*/
+func fireSubmitHandler(layerAlias string, controlAlias string) {
+	fireEventHandler(layerAlias, controlAlias, func(handlerEntry *eventHandlerEntryType) EventHandlerType {
+		return handlerEntry.onSubmit
+	})
+}
+
+/*
+fireFocusHandlers is a method which allows you to invoke the blur handler of the control which lost focus and the focus
+handler of the control which gained it. In addition, the following should be noted:
+
+- If focus did not move to a different control, then no operation will be performed.
+
+  - The identifiers are resolved to the controls they belong to first, so that the handlers of a spinner are called
+    when the text field it edits with gains or loses focus.
+
+Example:
+
+	fireFocusHandlers(previouslyFocusedControl, eventStateMemory.currentlyFocusedControl)
+*/
+func fireFocusHandlers(previousControl controlIdentifierType, currentControl controlIdentifierType) {
+	if previousControl.layerAlias == currentControl.layerAlias && previousControl.controlAlias == currentControl.controlAlias {
+		return
+	}
+	previousInstance := getControlInstanceFromIdentifier(previousControl)
+	currentInstance := getControlInstanceFromIdentifier(currentControl)
+	fireEventHandler(previousInstance.layerAlias, previousInstance.controlAlias, func(handlerEntry *eventHandlerEntryType) EventHandlerType {
+		return handlerEntry.onBlur
+	})
+	fireEventHandler(currentInstance.layerAlias, currentInstance.controlAlias, func(handlerEntry *eventHandlerEntryType) EventHandlerType {
+		return handlerEntry.onFocus
+	})
+}
+
+/*
+getControlStatesForChangeHandlers is a method which allows you to take a snapshot of the values of every control that
+has a change handler registered. The snapshot is later compared by fireChangeHandlers to detect which controls were
+modified. In addition, the following should be noted:
+
+- Handler entries for controls which no longer exist are removed.
+
+Example:
+
+	controlStates := getControlStatesForChangeHandlers()
+*/
+func getControlStatesForChangeHandlers() map[*eventHandlerEntryType]string {
+	controlStates := make(map[*eventHandlerEntryType]string)
+	for _, handlerEntry := range eventHandlers.GetAllEntriesOverall() {
+		if handlerEntry.onChange == nil {
+			continue
+		}
+		controlState, isControlExists := getControlState(&handlerEntry.control)
+		if !isControlExists {
+			deleteEventHandlers(handlerEntry.control.layerAlias, handlerEntry.control.controlAlias)
+			continue
+		}
+		controlStates[handlerEntry] = controlState
+	}
+	return controlStates
+}
+
+/*
+isEventAbleToChangeControls is a method which allows you to detect if an event could change the value of a control,
+so that the snapshot taken for change handlers can be skipped when it could not. Mouse events are only able to change
+a control if a button or the wheel is in use, or a button held down previously is being released.
+
+Example:
+
+	if isEventAbleToChangeControls(event) {
+		controlStates = getControlStatesForChangeHandlers()
+	}
+*/
+func isEventAbleToChangeControls(event tcell.Event) bool {
+	mouseEvent, isMouseEvent := event.(*tcell.EventMouse)
+	if !isMouseEvent {
+		return true
+	}
+	_, _, buttonPressed, wheelState := GetMouseStatus()
+	return mouseEvent.Buttons() != tcell.ButtonNone || buttonPressed != 0 || wheelState != ""
+}
+
+/*
+fireChangeHandlers is a method which allows you to invoke the change handler of every control whose value differs
+from the snapshot provided.
+
+Example:
+
+	fireChangeHandlers(controlStates)
+*/
+func fireChangeHandlers(controlStates map[*eventHandlerEntryType]string) {
+	for handlerEntry, previousState := range controlStates {
+		currentState, isControlExists := getControlState(&handlerEntry.control)
+		if isControlExists && currentState != previousState && handlerEntry.onChange != nil {
+			control := handlerEntry.control
+			handlerEntry.onChange(&control)
+		}
+	}
+}
+
+/*
+getControlState is a method which allows you to obtain a string representation of the user editable value of a
+control. In addition, the following should be noted:
+
+- If the control no longer exists, false is returned as the second value.
+
+- Controls which have no user editable value always return an empty state.
+
+Example:
+
+	controlState, isControlExists := getControlState(control)
+*/
+func getControlState(control *BaseControlInstanceType) (string, bool) {
+	layerAlias := control.layerAlias
+	controlAlias := control.controlAlias
+	switch control.controlType {
+	case constants.TYPE_BUTTON:
+		return "", Buttons.IsExists(layerAlias, controlAlias)
+	case constants.TYPE_CHECKBOX:
+		if Checkboxes.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%t", Checkboxes.Get(layerAlias, controlAlias).IsSelected), true
+		}
+	case constants.TYPE_RADIOBUTTON:
+		if RadioButtons.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%t", RadioButtons.Get(layerAlias, controlAlias).IsSelected), true
+		}
+	case constants.TYPE_DROPDOWN:
+		if Dropdowns.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%d", Dropdowns.Get(layerAlias, controlAlias).ItemSelected), true
+		}
+	case constants.TYPE_SELECTOR:
+		if Selectors.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%d", Selectors.Get(layerAlias, controlAlias).ItemSelected), true
+		}
+	case constants.TYPE_SCROLLBAR:
+		if ScrollBars.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%d", ScrollBars.Get(layerAlias, controlAlias).ScrollValue), true
+		}
+	case constants.TYPE_PROGRESSBAR:
+		if ProgressBars.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%d", ProgressBars.Get(layerAlias, controlAlias).Value), true
+		}
+	case constants.TYPE_SLIDER:
+		if Sliders.IsExists(layerAlias, controlAlias) {
+			return Slider.getSliderState(Sliders.Get(layerAlias, controlAlias)), true
+		}
+	case constants.TYPE_SPINNER:
+		if Spinners.IsExists(layerAlias, controlAlias) {
+			return fmt.Sprintf("%v", Spinner.getValue(layerAlias, controlAlias)), true
+		}
+	case constants.TYPE_TEXTFIELD:
+		if TextFields.IsExists(layerAlias, controlAlias) {
+			return string(TextFields.Get(layerAlias, controlAlias).CurrentValue), true
+		}
+	case constants.TYPE_TEXTBOX:
+		if Textboxes.IsExists(layerAlias, controlAlias) {
+			textboxInstance := TextboxInstanceType{BaseControlInstanceType: *control}
+			return textboxInstance.GetText(), true
+		}
+	case constants.TYPE_TREEVIEW:
+		if TreeViews.IsExists(layerAlias, controlAlias) {
+			return TreeViews.Get(layerAlias, controlAlias).SelectedNodeAlias, true
+		}
+	case constants.TYPE_TABLE:
+		if Tables.IsExists(layerAlias, controlAlias) {
+			return Table.getTableState(layerAlias, controlAlias), true
+		}
+	case constants.TYPE_TABCONTROL:
+		if TabControls.IsExists(layerAlias, controlAlias) {
+			return TabControl.getTabControlState(TabControls.Get(layerAlias, controlAlias)), true
+		}
+	case constants.TYPE_LABEL:
+		return "", Labels.IsExists(layerAlias, controlAlias)
+	case constants.TYPE_TOOLTIP:
+		return "", Tooltips.IsExists(layerAlias, controlAlias)
+	case constants.TYPE_VIEWPORT:
+		return "", Viewports.IsExists(layerAlias, controlAlias)
+	}
+	return "", false
+}
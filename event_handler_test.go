@@ -0,0 +1,140 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+/*
+TestEventHandlerButtonPress is a test which verifies that a press handler is called when a button is clicked.
+
+Example:
+
+	Expected Inputs:
+	    A mouse click injected over a button.
+
+	Expected Outputs:
+	    The press handler is called once with the button that was pressed, and only mouse events which press,
+	    release, or scroll are treated as able to change a control.
+*/
+func TestEventHandlerButtonPress(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(20, 5)
+	layer1 := AddLayer(0, 0, 20, 5, 1, nil)
+	button := layer1.AddButton("OK", NewTuiStyleEntry(), 2, 1, 8, 3, true)
+	numberOfPresses := 0
+	pressedAlias := ""
+	button.OnPress(func(control *BaseControlInstanceType) {
+		numberOfPresses++
+		pressedAlias = control.GetAlias()
+	}).AddToTabIndex()
+	UpdateDisplay(false)
+	screen.InjectMouse(5, 2, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, 1, numberOfPresses, "The press handler was not called exactly once!")
+	assert.Equalf(test, button.GetAlias(), pressedAlias, "The press handler did not receive the button pressed!")
+	ClearTabIndex()
+
+	SetMouseStatus(5, 2, 0, "")
+	assert.Falsef(test, isEventAbleToChangeControls(tcell.NewEventMouse(6, 2, tcell.ButtonNone, tcell.ModNone)), "Moving the mouse without a button was treated as able to change a control!")
+	assert.Truef(test, isEventAbleToChangeControls(tcell.NewEventMouse(6, 2, tcell.Button1, tcell.ModNone)), "Pressing a mouse button was not treated as able to change a control!")
+	assert.Truef(test, isEventAbleToChangeControls(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone)), "A keystroke was not treated as able to change a control!")
+	SetMouseStatus(5, 2, 1, "")
+	assert.Truef(test, isEventAbleToChangeControls(tcell.NewEventMouse(6, 2, tcell.ButtonNone, tcell.ModNone)), "Releasing a mouse button was not treated as able to change a control!")
+}
+
+/*
+TestEventHandlerFocusChangeAndSubmit is a test which verifies that focus, blur, change, and submit handlers are called
+for text fields and checkboxes.
+
+Example:
+
+	Expected Inputs:
+	    Mouse clicks and keystrokes injected over a text field and a checkbox.
+
+	Expected Outputs:
+	    Each handler is called in response to the matching user action.
+*/
+func TestEventHandlerFocusChangeAndSubmit(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(30, 5)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 30, 5, 1, nil)
+	textField := layer1.AddTextField(styleEntry, 1, 1, 10, 10, false, "", true)
+	checkbox := layer1.AddCheckbox("Check", styleEntry, 1, 3, false, true)
+	var handlerHistory []string
+	textField.OnFocus(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "focus")
+	}).OnBlur(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "blur")
+	}).OnChange(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "change")
+	}).OnSubmit(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "submit")
+	})
+	checkbox.OnChange(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "checkbox")
+	})
+	UpdateDisplay(false)
+	screen.InjectMouse(2, 1, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectMouse(2, 1, tcell.ButtonNone, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectKey(tcell.KeyRune, 'a', tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectMouse(1, 3, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, []string{"focus", "change", "submit", "blur", "checkbox"}, handlerHistory, "The handlers were not called in the expected order!")
+	assert.Truef(test, checkbox.IsSelected(), "The checkbox was not toggled by the injected mouse click!")
+
+	handlerHistory = nil
+	textField.Delete()
+	// Mouse releases are throttled like any other movement, so wait before injecting one.
+	time.Sleep(60 * time.Millisecond)
+	screen.InjectMouse(1, 3, tcell.ButtonNone, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectMouse(1, 3, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, []string{"checkbox"}, handlerHistory, "Handlers for a deleted control should not be called!")
+}
+
+/*
+TestEventHandlerSpinnerFocusAndBlur is a test which verifies that the focus and blur handlers of a spinner are called,
+even though focus is given to the text field it edits with.
+
+Example:
+
+	Expected Inputs:
+	    A mouse click on a spinner, followed by a mouse click on a checkbox.
+
+	Expected Outputs:
+	    The focus handler of the spinner is called by the first click, and its blur handler by the second.
+*/
+func TestEventHandlerSpinnerFocusAndBlur(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(30, 5)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 30, 5, 1, nil)
+	spinnerInstance := layer1.AddSpinner(styleEntry, 1, 1, 10, 0, 10, 1, 5, 0, true)
+	layer1.AddCheckbox("Check", styleEntry, 1, 3, false, true)
+	var handlerHistory []string
+	spinnerInstance.OnFocus(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "focus")
+	}).OnBlur(func(control *BaseControlInstanceType) {
+		handlerHistory = append(handlerHistory, "blur")
+	})
+	UpdateDisplay(false)
+	screen.InjectMouse(2, 1, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, []string{"focus"}, handlerHistory, "Clicking the spinner did not call its focus handler!")
+	// Mouse releases are throttled like any other movement, so wait before injecting one.
+	time.Sleep(60 * time.Millisecond)
+	screen.InjectMouse(2, 1, tcell.ButtonNone, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectMouse(1, 3, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, []string{"focus", "blur"}, handlerHistory, "Clicking away from the spinner did not call its blur handler!")
+}
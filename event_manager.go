@@ -20,10 +20,14 @@ type eventStateType struct {
 	// This variable is used to keep track of items which were highlighted so that they can be
 	// un-highlighted later. Currently, only used by selectors and tooltips
 	previouslyHighlightedControl controlIdentifierType
-	tabIndexMemory               []controlIdentifierType
-	currentTabIndex              int
+	tabIndexMemory               []tabIndexEntryType
 	// Track modifier key states
 	modifierKeys tcell.ModMask
+	// Track how many events are being dispatched, so that events dispatched from within an event handler can be detected
+	dispatchDepth int
+	// Track text received between the start and end of a bracketed paste
+	isPasting  bool
+	pastedText []rune
 }
 
 var eventStateMemory eventStateType
@@ -50,6 +54,10 @@ func UpdatePeriodicEvents() {
 		}
 	}
 
+	if Spinner.updatePeriodicEvent() {
+		UpdateDisplay(false)
+	}
+
 	// Clear key states periodically to handle key releases
 	// This is done more frequently than other periodic events
 	// to ensure responsive keyboard input
@@ -70,15 +78,68 @@ func UpdateEventQueues() {
 		return
 	}
 
-	event := commonResource.screen.PollEvent()
+	dispatchEvent(commonResource.screen.PollEvent())
+}
+
+/*
+dispatchEvent is a method which allows you to route a single terminal event to all controls that are interested in it.
+In addition, the following should be noted:
+
+- Keyboard and mouse events are captured by the event recorder if a recording is in progress.
+
+  - Keystrokes received during a bracketed paste are collected, and pasted into the control with focus all at once
+    when the paste ends.
+
+- Once the event has been processed, the focus, blur, and change handlers of any affected controls are called.
+
+- Mouse movement throttling is skipped while a recording is being replayed, since only events which were originally
+dispatched are ever recorded.
+
+Example:
+
+	dispatchEvent(event)
+*/
+func dispatchEvent(event tcell.Event) {
+	eventStateMemory.dispatchDepth++
+	defer func() {
+		eventStateMemory.dispatchDepth--
+	}()
+	previouslyFocusedControl := eventStateMemory.currentlyFocusedControl
+	var controlStates map[*eventHandlerEntryType]string
+	// Moving the mouse without a button or wheel change can not edit a control, so no snapshot is needed for it.
+	if isEventAbleToChangeControls(event) {
+		controlStates = getControlStatesForChangeHandlers()
+	}
+	defer func() {
+		fireFocusHandlers(previouslyFocusedControl, eventStateMemory.currentlyFocusedControl)
+		fireChangeHandlers(controlStates)
+	}()
 	switch event := event.(type) {
 	case *tcell.EventResize:
-		commonResource.screen.Sync()
+		resizeTerminal(event.Size())
+		UpdateDisplay(true)
+	case *tcell.EventPaste:
+		recordEvent(event)
+		if event.Start() {
+			eventStateMemory.isPasting = true
+			eventStateMemory.pastedText = nil
+		} else if eventStateMemory.isPasting {
+			eventStateMemory.isPasting = false
+			if pasteText(string(eventStateMemory.pastedText)) {
+				UpdateDisplay(false)
+			}
+		}
 	case *tcell.EventKey:
 		isScreenUpdateRequired := false
 		isKeystrokeConsumed := false
 		var keystroke []rune
 
+		recordEvent(event)
+		// Keystrokes which make up a bracketed paste are collected, so that they can be inserted all at once.
+		if eventStateMemory.isPasting {
+			addPastedKeystroke(event)
+			return
+		}
 		// Update modifier key state
 		eventStateMemory.modifierKeys = event.Modifiers()
 
@@ -87,35 +148,125 @@ func UpdateEventQueues() {
 		} else {
 			keystroke = []rune(strings.ToLower(event.Name()))
 		}
-		if string(keystroke) == "tab" {
-			nextTabIndex()
+		// File menus come first, so that their headings can be opened with alt no matter which control has focus.
+		if updateRequired, consumed := FileMenu.updateKeyboardEvent(keystroke); consumed {
 			keystroke = nil
-			isScreenUpdateRequired = true
+			isScreenUpdateRequired = updateRequired
 			isKeystrokeConsumed = true
 		}
-		if updateRequired, consumed := scrollbar.updateKeyboardEvent(keystroke); updateRequired {
-			isScreenUpdateRequired = true
-			isKeystrokeConsumed = consumed
+		// While a context menu is open, it captures every keystroke.
+		if updateRequired, consumed := contextMenu.updateKeyboardEvent(keystroke); consumed {
+			keystroke = nil
+			isScreenUpdateRequired = updateRequired
+			isKeystrokeConsumed = true
 		}
-		if updateRequired, consumed := TextField.updateKeyboardEvent(keystroke); updateRequired {
-			isScreenUpdateRequired = true
-			isKeystrokeConsumed = consumed
+		// Once a shortcut chord has been started, the rest of it is never passed on to controls.
+		if shortcutRegistry.isChordInProgress() {
+			if updateRequired, consumed := shortcutRegistry.updateKeyboardEvent(keystroke); consumed {
+				keystroke = nil
+				isScreenUpdateRequired = updateRequired
+				isKeystrokeConsumed = true
+			}
 		}
-		if updateRequired, consumed := textbox.UpdateKeyboardEvent(keystroke); updateRequired {
+		// When a text field is showing suggestions, tab accepts the one highlighted instead of moving focus.
+		if string(keystroke) == "tab" && !TextField.isFocusedSuggestionTrayOpen() {
+			nextTabIndex()
+			keystroke = nil
 			isScreenUpdateRequired = true
-			isKeystrokeConsumed = consumed
+			isKeystrokeConsumed = true
 		}
-		if updateRequired, consumed := Selector.updateKeyboardEvent(keystroke); updateRequired {
+		// Depending on the terminal, shift+tab is reported either as a back tab or as tab with shift held down.
+		if string(keystroke) == "backtab" || string(keystroke) == "shift+backtab" || string(keystroke) == "shift+tab" {
+			previousTabIndex()
+			keystroke = nil
 			isScreenUpdateRequired = true
-			isKeystrokeConsumed = consumed
+			isKeystrokeConsumed = true
 		}
-		if updateRequired, consumed := Dropdown.updateKeyboardEvent(keystroke); updateRequired {
-			isScreenUpdateRequired = true
-			isKeystrokeConsumed = consumed
+		// While a modal layer is shown, only controls on it are allowed to process keystrokes.
+		if isLayerWithinActiveModal(eventStateMemory.currentlyFocusedControl.layerAlias) {
+			if updateRequired, consumed := scrollbar.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := Slider.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := Spinner.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+				// Keystrokes used by a spinner should not also be processed by its text field.
+				if consumed {
+					keystroke = nil
+				}
+			}
+			focusedControl := eventStateMemory.currentlyFocusedControl
+			if updateRequired, consumed := TextField.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			// Submitting a text field can close the dialog it is on, so its keystroke must not reach the control
+			// focused afterwards.
+			if eventStateMemory.currentlyFocusedControl != focusedControl {
+				keystroke = nil
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = true
+			}
+			if updateRequired, consumed := textbox.UpdateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := viewport.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := radioButton.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := Selector.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := TreeView.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := Table.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := TabControl.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
+			if updateRequired, consumed := Dropdown.updateKeyboardEvent(keystroke); updateRequired {
+				isScreenUpdateRequired = true
+				isKeystrokeConsumed = consumed
+			}
 		}
-		if updateRequired, consumed := FileMenu.updateKeyboardEvent(keystroke); updateRequired {
-			isScreenUpdateRequired = true
-			isKeystrokeConsumed = consumed
+		// While a ready-made dialog is shown, keystrokes no control used select its buttons instead of reaching shortcuts.
+		if !isKeystrokeConsumed {
+			if updateRequired, consumed := updateModalDialogKeyboardEvent(keystroke); consumed {
+				keystroke = nil
+				isScreenUpdateRequired = isScreenUpdateRequired || updateRequired
+				isKeystrokeConsumed = true
+			}
+		}
+		// Shortcuts only receive keystrokes which no control used.
+		if !isKeystrokeConsumed {
+			if updateRequired, consumed := shortcutRegistry.updateKeyboardEvent(keystroke); consumed {
+				keystroke = nil
+				isScreenUpdateRequired = isScreenUpdateRequired || updateRequired
+				isKeystrokeConsumed = true
+			}
+		}
+		if !isKeystrokeConsumed {
+			if updateRequired, consumed := FileMenu.updateShortcutKeyboardEvent(keystroke); consumed {
+				keystroke = nil
+				isScreenUpdateRequired = isScreenUpdateRequired || updateRequired
+				isKeystrokeConsumed = true
+			}
 		}
 		if isScreenUpdateRequired == true {
 			UpdateDisplay(false)
@@ -147,16 +298,36 @@ func UpdateEventQueues() {
 		isScreenUpdateRequired := false
 
 		// Throttle mouse movement events (when no button is pressed)
-		// Skip processing if not enough time has passed since the last event
-		if mouseButtonNumber == 0 && wheelState == "" || (eventStateMemory.stateId == constants.EventStateDragAndDropScrollbar) {
+		// Skip processing if not enough time has passed since the last event. Releasing a button is never skipped,
+		// since otherwise the next click could not be told apart from a drag.
+		_, _, currentButtonPressed, _ := GetMouseStatus()
+		isButtonReleased := mouseButtonNumber == 0 && currentButtonPressed != 0
+		if !isEventReplayInProgress() && !isButtonReleased && (mouseButtonNumber == 0 && wheelState == "" || (eventStateMemory.stateId == constants.EventStateDragAndDropScrollbar)) {
 			elapsedTime := time.Since(lastMouseMoveTime)
 			if elapsedTime < 50*time.Millisecond {
 				return
 			}
 			lastMouseMoveTime = time.Now()
 		}
+		recordEvent(event)
+		// Update modifier key state, so that clicks made while holding shift can extend a selection.
+		eventStateMemory.modifierKeys = event.Modifiers()
 
 		SetMouseStatus(mouseXLocation, mouseYLocation, mouseButtonNumber, wheelState)
+		// Context menus are drawn above every layer, including modal ones, so they are given the event first. The one
+		// exception is a file menu with its menu open, whose headings must keep working while it is.
+		if FileMenu.updateOpenMenuMouseEvent() {
+			UpdateDisplay(false)
+			return
+		}
+		if contextMenu.updateMouseEvent() {
+			UpdateDisplay(false)
+			return
+		}
+		// Clicks outside a modal layer are ignored entirely, so they can neither raise nor focus anything behind it.
+		if isMouseInputBlockedByModal(mouseXLocation, mouseYLocation, mouseButtonNumber != 0 || wheelState != "") {
+			return
+		}
 		bringLayerToFrontIfRequired()
 		if moveLayerIfRequired() {
 			isScreenUpdateRequired = true
@@ -176,6 +347,9 @@ func UpdateEventQueues() {
 		if TextField.updateMouseEvent() {
 			isScreenUpdateRequired = true
 		}
+		if Spinner.updateMouseEvent() {
+			isScreenUpdateRequired = true
+		}
 		if FileMenu.updateStateMouse() {
 			isScreenUpdateRequired = true
 		}
@@ -203,6 +377,18 @@ func UpdateEventQueues() {
 			buttonHistory.buttonAlias = ""
 			isScreenUpdateRequired = true
 		}
+		if Slider.updateMouseEvent() {
+			isScreenUpdateRequired = true
+		}
+		if TreeView.updateMouseEvent() {
+			isScreenUpdateRequired = true
+		}
+		if Table.updateMouseEvent() {
+			isScreenUpdateRequired = true
+		}
+		if TabControl.updateMouseEvent() {
+			isScreenUpdateRequired = true
+		}
 		// LogInfo("mouse event selector" + time.Now().String())
 		if textbox.updateMouseEvent() {
 			isScreenUpdateRequired = true
@@ -226,46 +412,6 @@ func UpdateEventQueues() {
 	}
 }
 
-/*
-ClearTabIndex is a method which clears all registered tab index entries from memory.
-
-Example:
-
-	ClearTabIndex()
-*/
-func ClearTabIndex() {
-	eventStateMemory.tabIndexMemory = nil
-}
-
-/*
-addTabIndex is a method which registers a new control in the tab index memory for sequential navigation.
-
-Example:
-
-	addTabIndex("layer1", "button1", constants.CellTypeButton)
-*/
-func addTabIndex(layerAlias string, controlAlias string, controlType int) {
-	controlEntry := controlIdentifierType{layerAlias: layerAlias, controlAlias: controlAlias, controlType: controlType}
-	eventStateMemory.tabIndexMemory = append(eventStateMemory.tabIndexMemory, controlEntry)
-}
-
-/*
-nextTabIndex is a method which advances the focus to the next control in the registered tab index sequence.
-
-Example:
-
-	nextTabIndex()
-*/
-func nextTabIndex() {
-	eventStateMemory.currentTabIndex++
-	if eventStateMemory.currentTabIndex >= len(eventStateMemory.tabIndexMemory) {
-		eventStateMemory.currentTabIndex = 0
-	}
-	if len(eventStateMemory.tabIndexMemory) != 0 {
-		eventStateMemory.currentlyFocusedControl = eventStateMemory.tabIndexMemory[eventStateMemory.currentTabIndex]
-	}
-}
-
 /*
 setFocusedControl is a method which explicitly sets which control currently has focus.
 
@@ -437,6 +583,67 @@ func getCellInformationUnderMouseCursor(mouseXLocation int, mouseYLocation int)
 	return characterEntry
 }
 
+/*
+addPastedKeystroke is a method which allows you to add a keystroke received during a bracketed paste to the text
+being pasted. Line breaks and tabs arrive as named keys, so they are turned back into characters, while any other
+named key is ignored.
+
+Example:
+
+	addPastedKeystroke(event)
+*/
+func addPastedKeystroke(event *tcell.EventKey) {
+	switch event.Key() {
+	case tcell.KeyRune:
+		eventStateMemory.pastedText = append(eventStateMemory.pastedText, event.Rune())
+	case tcell.KeyEnter, tcell.KeyLF:
+		eventStateMemory.pastedText = append(eventStateMemory.pastedText, '\n')
+	case tcell.KeyTab:
+		eventStateMemory.pastedText = append(eventStateMemory.pastedText, '\t')
+	}
+}
+
+/*
+pasteText is a method which allows you to paste text into the control which currently has focus, as a single edit
+which can be undone all at once. In addition, the following should be noted:
+
+- Only text fields and textboxes accept pasted text, and only while they are not hidden behind a modal layer.
+
+  - If no such control has focus, the text is added to the keyboard buffer as if it had been typed, with line breaks
+    turned into enter keystrokes.
+
+- Returns true if the text was pasted into a control, and the screen needs to be updated.
+
+Example:
+
+	isScreenUpdateRequired := pasteText("Hello")
+*/
+func pasteText(text string) bool {
+	focusedControl := eventStateMemory.currentlyFocusedControl
+	if isLayerWithinActiveModal(focusedControl.layerAlias) {
+		switch focusedControl.controlType {
+		case constants.CellTypeTextField:
+			if TextFields.IsExists(focusedControl.layerAlias, focusedControl.controlAlias) {
+				TextField.pasteText(focusedControl.layerAlias, focusedControl.controlAlias, text)
+				return true
+			}
+		case constants.CellTypeTextbox:
+			if Textboxes.IsExists(focusedControl.layerAlias, focusedControl.controlAlias) {
+				textbox.pasteText(focusedControl.layerAlias, focusedControl.controlAlias, text)
+				return true
+			}
+		}
+	}
+	for _, currentCharacter := range text {
+		if currentCharacter == '\n' {
+			KeyboardMemory.AddToBuffer([]rune("enter"))
+		} else {
+			KeyboardMemory.AddToBuffer([]rune{currentCharacter})
+		}
+	}
+	return false
+}
+
 /*
 IsModifierKeyPressed is a method which checks if a specific modifier key is currently pressed.
 
@@ -0,0 +1,230 @@
+package consolizer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gdamore/tcell/v2"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"sync"
+	"time"
+)
+
+/*
+eventRecorderType is a structure which holds the state of the current event recording or replay session.
+*/
+type eventRecorderType struct {
+	isRecording    bool
+	isReplaying    bool
+	startTime      time.Time
+	recordedEvents []types.RecordedEventEntryType
+	mutex          sync.Mutex
+}
+
+/*
+eventRecorderMemory is a variable which holds the state of the current event recording or replay session.
+*/
+var eventRecorderMemory eventRecorderType
+
+/*
+StartEventRecording is a method which allows you to begin capturing every keyboard and mouse event that is dispatched
+to your controls. This is useful for reproducing user sessions exactly, or for turning them into regression tests. In
+addition, the following should be noted:
+
+- Any events recorded previously which were not saved are discarded.
+
+- Each event is stamped with the number of milliseconds elapsed since the recording was started.
+
+- Mouse movements which are throttled by the event manager are never recorded, since they never reach your controls.
+
+- The start and end of each bracketed paste are recorded too, so that pasted text is replayed as a single paste.
+
+Example:
+
+	StartEventRecording()
+*/
+func StartEventRecording() {
+	eventRecorderMemory.mutex.Lock()
+	defer eventRecorderMemory.mutex.Unlock()
+	eventRecorderMemory.recordedEvents = make([]types.RecordedEventEntryType, 0)
+	eventRecorderMemory.startTime = time.Now()
+	eventRecorderMemory.isRecording = true
+}
+
+/*
+StopEventRecording is a method which allows you to stop capturing events and save everything recorded so far to the
+file specified. In addition, the following should be noted:
+
+- Recordings are always written to the local file system, since virtual file systems are read-only.
+
+- If the file already exists, it will be overwritten.
+
+- If no recording is in progress, an error is returned instead.
+
+Example:
+
+	err := StopEventRecording("/tmp/session.json")
+*/
+func StopEventRecording(fileName string) error {
+	eventRecorderMemory.mutex.Lock()
+	defer eventRecorderMemory.mutex.Unlock()
+	if !eventRecorderMemory.isRecording {
+		return errors.New("No event recording is currently in progress.")
+	}
+	eventRecorderMemory.isRecording = false
+	recordingData, err := json.MarshalIndent(eventRecorderMemory.recordedEvents, "", "\t")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Could not encode the event recording: %s", err.Error()))
+	}
+	eventRecorderMemory.recordedEvents = nil
+	return writeFileDataToFileSystem(fileName, recordingData, 0)
+}
+
+/*
+IsEventRecordingInProgress is a method which allows you to detect if events are currently being recorded.
+
+Example:
+
+	isRecording := IsEventRecordingInProgress()
+*/
+func IsEventRecordingInProgress() bool {
+	eventRecorderMemory.mutex.Lock()
+	defer eventRecorderMemory.mutex.Unlock()
+	return eventRecorderMemory.isRecording
+}
+
+/*
+ReplayEventRecording is a method which allows you to feed a previously saved event recording back through the same
+dispatch path used for live terminal events. In addition, the following should be noted:
+
+- If a virtual file system is mounted, the recording will be read from it instead of your local file system.
+
+  - If isPlaybackTimed is true, the delay between each event is reproduced as originally recorded. Otherwise, events
+    are dispatched as fast as possible, which is preferred when running regression tests.
+
+- This method blocks until every event in the recording has been dispatched.
+
+- If the recording cannot be read or decoded, an error is returned and no events are dispatched.
+
+Example:
+
+	err := ReplayEventRecording("/tmp/session.json", false)
+*/
+func ReplayEventRecording(fileName string, isPlaybackTimed bool) error {
+	recordingData, err := getFileDataFromFileSystem(fileName)
+	if err != nil {
+		return err
+	}
+	var recordedEvents []types.RecordedEventEntryType
+	if err = json.Unmarshal(recordingData, &recordedEvents); err != nil {
+		return errors.New(fmt.Sprintf("Could not decode the event recording '%s': %s", fileName, err.Error()))
+	}
+	setEventReplayInProgress(true)
+	defer setEventReplayInProgress(false)
+	previousElapsedTime := int64(0)
+	for _, currentRecordedEvent := range recordedEvents {
+		if isPlaybackTimed && currentRecordedEvent.ElapsedTime > previousElapsedTime {
+			time.Sleep(time.Duration(currentRecordedEvent.ElapsedTime-previousElapsedTime) * time.Millisecond)
+		}
+		previousElapsedTime = currentRecordedEvent.ElapsedTime
+		event := getEventFromRecordedEvent(currentRecordedEvent)
+		if event != nil {
+			dispatchEvent(event)
+		}
+	}
+	return nil
+}
+
+/*
+setEventReplayInProgress is a method which allows you to mark whether a recording is currently being replayed.
+
+Example:
+
+	setEventReplayInProgress(true)
+*/
+func setEventReplayInProgress(isReplaying bool) {
+	eventRecorderMemory.mutex.Lock()
+	defer eventRecorderMemory.mutex.Unlock()
+	eventRecorderMemory.isReplaying = isReplaying
+}
+
+/*
+isEventReplayInProgress is a method which allows you to detect if a recording is currently being replayed, which may
+happen on a different goroutine from the one dispatching events.
+
+Example:
+
+	if isEventReplayInProgress() {
+		return
+	}
+*/
+func isEventReplayInProgress() bool {
+	eventRecorderMemory.mutex.Lock()
+	defer eventRecorderMemory.mutex.Unlock()
+	return eventRecorderMemory.isReplaying
+}
+
+/*
+recordEvent is a method which allows you to capture a keyboard, mouse, or bracketed paste event if a recording is in
+progress. In addition, the following should be noted:
+
+- Events of any other type are ignored.
+
+Example:
+
+	recordEvent(event)
+*/
+func recordEvent(event tcell.Event) {
+	eventRecorderMemory.mutex.Lock()
+	defer eventRecorderMemory.mutex.Unlock()
+	if !eventRecorderMemory.isRecording {
+		return
+	}
+	recordedEvent := types.NewRecordedEventEntry()
+	recordedEvent.ElapsedTime = time.Since(eventRecorderMemory.startTime).Milliseconds()
+	switch event := event.(type) {
+	case *tcell.EventKey:
+		recordedEvent.EventType = constants.RecordedEventKey
+		recordedEvent.Key = int(event.Key())
+		recordedEvent.Rune = event.Rune()
+		recordedEvent.Modifiers = int(event.Modifiers())
+	case *tcell.EventMouse:
+		recordedEvent.EventType = constants.RecordedEventMouse
+		recordedEvent.XLocation, recordedEvent.YLocation = event.Position()
+		recordedEvent.Buttons = int(event.Buttons())
+		recordedEvent.Modifiers = int(event.Modifiers())
+	case *tcell.EventPaste:
+		recordedEvent.EventType = constants.RecordedEventPasteEnd
+		if event.Start() {
+			recordedEvent.EventType = constants.RecordedEventPasteStart
+		}
+	default:
+		return
+	}
+	eventRecorderMemory.recordedEvents = append(eventRecorderMemory.recordedEvents, recordedEvent)
+}
+
+/*
+getEventFromRecordedEvent is a method which allows you to rebuild a terminal event from a recorded event entry. In
+addition, the following should be noted:
+
+- If the recorded event type is not recognized, nil is returned.
+
+Example:
+
+	event := getEventFromRecordedEvent(recordedEvent)
+*/
+func getEventFromRecordedEvent(recordedEvent types.RecordedEventEntryType) tcell.Event {
+	switch recordedEvent.EventType {
+	case constants.RecordedEventKey:
+		return tcell.NewEventKey(tcell.Key(recordedEvent.Key), recordedEvent.Rune, tcell.ModMask(recordedEvent.Modifiers))
+	case constants.RecordedEventMouse:
+		return tcell.NewEventMouse(recordedEvent.XLocation, recordedEvent.YLocation, tcell.ButtonMask(recordedEvent.Buttons), tcell.ModMask(recordedEvent.Modifiers))
+	case constants.RecordedEventPasteStart:
+		return tcell.NewEventPaste(true)
+	case constants.RecordedEventPasteEnd:
+		return tcell.NewEventPaste(false)
+	}
+	return nil
+}
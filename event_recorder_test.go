@@ -0,0 +1,100 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"os"
+	"testing"
+)
+
+const EVENT_RECORDER_TEST_SUITE_NAME = "event_recorder"
+
+/*
+TestEventRecorderRecordAndReplay is a test which verifies that a recorded session can be replayed to reproduce the
+same control state.
+
+Example:
+
+	Expected Inputs:
+	    Injected mouse click and keystrokes on a simulation screen.
+
+	Expected Outputs:
+	    The replayed text field contains the same value as the recorded one.
+*/
+func TestEventRecorderRecordAndReplay(test *testing.T) {
+	recordingFileName := os.TempDir() + "/event_recorder_session.json"
+	defer os.Remove(recordingFileName)
+	screen := InitializeTerminalWithSimulationScreen(20, 5)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 20, 5, 1, nil)
+	textField := layer1.AddTextField(styleEntry, 1, 1, 10, 10, false, "", true)
+	UpdateDisplay(false)
+	StartEventRecording()
+	assert.Truef(test, IsEventRecordingInProgress(), "The event recording did not start!")
+	screen.InjectMouse(2, 1, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	for _, currentCharacter := range "abc" {
+		screen.InjectKey(tcell.KeyRune, currentCharacter, tcell.ModNone)
+		UpdateEventQueues()
+	}
+	screen.InjectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	UpdateEventQueues()
+	screen.InjectKey(tcell.KeyRune, 'z', tcell.ModNone)
+	UpdateEventQueues()
+	err := StopEventRecording(recordingFileName)
+	assert.NoErrorf(test, err, "The event recording could not be saved!")
+	assert.Equalf(test, "abzc", textField.GetValue(), "The recorded session did not produce the expected value!")
+
+	InitializeTerminalWithSimulationScreen(20, 5)
+	layer1 = AddLayer(0, 0, 20, 5, 1, nil)
+	textField = layer1.AddTextField(styleEntry, 1, 1, 10, 10, false, "", true)
+	UpdateDisplay(false)
+	err = ReplayEventRecording(recordingFileName, false)
+	assert.NoErrorf(test, err, "The event recording could not be replayed!")
+	assert.Equalf(test, "abzc", textField.GetValue(), "The replayed session did not produce the same value!")
+	assert.Errorf(test, StopEventRecording(recordingFileName), "Stopping a recording which was never started should fail!")
+}
+
+/*
+TestEventRecorderReplayRegression is a test which replays a saved session against a text field and compares the
+result with a master image.
+
+Example:
+
+	Expected Inputs:
+	    A saved recording which clicks a text field, types text, and presses backspace and home.
+
+	Expected Outputs:
+	    The rendered screen matches the master image.
+*/
+func TestEventRecorderReplayRegression(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textField := layer1.AddTextField(styleEntry, 2, 3, 15, 20, false, "", true)
+	UpdateDisplay(false)
+	err := ReplayEventRecording(constants.RecordingsPath+"text_field_session.json", false)
+	assert.NoErrorf(test, err, "The event recording could not be replayed!")
+	assert.Equalf(test, "Hi!", textField.GetValue(), "The replayed session did not produce the expected value!")
+	UpdateDisplay(false)
+	obtainedValue := commonResource.screenLayer.GetBasicAnsiStringAsBase64()
+	UpdateMasterImages(false, EVENT_RECORDER_TEST_SUITE_NAME, "TestEventRecorderReplayRegression", obtainedValue)
+	expectedValue := LoadMasterImage(EVENT_RECORDER_TEST_SUITE_NAME, "TestEventRecorderReplayRegression")
+	assert.Equalf(test, expectedValue, obtainedValue, "The replayed session does not match the master image!")
+}
+
+/*
+TestEventRecorderReplayMissingFile is a test which verifies that replaying a missing recording returns an error.
+
+Example:
+
+	Expected Inputs:
+	    A recording file name which does not exist.
+
+	Expected Outputs:
+	    An error is returned.
+*/
+func TestEventRecorderReplayMissingFile(test *testing.T) {
+	CommonTestSetup()
+	err := ReplayEventRecording(constants.RecordingsPath+"does_not_exist.json", false)
+	assert.Errorf(test, err, "Replaying a missing recording should have failed!")
+}
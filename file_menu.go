@@ -1,10 +1,13 @@
 package consolizer
 
 import (
+	"github.com/gdamore/tcell/v2"
 	"github.com/supercom32/consolizer/constants"
 	"github.com/supercom32/consolizer/memory"
 	"github.com/supercom32/consolizer/stringformat"
 	"github.com/supercom32/consolizer/types"
+	"strings"
+	"unicode"
 )
 
 /*
@@ -17,8 +20,13 @@ type FileMenuInstanceType struct {
 /*
 fileMenuType is a structure which is the main struct for managing file menus.
 */
-type fileMenuType struct {
-	previousButtonState uint
+type fileMenuType struct{}
+
+/*
+fileMenuSelectHandlerEntryType is a structure which holds the select handler assigned to a file menu.
+*/
+type fileMenuSelectHandlerEntryType struct {
+	handler MenuSelectHandlerType
 }
 
 /*
@@ -31,6 +39,12 @@ Example:
 var FileMenu fileMenuType
 var FileMenus = memory.NewControlMemoryManager[types.FileMenuEntryType]()
 
+/*
+fileMenuSelectHandlers is a variable which holds the select handler of every file menu which has one assigned, grouped
+by layer.
+*/
+var fileMenuSelectHandlers = memory.NewControlMemoryManager[fileMenuSelectHandlerEntryType]()
+
 /*
 Delete is a method which allows you to remove a file menu instance from memory. In addition, the following should be
 noted:
@@ -62,12 +76,60 @@ func (shared *FileMenuInstanceType) AddToTabIndex() {
 	addTabIndex(shared.layerAlias, shared.controlAlias, constants.CellTypeFileMenuHeading)
 }
 
+/*
+SetSelectHandler is a method which allows you to assign a handler which is called whenever an item of the file menu
+is selected, whether from an open menu or with its shortcut. If the file menu instance no longer exists, then no
+operation takes place. In addition, the following should be noted:
+
+- The item is passed in as it is after being selected, so a checkable item already shows its new check state.
+
+- Passing nil removes the handler. The item selected can still be obtained with GetSelectedItem.
+
+Example:
+
+	fileMenu.SetSelectHandler(func(menuItem types.MenuItemEntryType) {
+		handleCommand(menuItem.Alias)
+	})
+*/
+func (shared *FileMenuInstanceType) SetSelectHandler(handler MenuSelectHandlerType) *FileMenuInstanceType {
+	if FileMenus.IsExists(shared.layerAlias, shared.controlAlias) {
+		deleteFileMenuSelectHandler(shared.layerAlias, shared.controlAlias)
+		if handler != nil {
+			fileMenuSelectHandlers.Add(shared.layerAlias, shared.controlAlias, &fileMenuSelectHandlerEntryType{handler: handler})
+		}
+	}
+	return shared
+}
+
+/*
+GetItem is a method which allows you to obtain an item of the file menu, under any of its headings or nested menus,
+by its alias. This allows items to be disabled or checked after the file menu is created. If no item has the alias
+provided, or the file menu instance no longer exists, nil is returned.
+
+Example:
+
+	fileMenu.GetItem("save").IsDisabled = true
+*/
+func (shared *FileMenuInstanceType) GetItem(itemAlias string) *types.MenuItemEntryType {
+	if !FileMenus.IsExists(shared.layerAlias, shared.controlAlias) {
+		return nil
+	}
+	fileMenuEntry := FileMenus.Get(shared.layerAlias, shared.controlAlias)
+	for headingIndex := range fileMenuEntry.MenuEntries {
+		if menuItem := fileMenuEntry.MenuEntries[headingIndex].GetItem(itemAlias); menuItem != nil {
+			return menuItem
+		}
+	}
+	return nil
+}
+
 /*
 Add is a method which allows you to add a new file menu to a layer. In addition, the following should be noted:
 
 - The file menu will be drawn at the specified location with the given style.
 
-- Each heading in the menu can have its own dropdown with selectable items.
+  - Each heading in the menu has its own dropdown, holding one item for each value of its selection entry. The
+    selection aliases are used as the item aliases.
 
 - The top level headings widths are always dynamic based on how large the heading is.
 
@@ -77,7 +139,7 @@ Add is a method which allows you to add a new file menu to a layer. In addition,
 
 - The dropdown's border aligns neatly under the header and adjusts in width to fit the longest item label.
 
-- The file menu reuses existing selectors for dropdown functionality.
+- To use nested menus, separators, disabled items, checkable items, or shortcuts, use AddWithMenuEntries instead.
 
 Example:
 
@@ -86,13 +148,53 @@ Example:
 func (shared *fileMenuType) Add(layerAlias string, menuAlias string, styleEntry types.TuiStyleEntryType,
 	menuHeadings []string, menuSelections []types.SelectionEntryType, xLocation int, yLocation int,
 	isEnabled bool) FileMenuInstanceType {
+	var menuEntries []types.MenuEntryType
+	for _, selectionEntry := range menuSelections {
+		menuEntry := types.NewMenuEntry()
+		for currentIndex, selectionValue := range selectionEntry.SelectionValue {
+			// Selection values have no keyboard accelerators, so any ampersand in them is shown as is.
+			menuEntry.AddItem(selectionEntry.SelectionAlias[currentIndex], strings.ReplaceAll(selectionValue, "&", "&&"), "")
+		}
+		menuEntries = append(menuEntries, menuEntry)
+	}
+	return shared.AddWithMenuEntries(layerAlias, menuAlias, styleEntry, menuHeadings, menuEntries, xLocation, yLocation, isEnabled)
+}
+
+/*
+AddWithMenuEntries is a method which allows you to add a new file menu to a layer, where the dropdown of each heading
+is built from a menu entry. In addition, the following should be noted:
+
+  - Menu entries support nested menus to any depth, separators, disabled items, checkable items, and shortcut hints,
+    just like context menus.
 
+  - An ampersand in a heading marks the letter after it as the one which opens the heading when pressed along with
+    the alt key. Use two ampersands to show a literal one.
+
+  - The shortcut hint of an item, such as "Ctrl+S", also works as its shortcut. Pressing it selects the item even
+    while the file menu is closed, as long as the file menu is shown and not hidden behind a modal layer.
+
+- The items of each menu entry are shared rather than copied, so they can still be disabled or checked later.
+
+- There must be exactly one menu entry for each heading, otherwise a panic will be generated.
+
+Example:
+
+	fileMenuEntry := types.NewMenuEntry()
+	fileMenuEntry.AddItem("save", "&Save", "Ctrl+S")
+	fileMenuEntry.AddSeparator()
+	fileMenuEntry.AddSubMenu("&Export", exportMenuEntry)
+	FileMenu.AddWithMenuEntries("main", "fileMenu", style, []string{"&File"}, []types.MenuEntryType{fileMenuEntry}, 0, 0, true)
+*/
+func (shared *fileMenuType) AddWithMenuEntries(layerAlias string, menuAlias string, styleEntry types.TuiStyleEntryType,
+	menuHeadings []string, menuEntries []types.MenuEntryType, xLocation int, yLocation int,
+	isEnabled bool) FileMenuInstanceType {
+	validateFileMenuEntries(menuHeadings, menuEntries)
 	fileMenuEntry := types.NewFileMenuEntry()
 	fileMenuEntry.LayerAlias = layerAlias
 	fileMenuEntry.Alias = menuAlias
 	fileMenuEntry.StyleEntry = styleEntry
 	fileMenuEntry.MenuHeadings = menuHeadings
-	fileMenuEntry.MenuSelections = menuSelections
+	fileMenuEntry.MenuEntries = menuEntries
 	fileMenuEntry.XLocation = xLocation
 	fileMenuEntry.YLocation = yLocation
 	fileMenuEntry.DynamicWidth = true
@@ -100,36 +202,6 @@ func (shared *fileMenuType) Add(layerAlias string, menuAlias string, styleEntry
 	fileMenuEntry.IsSubmenuOpen = false
 	fileMenuEntry.IsEnabled = isEnabled
 
-	// Create selectors for each menu heading
-	for i, selection := range menuSelections {
-		// Calculate max width needed for this selector based on the longest dropdown menu item
-		maxWidth := 0
-		for _, item := range selection.SelectionValue {
-			if len(item) > maxWidth {
-				maxWidth = len(item)
-			}
-		}
-		maxWidth += 4 // Add padding
-
-		// Calculate position for this selector
-		selectorX := xLocation
-		// Sum the widths of all headings before this one
-		for j := 0; j < i; j++ {
-			selectorX += len(menuHeadings[j]) + 2 // Add padding
-		}
-
-		// Create a selector for this heading
-		selectorAlias := stringformat.GetLastSortedUUID()
-		fileMenuEntry.SelectorAliases = append(fileMenuEntry.SelectorAliases, selectorAlias)
-
-		// Add the selector (initially hidden)
-		// Position the selector directly beneath the header
-		selectorInstance := Selector.Add(layerAlias, selectorAlias, styleEntry, selection,
-			selectorX+1, yLocation+2, len(selection.SelectionAlias), maxWidth, 1, 0, 0, false, true)
-		selectorInstance.Unselect()
-		selectorInstance.SetVisible(false)
-	}
-
 	// Store the file menu entry in memory
 	FileMenus.Add(layerAlias, menuAlias, &fileMenuEntry)
 
@@ -147,7 +219,7 @@ noted:
 
 - This method is used to clean up resources when a file menu is no longer needed.
 
-- After deletion, the file menu should not be used anymore.
+- After deletion, the file menu should not be used anymore. If one of its menus is open, it is closed.
 
 Example:
 
@@ -156,16 +228,15 @@ Example:
 func (shared *fileMenuType) Delete(layerAlias string, menuAlias string) {
 	if FileMenus.IsExists(layerAlias, menuAlias) {
 		fileMenuEntry := FileMenus.Get(layerAlias, menuAlias)
-
-		// Delete all associated selectors
-		for _, selectorAlias := range fileMenuEntry.SelectorAliases {
-			Selector.Delete(layerAlias, selectorAlias)
+		if fileMenuEntry.IsSubmenuOpen {
+			contextMenu.closeMenusAfter(-1)
 		}
 
 		// Delete the tooltip
 		Tooltip.Delete(layerAlias, fileMenuEntry.TooltipAlias)
 
 		// Delete the file menu entry
+		deleteFileMenuSelectHandler(layerAlias, menuAlias)
 		FileMenus.Remove(layerAlias, menuAlias)
 	}
 }
@@ -189,6 +260,20 @@ func (shared *fileMenuType) DeleteAll(layerAlias string) {
 	}
 }
 
+/*
+deleteFileMenuSelectHandler is a method which allows you to remove the select handler assigned to a file menu, if
+any.
+
+Example:
+
+	deleteFileMenuSelectHandler("layer1", "fileMenu1")
+*/
+func deleteFileMenuSelectHandler(layerAlias string, menuAlias string) {
+	if fileMenuSelectHandlers.IsExists(layerAlias, menuAlias) {
+		fileMenuSelectHandlers.Remove(layerAlias, menuAlias)
+	}
+}
+
 /*
 drawOnLayer is a method which allows you to draw all file menus on a layer. In addition, the following should
 be noted:
@@ -214,7 +299,8 @@ draw is a method which allows you to draw a file menu on a layer. In addition, t
 
 - This method is called internally by drawFileMenusOnLayer.
 
-- It draws the menu bar and manages the visibility of associated selectors.
+  - It draws the menu bar only, with the keyboard accelerator of each heading underlined. The menu of the active
+    heading is drawn by the context menu on a layer of its own.
 
 Example:
 
@@ -228,8 +314,7 @@ func (shared *fileMenuType) draw(layerEntry *types.LayerEntryType, fileMenuEntry
 	// Draw the menu bar
 	currentX := fileMenuEntry.XLocation
 	for index, heading := range fileMenuEntry.MenuHeadings {
-		// Determine the width of this heading
-		headingWidth := len(heading) + 2 // Add padding
+		labelText, _, acceleratorIndex := getMenuLabel(heading)
 
 		// Set up attributes for drawing
 		attributeEntry := types.NewAttributeEntry()
@@ -248,66 +333,366 @@ func (shared *fileMenuType) draw(layerEntry *types.LayerEntryType, fileMenuEntry
 		attributeEntry.CellControlId = index
 
 		// Draw the heading with padding
-		paddedHeading := " " + heading + " "
-		if len(paddedHeading) < headingWidth {
-			paddedHeading = paddedHeading + stringformat.GetFilledString(headingWidth-len(paddedHeading), " ")
-		} else if len(paddedHeading) > headingWidth {
-			paddedHeading = paddedHeading[:headingWidth]
+		paddedHeading := append([]rune{' '}, labelText...)
+		paddedHeading = append(paddedHeading, ' ')
+		printLayer(layerEntry, attributeEntry, currentX, fileMenuEntry.YLocation, paddedHeading)
+		if acceleratorIndex != -1 {
+			attributeEntry.IsUnderlined = true
+			printLayer(layerEntry, attributeEntry, currentX+1+acceleratorIndex, fileMenuEntry.YLocation, []rune{labelText[acceleratorIndex]})
 		}
-		printLayer(layerEntry, attributeEntry, currentX, fileMenuEntry.YLocation, []rune(paddedHeading))
 
 		// Move to the next heading position
-		currentX += headingWidth
-	}
-
-	// Manage selector visibility based on active heading
-	for i, selectorAlias := range fileMenuEntry.SelectorAliases {
-		selectorEntry := Selectors.Get(fileMenuEntry.LayerAlias, selectorAlias)
-		if i == fileMenuEntry.ActiveHeadingIndex && fileMenuEntry.IsSubmenuOpen {
-			// Show the selector for the active heading
-			selectorEntry.IsVisible = true
-		} else {
-			// Hide selectors for inactive headings
-			selectorEntry.IsVisible = false
+		currentX += stringformat.GetWidthOfRunesWhenPrinted(paddedHeading)
+	}
+}
+
+/*
+getHeadingXLocation is a method which allows you to obtain the x location of a heading, relative to the layer the
+file menu is on.
+
+Example:
+
+	xLocation := FileMenu.getHeadingXLocation(fileMenuEntry, 2)
+*/
+func (shared *fileMenuType) getHeadingXLocation(fileMenuEntry *types.FileMenuEntryType, headingIndex int) int {
+	xLocation := fileMenuEntry.XLocation
+	for currentIndex := 0; currentIndex < headingIndex; currentIndex++ {
+		labelText, _, _ := getMenuLabel(fileMenuEntry.MenuHeadings[currentIndex])
+		xLocation += stringformat.GetWidthOfRunesWhenPrinted(labelText) + 2
+	}
+	return xLocation
+}
+
+/*
+getOpenFileMenu is a method which allows you to obtain the file menu whose menu is currently open. If no file menu
+has its menu open, nil is returned.
+
+Example:
+
+	fileMenuEntry := FileMenu.getOpenFileMenu()
+*/
+func (shared *fileMenuType) getOpenFileMenu() *types.FileMenuEntryType {
+	for _, fileMenuEntry := range FileMenus.GetAllEntriesOverall() {
+		if fileMenuEntry.IsSubmenuOpen {
+			return fileMenuEntry
 		}
 	}
+	return nil
+}
+
+/*
+isFileMenuActive is a method which allows you to detect if a file menu can currently be used. A file menu can not be
+used while it is disabled, while its layer or any of its parents are hidden, or while a modal layer it is not part of
+is shown.
+
+Example:
+
+	if FileMenu.isFileMenuActive(fileMenuEntry) {
+		FileMenu.openHeading(fileMenuEntry, 0, true)
+	}
+*/
+func (shared *fileMenuType) isFileMenuActive(fileMenuEntry *types.FileMenuEntryType) bool {
+	return fileMenuEntry.IsEnabled && isLayerShown(fileMenuEntry.LayerAlias) && isLayerWithinActiveModal(fileMenuEntry.LayerAlias)
+}
+
+/*
+openHeading is a method which allows you to open the menu of a heading directly beneath it, closing any menu already
+open. If the heading has no items, then no operation takes place.
+
+Example:
+
+	FileMenu.openHeading(fileMenuEntry, 0, true)
+*/
+func (shared *fileMenuType) openHeading(fileMenuEntry *types.FileMenuEntryType, headingIndex int, isFirstItemHighlighted bool) {
+	menuItems := fileMenuEntry.MenuEntries[headingIndex].Items
+	if len(menuItems) == 0 {
+		return
+	}
+	layerAlias := fileMenuEntry.LayerAlias
+	menuAlias := fileMenuEntry.Alias
+	xOrigin, yOrigin, _ := getLayerScreenRegion(Layers.Get(layerAlias))
+	xLocation := xOrigin + shared.getHeadingXLocation(fileMenuEntry, headingIndex)
+	yLocation := yOrigin + fileMenuEntry.YLocation + 1
+	contextMenu.open(fileMenuEntry.StyleEntry, menuItems, xLocation, yLocation, func(menuItem types.MenuItemEntryType) {
+		shared.selectMenuItem(layerAlias, menuAlias, headingIndex, menuItem)
+	}, isFirstItemHighlighted)
+	contextMenu.closeHandler = func() {
+		if FileMenus.IsExists(layerAlias, menuAlias) {
+			fileMenuEntry := FileMenus.Get(layerAlias, menuAlias)
+			fileMenuEntry.ActiveHeadingIndex = -1
+			fileMenuEntry.IsSubmenuOpen = false
+		}
+	}
+	fileMenuEntry.ActiveHeadingIndex = headingIndex
+	fileMenuEntry.IsSubmenuOpen = true
+}
+
+/*
+openAdjacentHeading is a method which allows you to move the open menu of a file menu to the heading next to the one
+currently open, in a given direction, wrapping around at either end.
+
+Example:
+
+	FileMenu.openAdjacentHeading(fileMenuEntry, 1)
+*/
+func (shared *fileMenuType) openAdjacentHeading(fileMenuEntry *types.FileMenuEntryType, direction int) {
+	numberOfHeadings := len(fileMenuEntry.MenuHeadings)
+	headingIndex := (fileMenuEntry.ActiveHeadingIndex + direction + numberOfHeadings) % numberOfHeadings
+	shared.openHeading(fileMenuEntry, headingIndex, true)
+}
+
+/*
+getHeadingByAccelerator is a method which allows you to obtain the index of the heading whose keyboard accelerator
+matches the character provided. If no heading matches, -1 is returned.
+
+Example:
+
+	headingIndex := FileMenu.getHeadingByAccelerator(fileMenuEntry, 'f')
+*/
+func (shared *fileMenuType) getHeadingByAccelerator(fileMenuEntry *types.FileMenuEntryType, character rune) int {
+	for currentIndex, heading := range fileMenuEntry.MenuHeadings {
+		if _, accelerator, _ := getMenuLabel(heading); accelerator != 0 && accelerator == unicode.ToLower(character) {
+			return currentIndex
+		}
+	}
+	return -1
+}
+
+/*
+selectMenuItem is a method which allows you to record the item selected from a file menu, and call its select
+handler if it has one.
+
+Example:
+
+	FileMenu.selectMenuItem("layer1", "fileMenu1", 0, menuItem)
+*/
+func (shared *fileMenuType) selectMenuItem(layerAlias string, menuAlias string, headingIndex int, menuItem types.MenuItemEntryType) {
+	if !FileMenus.IsExists(layerAlias, menuAlias) {
+		return
+	}
+	fileMenuEntry := FileMenus.Get(layerAlias, menuAlias)
+	fileMenuEntry.SelectedHeadingIndex = headingIndex
+	fileMenuEntry.SelectedItemIndex = getMenuItemIndex(fileMenuEntry.MenuEntries[headingIndex].Items, menuItem.Alias)
+	fileMenuEntry.SelectedItem = menuItem
+	if fileMenuSelectHandlers.IsExists(layerAlias, menuAlias) {
+		fileMenuSelectHandlers.Get(layerAlias, menuAlias).handler(menuItem)
+	}
+}
+
+/*
+getMenuItemIndex is a method which allows you to obtain the index of an item within the menu holding it, searching
+a list of menu items and all of their nested menus by alias. If no item has the alias provided, -1 is returned.
+
+Example:
+
+	itemIndex := getMenuItemIndex(menuItems, "save")
+*/
+func getMenuItemIndex(menuItems []types.MenuItemEntryType, itemAlias string) int {
+	for currentIndex, menuItem := range menuItems {
+		if !menuItem.IsSeparator && menuItem.Alias == itemAlias {
+			return currentIndex
+		}
+		if itemIndex := getMenuItemIndex(menuItem.SubMenuItems, itemAlias); itemIndex != -1 {
+			return itemIndex
+		}
+	}
+	return -1
+}
+
+/*
+getMenuItemByShortcut is a method which allows you to find the item whose shortcut hint matches a given shortcut,
+searching a list of menu items and all of their nested menus. Items which can not be selected, and the nested menus
+of disabled items, are skipped. If no item matches, nil is returned.
+
+Example:
+
+	menuItem := getMenuItemByShortcut(menuItems, "ctrl+s")
+*/
+func getMenuItemByShortcut(menuItems []types.MenuItemEntryType, shortcut string) *types.MenuItemEntryType {
+	for currentIndex := range menuItems {
+		menuItem := &menuItems[currentIndex]
+		if !isMenuItemSelectable(*menuItem) {
+			continue
+		}
+		if len(menuItem.SubMenuItems) > 0 {
+			if subMenuItem := getMenuItemByShortcut(menuItem.SubMenuItems, shortcut); subMenuItem != nil {
+				return subMenuItem
+			}
+		} else if menuItem.ShortcutHint != "" && getNormalizedShortcut(menuItem.ShortcutHint) == shortcut {
+			return menuItem
+		}
+	}
+	return nil
+}
+
+/*
+getNormalizedShortcut is a method which allows you to convert a shortcut, such as "Ctrl+Shift+S", into the name the
+keyboard reports for it, such as "shift+ctrl+s". Modifiers are put into the order the keyboard reports them in, so
+that shortcuts can be written with their modifiers in any order.
+
+Example:
+
+	shortcut := getNormalizedShortcut("Ctrl+S")
+*/
+func getNormalizedShortcut(shortcut string) string {
+	keyNames := strings.Split(strings.ToLower(shortcut), "+")
+	keyName := keyNames[len(keyNames)-1]
+	var normalizedKeyNames []string
+	for _, modifierName := range []string{"shift", "alt", "meta", "ctrl"} {
+		for _, currentKeyName := range keyNames[:len(keyNames)-1] {
+			if currentKeyName == modifierName {
+				normalizedKeyNames = append(normalizedKeyNames, modifierName)
+				break
+			}
+		}
+	}
+	return strings.Join(append(normalizedKeyNames, keyName), "+")
+}
+
+/*
+getKeystrokeShortcut is a method which allows you to obtain the shortcut name of a keystroke. Keystrokes for named
+keys already carry their modifiers, while typed characters are reported on their own, so the alt key is added to
+them here when it is held down.
+
+Example:
+
+	shortcut := getKeystrokeShortcut(keystroke)
+*/
+func getKeystrokeShortcut(keystroke []rune) string {
+	if len(keystroke) == 1 {
+		shortcut := string(unicode.ToLower(keystroke[0]))
+		if IsModifierKeyPressed(tcell.ModAlt) {
+			shortcut = "alt+" + shortcut
+		}
+		return shortcut
+	}
+	return string(keystroke)
 }
 
 /*
 updateKeyboardEvent is a method which allows you to update the state of file menus based on keyboard events. In
 addition, the following should be noted:
 
-- This method is called internally by the input handling system.
+- This method is called internally by the input handling system, before any context menu or control.
+
+  - While the menu of a file menu is open, left and right move to the menu of the adjacent heading, unless a nested
+    menu can be closed or opened instead. Every other keystroke is left to the context menu showing it.
 
-- It handles keyboard navigation for file menus.
+- Pressing the alt key along with the keyboard accelerator of a heading opens its menu.
+
+- The shortcuts of items are handled by updateShortcutKeyboardEvent instead, so that controls can use them first.
 
 Example:
 
 	updateRequired, consumed := FileMenu.updateKeyboardEvent(keystroke)
 */
 func (shared *fileMenuType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
-	keystrokeAsString := string(keystroke)
-	isScreenUpdateRequired := false
-	isKeystrokeConsumed := false
-
-	// Handle escape key to close open menus
-	if keystrokeAsString == "escape" {
-		// Get all layer entries
-		for _, layerEntry := range Layers.GetAllEntries() {
-			layerAlias := layerEntry.LayerAlias
-			fileMenuEntries := FileMenus.GetAllEntries(layerAlias)
-			for _, fileMenuEntry := range fileMenuEntries {
-				if fileMenuEntry.IsSubmenuOpen {
-					fileMenuEntry.IsSubmenuOpen = false
-					fileMenuEntry.ActiveHeadingIndex = -1
-					isScreenUpdateRequired = true
-					isKeystrokeConsumed = true
+	if keystroke == nil {
+		return false, false
+	}
+	isAltPressed := len(keystroke) == 1 && IsModifierKeyPressed(tcell.ModAlt)
+	if fileMenuEntry := shared.getOpenFileMenu(); fileMenuEntry != nil {
+		innermostMenu := contextMenu.openMenus[len(contextMenu.openMenus)-1]
+		isSubMenuHighlighted := innermostMenu.itemHighlighted != constants.NullCellId && len(innermostMenu.menuItems[innermostMenu.itemHighlighted].SubMenuItems) > 0
+		switch {
+		case string(keystroke) == "left" && len(contextMenu.openMenus) == 1:
+			shared.openAdjacentHeading(fileMenuEntry, -1)
+		case string(keystroke) == "right" && !isSubMenuHighlighted:
+			shared.openAdjacentHeading(fileMenuEntry, 1)
+		case isAltPressed && shared.getHeadingByAccelerator(fileMenuEntry, keystroke[0]) != -1:
+			shared.openHeading(fileMenuEntry, shared.getHeadingByAccelerator(fileMenuEntry, keystroke[0]), true)
+		default:
+			return false, false
+		}
+		return true, true
+	}
+	// Any other context menu open captures every keystroke.
+	if !isAltPressed || IsContextMenuOpen() {
+		return false, false
+	}
+	for _, fileMenuEntry := range FileMenus.GetAllEntriesOverall() {
+		if !shared.isFileMenuActive(fileMenuEntry) {
+			continue
+		}
+		if headingIndex := shared.getHeadingByAccelerator(fileMenuEntry, keystroke[0]); headingIndex != -1 {
+			shared.openHeading(fileMenuEntry, headingIndex, true)
+			return true, true
+		}
+	}
+	return false, false
+}
+
+/*
+updateShortcutKeyboardEvent is a method which allows you to select the items of file menus whose shortcuts are
+pressed. In addition, the following should be noted:
+
+  - This method is called internally by the input handling system, and is only given keystrokes which no control
+    used. This way, shortcuts such as 'Ctrl+C' never stop the control focused from copying text.
+
+- Pressing the shortcut of an item selects it, even while the file menu is closed.
+
+- Checkable items have their check mark toggled before being selected.
+
+Example:
+
+	updateRequired, consumed := FileMenu.updateShortcutKeyboardEvent(keystroke)
+*/
+func (shared *fileMenuType) updateShortcutKeyboardEvent(keystroke []rune) (bool, bool) {
+	if keystroke == nil || IsContextMenuOpen() {
+		return false, false
+	}
+	shortcut := getKeystrokeShortcut(keystroke)
+	for _, fileMenuEntry := range FileMenus.GetAllEntriesOverall() {
+		if !shared.isFileMenuActive(fileMenuEntry) {
+			continue
+		}
+		for headingIndex := range fileMenuEntry.MenuEntries {
+			if menuItem := getMenuItemByShortcut(fileMenuEntry.MenuEntries[headingIndex].Items, shortcut); menuItem != nil {
+				if menuItem.IsCheckable {
+					menuItem.IsChecked = !menuItem.IsChecked
 				}
+				shared.selectMenuItem(fileMenuEntry.LayerAlias, fileMenuEntry.Alias, headingIndex, *menuItem)
+				return true, true
 			}
 		}
 	}
+	return false, false
+}
+
+/*
+updateOpenMenuMouseEvent is a method which allows you to update a file menu whose menu is open, based on mouse events
+over its headings. It is called before the context menu showing the open menu, which would otherwise treat the
+headings as being outside of it. In addition, the following should be noted:
+
+- Moving over another heading opens its menu instead.
+
+- Clicking the heading whose menu is open closes it.
+
+- Returns true if the mouse event was used, false otherwise.
 
-	return isScreenUpdateRequired, isKeystrokeConsumed
+Example:
+
+	isUpdateRequired := FileMenu.updateOpenMenuMouseEvent()
+*/
+func (shared *fileMenuType) updateOpenMenuMouseEvent() bool {
+	fileMenuEntry := shared.getOpenFileMenu()
+	if fileMenuEntry == nil {
+		return false
+	}
+	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeFileMenuHeading || characterEntry.LayerAlias != fileMenuEntry.LayerAlias ||
+		characterEntry.AttributeEntry.CellControlAlias != fileMenuEntry.Alias {
+		return false
+	}
+	headingIndex := characterEntry.AttributeEntry.CellControlId
+	if headingIndex != fileMenuEntry.ActiveHeadingIndex {
+		shared.openHeading(fileMenuEntry, headingIndex, false)
+	} else if buttonPressed != 0 && previousButtonPressed == 0 {
+		contextMenu.closeMenusAfter(-1)
+	}
+	return true
 }
 
 /*
@@ -316,95 +701,41 @@ addition, the following should be noted:
 
 - This method is called internally by the input handling system.
 
-- It handles mouse clicks on menu headings and delegates to selectors for menu items.
+  - It opens the menu of a heading when the heading is clicked. Once open, the menu is handled by the context menu
+    showing it, which also closes it when clicking anywhere else.
 
 Example:
 
 	updateRequired := FileMenu.updateStateMouse()
 */
 func (shared *fileMenuType) updateStateMouse() bool {
-	isUpdateRequired := false
 	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	if buttonPressed != constants.MouseButtonLeft || previousButtonPressed != 0 {
+		return false
+	}
 	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
 	layerAlias := characterEntry.LayerAlias
 	cellControlAlias := characterEntry.AttributeEntry.CellControlAlias
-	cellControlId := characterEntry.AttributeEntry.CellControlId
-	cellType := characterEntry.AttributeEntry.CellType
-
-	// Detect a new click (button state changed from released to pressed)
-	isNewClick := buttonPressed != 0 && shared.previousButtonState == 0
-
-	// Update the previous button state for the next call
-	defer func() { shared.previousButtonState = buttonPressed }()
-
-	// Only process if a button is pressed and it's a new click (not a continued press)
-	if isNewClick {
-		// Check if the mouse clicked on a file menu heading
-		if cellType == constants.CellTypeFileMenuHeading && FileMenus.IsExists(layerAlias, cellControlAlias) {
-			fileMenuEntry := FileMenus.Get(layerAlias, cellControlAlias)
-
-			// If clicking on the active heading, close the submenu
-			if fileMenuEntry.ActiveHeadingIndex == cellControlId && fileMenuEntry.IsSubmenuOpen {
-				fileMenuEntry.IsSubmenuOpen = false
-				fileMenuEntry.ActiveHeadingIndex = -1
-			} else {
-				// Close any other open menus first
-				shared.closeAllOpenMenus()
-
-				// Open the submenu for this heading
-				fileMenuEntry.ActiveHeadingIndex = cellControlId
-				fileMenuEntry.IsSubmenuOpen = true
-			}
-			isUpdateRequired = true
-		} else if cellType == constants.CellTypeSelectorItem {
-			// If a selector item was clicked, check if it belongs to a file menu
-			// This is handled by the selector's own event handling
-
-			// After a selection, close all menus
-			shared.closeAllOpenMenus()
-			isUpdateRequired = true
-		} else {
-			// If clicking outside any menu, close all open menus
-			if shared.closeAllOpenMenus() {
-				isUpdateRequired = true
-			}
-		}
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeFileMenuHeading || !FileMenus.IsExists(layerAlias, cellControlAlias) {
+		return false
 	}
-
-	return isUpdateRequired
+	fileMenuEntry := FileMenus.Get(layerAlias, cellControlAlias)
+	if !fileMenuEntry.IsEnabled {
+		return false
+	}
+	shared.openHeading(fileMenuEntry, characterEntry.AttributeEntry.CellControlId, false)
+	return true
 }
 
 /*
-closeAllOpenMenus is a method which allows you to close all open file menu submenus. In addition, the following should
-be noted:
-
-- This method is called when clicking outside any menu or when selecting a menu item.
-
-- Returns true if any menu was closed, false otherwise.
-
-Example:
+GetSelectedItem is a method which allows you to retrieve the currently selected item from the file menu. In
+addition, the following should be noted:
 
-	FileMenu.closeAllOpenMenus()
-*/
-func (shared *fileMenuType) closeAllOpenMenus() bool {
-	menuClosed := false
-	// Get all layer entries
-	for _, layerEntry := range Layers.GetAllEntries() {
-		layerAlias := layerEntry.LayerAlias
-		fileMenuEntries := FileMenus.GetAllEntries(layerAlias)
-		for _, fileMenuEntry := range fileMenuEntries {
-			if fileMenuEntry.IsSubmenuOpen {
-				fileMenuEntry.IsSubmenuOpen = false
-				fileMenuEntry.ActiveHeadingIndex = -1
-				menuClosed = true
-			}
-		}
-	}
-	return menuClosed
-}
+  - The index of the item is its position within the menu holding it, which is a nested menu for items selected from
+    one. The value returned is the label of the item as it is drawn.
 
-/*
-GetSelectedItem is a method which allows you to retrieve the currently selected item from the file menu.
+- Once read, the selection is cleared. If nothing is selected, -1 is returned for both indexes.
 
 Example:
 
@@ -415,20 +746,17 @@ func (shared *FileMenuInstanceType) GetSelectedItem() (int, int, string, string)
 		return -1, -1, "", ""
 	}
 	fileMenuEntry := FileMenus.Get(shared.layerAlias, shared.controlAlias)
-	// Iterate through selectors (one per heading)
-	for headingIndex, selectorAlias := range fileMenuEntry.SelectorAliases {
-		selectorEntry := Selectors.Get(shared.layerAlias, selectorAlias)
-		if selectorEntry.ItemSelected >= 0 {
-			// Store the values to be returned
-			itemIndex := selectorEntry.ItemSelected
-			itemAlias := selectorEntry.SelectionEntry.SelectionAlias[itemIndex]
-			itemValue := selectorEntry.SelectionEntry.SelectionValue[itemIndex]
-			shared.Unselect()
-			return headingIndex, itemIndex, itemAlias, itemValue
-		}
+	if fileMenuEntry.SelectedHeadingIndex == -1 {
+		// Nothing selected
+		return -1, -1, "", ""
 	}
-	// Nothing selected
-	return -1, -1, "", ""
+	// Store the values to be returned
+	headingIndex := fileMenuEntry.SelectedHeadingIndex
+	itemIndex := fileMenuEntry.SelectedItemIndex
+	itemAlias := fileMenuEntry.SelectedItem.Alias
+	labelText, _, _ := getMenuLabel(fileMenuEntry.SelectedItem.Label)
+	shared.Unselect()
+	return headingIndex, itemIndex, itemAlias, string(labelText)
 }
 
 /*
@@ -457,8 +785,6 @@ func (shared *FileMenuInstanceType) IsOpen() bool {
 Unselect is a method which allows you to clear the current selection for a file menu. In addition, the following should
 be noted:
 
-- This method iterates through all submenus (selectors) of the file menu and unselects any selected item.
-
 - If the file menu does not exist, no operation occurs.
 
 Example:
@@ -470,9 +796,7 @@ func (shared *FileMenuInstanceType) Unselect() {
 		return
 	}
 	fileMenuEntry := FileMenus.Get(shared.layerAlias, shared.controlAlias)
-	// Iterate through selectors (one per heading) and unselect them.
-	for _, selectorAlias := range fileMenuEntry.SelectorAliases {
-		selectorEntry := Selectors.Get(shared.layerAlias, selectorAlias)
-		selectorEntry.ItemSelected = constants.SELECTED_NONE
-	}
+	fileMenuEntry.SelectedHeadingIndex = -1
+	fileMenuEntry.SelectedItemIndex = -1
+	fileMenuEntry.SelectedItem = types.MenuItemEntryType{}
 }
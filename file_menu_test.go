@@ -0,0 +1,170 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+TestFileMenuKeyboard is a test which verifies that file menus with nested menus can be opened and navigated with the
+keyboard, and that the shortcuts of their items work while they are closed.
+
+Example:
+
+	Expected Inputs:
+	    A file menu whose headings have keyboard accelerators, holding a nested menu, a disabled item, and a checkable
+	    item, used with alt and a letter, the arrow keys, escape, and the shortcut hints of its items.
+
+	Expected Outputs:
+	    Alt and a letter opens the heading beneath it, left and right move between headings unless a nested menu can
+	    be opened or closed, shortcuts select items and toggle check marks while the file menu is closed, disabled
+	    items or file menus on hidden layers ignore their shortcuts, and shortcuts are only used once the control
+	    focused has not used them.
+*/
+func TestFileMenuKeyboard(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	exportMenuEntry := types.NewMenuEntry()
+	exportMenuEntry.AddItem("pdf", "&PDF", "Ctrl+Shift+P")
+	exportMenuEntry.AddItem("html", "&HTML", "")
+	fileMenuEntry := types.NewMenuEntry()
+	fileMenuEntry.AddItem("new", "&New", "Ctrl+N")
+	fileMenuEntry.AddSeparator()
+	fileMenuEntry.AddItem("save", "&Save", "Ctrl+S")
+	fileMenuEntry.GetItem("save").IsDisabled = true
+	fileMenuEntry.AddSubMenu("&Export", exportMenuEntry)
+	editMenuEntry := types.NewMenuEntry()
+	editMenuEntry.AddItem("undo", "&Undo", "Ctrl+Z")
+	viewMenuEntry := types.NewMenuEntry()
+	viewMenuEntry.AddCheckableItem("wrap", "&Word Wrap", "Alt+W", false)
+	fileMenuInstance := layer1.AddFileMenuWithMenuEntries(styleEntry, []string{"&File", "&Edit", "&View"},
+		[]types.MenuEntryType{fileMenuEntry, editMenuEntry, viewMenuEntry}, 0, 0, true)
+	var selectedItems []types.MenuItemEntryType
+	fileMenuInstance.SetSelectHandler(func(menuItem types.MenuItemEntryType) {
+		selectedItems = append(selectedItems, menuItem)
+	})
+	UpdateDisplay(false)
+
+	eventStateMemory.modifierKeys = tcell.ModAlt
+	FileMenu.updateKeyboardEvent([]rune("e"))
+	eventStateMemory.modifierKeys = tcell.ModNone
+	assert.Truef(test, fileMenuInstance.IsOpen(), "Alt and the accelerator of a heading did not open it!")
+	menuLayerEntry := Layers.Get(contextMenu.openMenus[0].layerAlias)
+	assert.Equalf(test, []int{6, 1}, []int{menuLayerEntry.ScreenXLocation, menuLayerEntry.ScreenYLocation}, "The menu of a heading was not opened beneath it!")
+	FileMenu.updateKeyboardEvent([]rune("right"))
+	FileMenu.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 0, FileMenus.Get(layer1.layerAlias, fileMenuInstance.controlAlias).ActiveHeadingIndex, "Right did not wrap around to the first heading!")
+	contextMenu.updateKeyboardEvent([]rune("down"))
+	_, isConsumed := FileMenu.updateKeyboardEvent([]rune("right"))
+	assert.Falsef(test, isConsumed, "Right moved to the next heading instead of opening the nested menu highlighted!")
+	contextMenu.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 2, len(contextMenu.openMenus), "Right did not open the nested menu!")
+	_, isConsumed = FileMenu.updateKeyboardEvent([]rune("left"))
+	assert.Falsef(test, isConsumed, "Left moved to the previous heading instead of closing the nested menu!")
+	contextMenu.updateKeyboardEvent([]rune("left"))
+	FileMenu.updateKeyboardEvent([]rune("left"))
+	assert.Equalf(test, 2, FileMenus.Get(layer1.layerAlias, fileMenuInstance.controlAlias).ActiveHeadingIndex, "Left did not wrap around to the last heading!")
+	contextMenu.updateKeyboardEvent([]rune("escape"))
+	assert.Falsef(test, fileMenuInstance.IsOpen(), "Closing the menu did not close the file menu!")
+	assert.Equalf(test, -1, FileMenus.Get(layer1.layerAlias, fileMenuInstance.controlAlias).ActiveHeadingIndex, "Closing the menu did not clear the active heading!")
+
+	_, isConsumed = FileMenu.updateShortcutKeyboardEvent([]rune("shift+ctrl+p"))
+	assert.Truef(test, isConsumed, "The shortcut of an item in a nested menu was not used while the file menu was closed!")
+	assert.Equalf(test, "pdf", selectedItems[0].Alias, "The shortcut did not select its item!")
+	headingIndex, itemIndex, itemAlias, itemValue := fileMenuInstance.GetSelectedItem()
+	assert.Equalf(test, []interface{}{0, 0, "pdf", "PDF"}, []interface{}{headingIndex, itemIndex, itemAlias, itemValue}, "The item selected with a shortcut was not recorded!")
+	_, isConsumed = FileMenu.updateShortcutKeyboardEvent([]rune("ctrl+s"))
+	assert.Falsef(test, isConsumed, "The shortcut of a disabled item was used!")
+	eventStateMemory.modifierKeys = tcell.ModAlt
+	FileMenu.updateShortcutKeyboardEvent([]rune("w"))
+	eventStateMemory.modifierKeys = tcell.ModNone
+	assert.Truef(test, fileMenuInstance.GetItem("wrap").IsChecked, "An alt shortcut did not check its checkable item!")
+	assert.Equalf(test, 2, len(selectedItems), "The shortcut of a checkable item did not call the handler!")
+
+	Layers.Get(layer1.layerAlias).IsVisible = false
+	_, isConsumed = FileMenu.updateShortcutKeyboardEvent([]rune("ctrl+n"))
+	assert.Falsef(test, isConsumed, "The shortcut of a file menu on a hidden layer was used!")
+	Layers.Get(layer1.layerAlias).IsVisible = true
+
+	textFieldInstance := layer1.AddTextField(styleEntry, 0, 5, 10, 10, false, "", true)
+	SetFocus(&textFieldInstance)
+	dispatchEvent(tcell.NewEventKey(tcell.KeyCtrlZ, 0, tcell.ModCtrl))
+	assert.Equalf(test, 2, len(selectedItems), "The shortcut of an item was used before the text field focused!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModAlt))
+	assert.Truef(test, fileMenuInstance.IsOpen(), "Alt and the accelerator of a heading did not open it while a text field was focused!")
+	assert.Equalf(test, "", textFieldInstance.GetValue(), "The accelerator of a heading was typed into the text field focused!")
+	contextMenu.updateKeyboardEvent([]rune("escape"))
+	setFocusedControl("", "", 0)
+	dispatchEvent(tcell.NewEventKey(tcell.KeyCtrlZ, 0, tcell.ModCtrl))
+	assert.Equalf(test, "undo", selectedItems[len(selectedItems)-1].Alias, "The shortcut of an item was not used while no control was focused!")
+	fileMenuInstance.Delete()
+	assert.Falsef(test, FileMenus.IsExists(layer1.layerAlias, fileMenuInstance.controlAlias), "Deleting the file menu did not remove it!")
+	assert.Panicsf(test, func() {
+		layer1.AddFileMenuWithMenuEntries(styleEntry, []string{"&File"}, nil, 0, 0, true)
+	}, "A heading without a menu entry did not panic!")
+}
+
+/*
+TestFileMenuMouse is a test which verifies that file menus created from selection entries can be used with the mouse.
+
+Example:
+
+	Expected Inputs:
+	    A file menu with two headings built from selection entries, whose headings are clicked and moved over, and
+	    whose items are clicked.
+
+	Expected Outputs:
+	    Clicking a heading opens its menu beneath it, moving over another heading opens its menu instead, clicking an
+	    item selects it and closes the menu, and clicking the open heading closes its menu.
+*/
+func TestFileMenuMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	fileSelectionEntry := types.NewSelectionEntry()
+	fileSelectionEntry.Add("open", "Open")
+	fileSelectionEntry.Add("faq", "Q&A")
+	editSelectionEntry := types.NewSelectionEntry()
+	editSelectionEntry.Add("copy", "Copy")
+	fileMenuInstance := layer1.AddFileMenu(styleEntry, []string{"File", "Edit"}, []types.SelectionEntryType{fileSelectionEntry, editSelectionEntry}, 0, 0, true)
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	assert.Equalf(test, 'E', layerEntry.CharacterMemory[0][7].Character, "The headings were not drawn!")
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(2, 0, 1, "")
+	assert.Truef(test, FileMenu.updateStateMouse(), "Clicking a heading was not used by the file menu!")
+	UpdateDisplay(false)
+	assert.Truef(test, fileMenuInstance.IsOpen(), "Clicking a heading did not open its menu!")
+	assert.Equalf(test, styleEntry.FileMenu.HighlightBackgroundColor, layerEntry.CharacterMemory[0][1].AttributeEntry.BackgroundColor, "The open heading was not highlighted!")
+	assert.Equalf(test, 'O', layerEntry.CharacterMemory[2][4].Character, "The menu was not drawn beneath the heading!")
+	assert.Equalf(test, '&', layerEntry.CharacterMemory[3][5].Character, "An ampersand in a selection value was not drawn as is!")
+
+	SetMouseStatus(2, 0, 0, "")
+	assert.Truef(test, FileMenu.updateOpenMenuMouseEvent(), "Releasing the mouse over the open heading was passed on!")
+	SetMouseStatus(8, 0, 0, "")
+	FileMenu.updateOpenMenuMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, 1, FileMenus.Get(layer1.layerAlias, fileMenuInstance.controlAlias).ActiveHeadingIndex, "Moving over another heading did not open its menu!")
+	assert.Equalf(test, 'C', layerEntry.CharacterMemory[2][10].Character, "The menu of the other heading was not drawn beneath it!")
+	SetMouseStatus(10, 2, 0, "")
+	contextMenu.updateMouseEvent()
+	SetMouseStatus(10, 2, 1, "")
+	contextMenu.updateMouseEvent()
+	assert.Falsef(test, fileMenuInstance.IsOpen(), "Selecting an item did not close the menu!")
+	headingIndex, itemIndex, itemAlias, itemValue := fileMenuInstance.GetSelectedItem()
+	assert.Equalf(test, []interface{}{1, 0, "copy", "Copy"}, []interface{}{headingIndex, itemIndex, itemAlias, itemValue}, "The item clicked was not selected!")
+	headingIndex, _, _, _ = fileMenuInstance.GetSelectedItem()
+	assert.Equalf(test, -1, headingIndex, "The selection was not cleared once read!")
+
+	UpdateDisplay(false)
+	SetMouseStatus(10, 2, 0, "")
+	SetMouseStatus(2, 0, 1, "")
+	FileMenu.updateStateMouse()
+	UpdateDisplay(false)
+	SetMouseStatus(2, 0, 0, "")
+	FileMenu.updateOpenMenuMouseEvent()
+	SetMouseStatus(2, 0, 1, "")
+	FileMenu.updateOpenMenuMouseEvent()
+	assert.Falsef(test, fileMenuInstance.IsOpen(), "Clicking the open heading did not close its menu!")
+	assert.Falsef(test, IsContextMenuOpen(), "Clicking the open heading did not close the menu shown for it!")
+}
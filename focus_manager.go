@@ -0,0 +1,333 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"sort"
+)
+
+/*
+tabIndexEntryType is a structure which holds a control registered in the tab index, along with the tab order used to
+decide when it is visited.
+*/
+type tabIndexEntryType struct {
+	control  controlIdentifierType
+	tabOrder int
+}
+
+/*
+SetFocus is a method which allows you to move focus to the control given. In addition, the following should be noted:
+
+- The blur and focus handlers are called as if the user had changed focus.
+
+- The control does not need to be in the tab index to be given focus.
+
+Example:
+
+	SetFocus(&buttonInstance)
+*/
+func SetFocus(control ControlInstanceType) {
+	control.getBaseControlInstance().GetFocus()
+}
+
+/*
+GetFocusedControl is a method which allows you to obtain the control which currently has focus. In addition, the
+following should be noted:
+
+- If no control has focus, false is returned.
+
+- If the text field of a spinner has focus, the spinner is returned.
+
+Example:
+
+	focusedControl, isFocused := GetFocusedControl()
+	if isFocused && focusedControl.GetAlias() == buttonInstance.GetAlias() {
+		// The button has focus.
+	}
+*/
+func GetFocusedControl() (BaseControlInstanceType, bool) {
+	focusedControl := eventStateMemory.currentlyFocusedControl
+	if focusedControl.controlAlias == "" {
+		return BaseControlInstanceType{}, false
+	}
+	return getControlInstanceFromIdentifier(focusedControl), true
+}
+
+/*
+SetTabOrder is a method which allows you to choose when a control is visited as the user moves through the tab index.
+In addition, the following should be noted:
+
+- If the control is not already part of the tab index, it is added to it.
+
+  - Controls with a positive tab order are visited first, from the lowest tab order to the highest. Controls with a
+    tab order of 0, which is the default, are visited afterwards in the order they were added to the tab index.
+
+- If a negative tab order is specified, then a panic will be generated to fail as fast as possible.
+
+Example:
+
+	buttonInstance.SetTabOrder(1)
+*/
+func (shared *BaseControlInstanceType) SetTabOrder(tabOrder int) *BaseControlInstanceType {
+	validateTabOrder(tabOrder)
+	focusIdentifier := shared.getFocusIdentifier()
+	tabIndexPosition := getTabIndexPosition(focusIdentifier)
+	if tabIndexPosition == -1 {
+		eventStateMemory.tabIndexMemory = append(eventStateMemory.tabIndexMemory, tabIndexEntryType{control: focusIdentifier, tabOrder: tabOrder})
+	} else {
+		eventStateMemory.tabIndexMemory[tabIndexPosition].tabOrder = tabOrder
+	}
+	return shared
+}
+
+/*
+ClearTabIndex is a method which clears all registered tab index entries from memory.
+
+Example:
+
+	ClearTabIndex()
+*/
+func ClearTabIndex() {
+	eventStateMemory.tabIndexMemory = nil
+}
+
+/*
+addTabIndex is a method which registers a new control in the tab index memory for sequential navigation. If the
+control is already registered, no operation takes place.
+
+Example:
+
+	addTabIndex("layer1", "button1", constants.CellTypeButton)
+*/
+func addTabIndex(layerAlias string, controlAlias string, controlType int) {
+	controlEntry := controlIdentifierType{layerAlias: layerAlias, controlAlias: controlAlias, controlType: controlType}
+	if getTabIndexPosition(controlEntry) != -1 {
+		return
+	}
+	eventStateMemory.tabIndexMemory = append(eventStateMemory.tabIndexMemory, tabIndexEntryType{control: controlEntry})
+}
+
+/*
+removeLayerTabIndex is a method which allows you to remove every control on a layer from the tab index memory. If no
+control on the layer is registered, no operation takes place.
+
+Example:
+
+	removeLayerTabIndex("layer1")
+*/
+func removeLayerTabIndex(layerAlias string) {
+	var tabIndexEntries []tabIndexEntryType
+	for _, tabIndexEntry := range eventStateMemory.tabIndexMemory {
+		if tabIndexEntry.control.layerAlias != layerAlias {
+			tabIndexEntries = append(tabIndexEntries, tabIndexEntry)
+		}
+	}
+	eventStateMemory.tabIndexMemory = tabIndexEntries
+}
+
+/*
+getTabIndexPosition is a method which allows you to obtain where a control is registered in the tab index memory. If
+the control is not registered, -1 is returned.
+
+Example:
+
+	position := getTabIndexPosition(controlEntry)
+*/
+func getTabIndexPosition(controlEntry controlIdentifierType) int {
+	for currentIndex, tabIndexEntry := range eventStateMemory.tabIndexMemory {
+		if tabIndexEntry.control == controlEntry {
+			return currentIndex
+		}
+	}
+	return -1
+}
+
+/*
+nextTabIndex is a method which advances the focus to the next control in the registered tab index sequence. In
+addition, the following should be noted:
+
+- While a modal layer is shown, controls outside of it are skipped.
+
+- Controls on layers which are hidden, such as the pages of unselected tabs, are skipped.
+
+- Controls which are disabled or hidden are skipped.
+
+  - If the control focused is inside a layer marked as a focus scope, focus never leaves that layer and its
+    children.
+
+Example:
+
+	nextTabIndex()
+*/
+func nextTabIndex() {
+	moveTabIndex(1)
+}
+
+/*
+previousTabIndex is a method which moves the focus back to the previous control in the registered tab index sequence,
+skipping the same controls as nextTabIndex.
+
+Example:
+
+	previousTabIndex()
+*/
+func previousTabIndex() {
+	moveTabIndex(-1)
+}
+
+/*
+moveTabIndex is a method which moves the focus through the tab index sequence in the direction given. If the control
+focused is not part of the sequence, moving forwards focuses the first control and moving backwards focuses the last.
+
+Example:
+
+	moveTabIndex(-1)
+*/
+func moveTabIndex(direction int) {
+	tabSequence := getTabSequence(getFocusScopeLayerAlias(eventStateMemory.currentlyFocusedControl.layerAlias))
+	if len(tabSequence) == 0 {
+		// If nothing can be reached inside the focus scope, such as when its layer was hidden, it is ignored.
+		tabSequence = getTabSequence("")
+	}
+	if len(tabSequence) == 0 {
+		return
+	}
+	currentPosition := len(tabSequence)
+	if direction > 0 {
+		currentPosition = -1
+	}
+	for currentIndex, controlEntry := range tabSequence {
+		if controlEntry == eventStateMemory.currentlyFocusedControl {
+			currentPosition = currentIndex
+			break
+		}
+	}
+	nextPosition := (currentPosition + direction + len(tabSequence)) % len(tabSequence)
+	eventStateMemory.currentlyFocusedControl = tabSequence[nextPosition]
+}
+
+/*
+getTabSequence is a method which allows you to obtain the controls which can currently be reached with the tab key,
+in the order they are visited. If a focus scope layer alias is given, only controls on that layer or its children are
+returned.
+
+Example:
+
+	tabSequence := getTabSequence("dialogLayer")
+*/
+func getTabSequence(focusScopeLayerAlias string) []controlIdentifierType {
+	var tabIndexEntries []tabIndexEntryType
+	for _, tabIndexEntry := range eventStateMemory.tabIndexMemory {
+		layerAlias := tabIndexEntry.control.layerAlias
+		if !isLayerWithinActiveModal(layerAlias) || !isLayerShown(layerAlias) {
+			continue
+		}
+		if focusScopeLayerAlias != "" && !isLayerWithinLayer(layerAlias, focusScopeLayerAlias) {
+			continue
+		}
+		baseControl := getControlInstanceFromIdentifier(tabIndexEntry.control)
+		if baseControlEntry := baseControl.getBaseControl(); baseControlEntry != nil && (!baseControlEntry.IsEnabled || !baseControlEntry.IsVisible) {
+			continue
+		}
+		tabIndexEntries = append(tabIndexEntries, tabIndexEntry)
+	}
+	sort.SliceStable(tabIndexEntries, func(firstIndex int, secondIndex int) bool {
+		return isTabOrderBefore(tabIndexEntries[firstIndex].tabOrder, tabIndexEntries[secondIndex].tabOrder)
+	})
+	var tabSequence []controlIdentifierType
+	for _, tabIndexEntry := range tabIndexEntries {
+		tabSequence = append(tabSequence, tabIndexEntry.control)
+	}
+	return tabSequence
+}
+
+/*
+isTabOrderBefore is a method which allows you to detect if a control with one tab order is visited before a control
+with another. Positive tab orders come first in ascending order, followed by controls with a tab order of 0.
+
+Example:
+
+	isTabOrderBefore(1, 0)
+*/
+func isTabOrderBefore(tabOrder int, otherTabOrder int) bool {
+	if tabOrder > 0 && otherTabOrder > 0 {
+		return tabOrder < otherTabOrder
+	}
+	return tabOrder > 0 && otherTabOrder == 0
+}
+
+/*
+getFocusScopeLayerAlias is a method which allows you to obtain the focus scope a layer belongs to, which is the
+closest layer marked as a focus scope out of the layer itself and its parents. If the layer is not inside a focus
+scope, an empty string is returned.
+
+Example:
+
+	focusScopeLayerAlias := getFocusScopeLayerAlias("buttonLayer")
+*/
+func getFocusScopeLayerAlias(layerAlias string) string {
+	for layerAlias != "" && Layers.IsExists(layerAlias) {
+		layerEntry := Layers.Get(layerAlias)
+		if layerEntry.IsFocusScope {
+			return layerAlias
+		}
+		layerAlias = layerEntry.ParentAlias
+	}
+	return ""
+}
+
+/*
+getControlInstanceFromIdentifier is a method which allows you to obtain a control instance from the identifier used
+by the event manager to track focus. This reverses the conversion made when a control is given focus, so that a
+spinner is returned for the text field it edits with, and a viewport is returned for its textbox cells.
+
+Example:
+
+	controlInstance := getControlInstanceFromIdentifier(eventStateMemory.currentlyFocusedControl)
+*/
+func getControlInstanceFromIdentifier(controlEntry controlIdentifierType) BaseControlInstanceType {
+	controlInstance := BaseControlInstanceType{layerAlias: controlEntry.layerAlias, controlAlias: controlEntry.controlAlias}
+	switch controlEntry.controlType {
+	case constants.CellTypeButton:
+		controlInstance.controlType = constants.TYPE_BUTTON
+	case constants.CellTypeCheckbox:
+		controlInstance.controlType = constants.TYPE_CHECKBOX
+	case constants.CellTypeDropdown:
+		controlInstance.controlType = constants.TYPE_DROPDOWN
+	case constants.CellTypeLabel:
+		controlInstance.controlType = constants.TYPE_LABEL
+	case constants.CellTypeProgressBar:
+		controlInstance.controlType = constants.TYPE_PROGRESSBAR
+	case constants.CellTypeScrollbar:
+		controlInstance.controlType = constants.TYPE_SCROLLBAR
+	case constants.CellTypeSelectorItem:
+		controlInstance.controlType = constants.TYPE_SELECTOR
+	case constants.CellTypeSlider:
+		controlInstance.controlType = constants.TYPE_SLIDER
+	case constants.CellTypeTextbox:
+		controlInstance.controlType = constants.TYPE_TEXTBOX
+		if Viewports.IsExists(controlEntry.layerAlias, controlEntry.controlAlias) {
+			controlInstance.controlType = constants.TYPE_VIEWPORT
+		}
+	case constants.CellTypeTextField:
+		controlInstance.controlType = constants.TYPE_TEXTFIELD
+		for _, spinnerEntry := range Spinners.GetAllEntries(controlEntry.layerAlias) {
+			if spinnerEntry.TextFieldAlias == controlEntry.controlAlias {
+				controlInstance.controlAlias = spinnerEntry.Alias
+				controlInstance.controlType = constants.TYPE_SPINNER
+			}
+		}
+	case constants.CellTypeTreeView:
+		controlInstance.controlType = constants.TYPE_TREEVIEW
+	case constants.CellTypeTable:
+		controlInstance.controlType = constants.TYPE_TABLE
+	case constants.CellTypeTabControl:
+		controlInstance.controlType = constants.TYPE_TABCONTROL
+	case constants.CellTypeTooltip:
+		controlInstance.controlType = constants.TYPE_TOOLTIP
+	case constants.CellTypeRadioButton:
+		controlInstance.controlType = constants.TYPE_RADIOBUTTON
+	case constants.CellTypeFileMenuHeading:
+		controlInstance.controlType = constants.TYPE_FILEMENU
+	}
+	return controlInstance
+}
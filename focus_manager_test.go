@@ -0,0 +1,119 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+TestFocusManagerTabOrder is a test which verifies that focus can be moved forwards and backwards through the tab
+index, in the tab order chosen, without leaving a layer marked as a focus scope.
+
+Example:
+
+	Expected Inputs:
+	    Three buttons given explicit tab orders, a disabled button, and a dialog layer marked as a focus scope,
+	    navigated with tab and shift+tab.
+
+	Expected Outputs:
+	    Buttons are visited by tab order before those without one, shift+tab moves backwards and wraps around,
+	    disabled buttons are skipped, and focus never leaves the focus scope once inside it.
+*/
+func TestFocusManagerTabOrder(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	ClearTabIndex()
+	defer ClearTabIndex()
+	firstButton := layer1.AddButton("First", styleEntry, 0, 0, 8, 3, true)
+	secondButton := layer1.AddButton("Second", styleEntry, 10, 0, 8, 3, true)
+	thirdButton := layer1.AddButton("Third", styleEntry, 20, 0, 8, 3, true)
+	disabledButton := layer1.AddButton("Off", styleEntry, 30, 0, 8, 3, false)
+	firstButton.AddToTabIndex()
+	disabledButton.AddToTabIndex()
+	secondButton.SetTabOrder(2)
+	thirdButton.SetTabOrder(1)
+	setFocusedControl("", "", 0)
+
+	nextTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, thirdButton.controlAlias, constants.CellTypeButton), "Tab did not focus the control with the lowest tab order first!")
+	nextTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, secondButton.controlAlias, constants.CellTypeButton), "Tab did not focus the control with the next tab order!")
+	nextTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, firstButton.controlAlias, constants.CellTypeButton), "Tab did not focus the control without a tab order last!")
+	nextTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, thirdButton.controlAlias, constants.CellTypeButton), "Tab did not skip the disabled control and wrap around!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone))
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, firstButton.controlAlias, constants.CellTypeButton), "Shift+tab did not move focus backwards and wrap around!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModShift))
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, secondButton.controlAlias, constants.CellTypeButton), "Tab with shift held down did not move focus backwards!")
+
+	dialogLayer := AddLayer(0, 5, 30, 5, 4, nil)
+	dialogLayer.SetFocusScope(true)
+	okButton := dialogLayer.AddButton("OK", styleEntry, 0, 0, 8, 3, true)
+	cancelButton := dialogLayer.AddButton("Cancel", styleEntry, 10, 0, 8, 3, true)
+	okButton.AddToTabIndex()
+	cancelButton.AddToTabIndex()
+	SetFocus(&okButton)
+	for currentPress := 0; currentPress < 3; currentPress++ {
+		nextTabIndex()
+		focusedControl, _ := GetFocusedControl()
+		assert.Equalf(test, dialogLayer.layerAlias, focusedControl.GetLayerAlias(), "Tab moved focus outside the focus scope!")
+	}
+	previousTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(dialogLayer.layerAlias, okButton.controlAlias, constants.CellTypeButton), "Shift+tab did not stay inside the focus scope!")
+	dialogLayer.SetFocusScope(false)
+	nextTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(dialogLayer.layerAlias, cancelButton.controlAlias, constants.CellTypeButton), "Tab did not continue through the tab index!")
+	nextTabIndex()
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, thirdButton.controlAlias, constants.CellTypeButton), "Tab did not leave a layer which is no longer a focus scope!")
+	assert.Panicsf(test, func() {
+		firstButton.SetTabOrder(-1)
+	}, "A negative tab order did not panic!")
+}
+
+/*
+TestFocusManagerFocusApi is a test which verifies that focus can be set and obtained directly, and that radio buttons
+can be navigated with the arrow keys.
+
+Example:
+
+	Expected Inputs:
+	    A spinner and a group of three radio buttons, one disabled, given focus directly and navigated with the arrow
+	    keys and space.
+
+	Expected Outputs:
+	    The control given focus is reported as focused, a spinner is reported instead of the text field it edits with,
+	    and the arrow keys select and focus the next radio button of the group in screen order, skipping disabled
+	    ones and wrapping around.
+*/
+func TestFocusManagerFocusApi(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	setFocusedControl("", "", 0)
+	_, isFocused := GetFocusedControl()
+	assert.Falsef(test, isFocused, "A control was reported as focused when none was!")
+	spinnerInstance := layer1.AddSpinner(styleEntry, 0, 0, 10, 0, 10, 1, 5, 0, true)
+	SetFocus(&spinnerInstance)
+	focusedControl, isFocused := GetFocusedControl()
+	assert.Truef(test, isFocused, "The control given focus was not reported as focused!")
+	assert.Equalf(test, []string{spinnerInstance.controlAlias, constants.TYPE_SPINNER}, []string{focusedControl.GetAlias(), focusedControl.controlType}, "The spinner given focus was not reported as focused!")
+
+	bottomRadioButton := layer1.AddRadioButton("Bottom", styleEntry, 0, 6, 1, false)
+	topRadioButton := layer1.AddRadioButton("Top", styleEntry, 0, 2, 1, true)
+	middleRadioButton := layer1.AddRadioButton("Middle", styleEntry, 0, 4, 1, false)
+	otherRadioButton := layer1.AddRadioButton("Other", styleEntry, 0, 8, 2, true)
+	SetFocus(&topRadioButton)
+	radioButton.updateKeyboardEvent([]rune("down"))
+	assert.Truef(test, middleRadioButton.IsSelected(), "Down did not select the next radio button of the group!")
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, middleRadioButton.controlAlias, constants.CellTypeRadioButton), "Down did not focus the next radio button of the group!")
+	assert.Truef(test, otherRadioButton.IsSelected(), "Down changed the selection of another group!")
+	middleRadioButton.SetEnabled(false)
+	radioButton.updateKeyboardEvent([]rune("down"))
+	radioButton.updateKeyboardEvent([]rune("down"))
+	assert.Truef(test, topRadioButton.IsSelected(), "Down did not skip the disabled radio button and wrap around!")
+	radioButton.updateKeyboardEvent([]rune("up"))
+	assert.Truef(test, bottomRadioButton.IsSelected(), "Up did not wrap around to the last radio button of the group!")
+	SetFocus(&topRadioButton)
+	_, isConsumed := radioButton.updateKeyboardEvent([]rune(" "))
+	assert.Truef(test, isConsumed && topRadioButton.IsSelected(), "Space did not select the radio button focused!")
+}
@@ -604,34 +604,6 @@ func OverlayImageWithAlpha(sourceImage image.Image, sourceXLocation int, sourceY
 	return resultImage
 }
 
-/*
-max is a method which allows you to determine the maximum of two float64 values.
-
-Example:
-
-	m := max(10.5, 20.1)
-*/
-func max(a, b float64) float64 {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-/*
-min is a method which allows you to determine the minimum of two float64 values.
-
-Example:
-
-	m := min(10.5, 20.1)
-*/
-func min(a, b float64) float64 {
-	if a < b {
-		return a
-	}
-	return b
-}
-
 /*
 blendColors is a method which allows you to blend two colors based on an alpha value. In addition, the following should
 be noted:
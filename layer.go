@@ -315,6 +315,7 @@ func (shared *layerType) print(layerEntry *types.LayerEntryType, attributeEntry
 				attrToUse = attributeEntry
 			}
 			shared.renderCharacter(characterMemory, cursorXLocation, cursorYLocation, currentCharacter, attrToUse)
+			layerEntry.MarkDirtyRegion(cursorXLocation, cursorYLocation, stringformat.GetWidthOfRunesWhenPrinted([]rune{currentCharacter}), 1)
 		}
 
 		// Advance cursor
@@ -515,10 +516,30 @@ func (shared *layerType) Delete(layerAlias string) {
 	RadioButtons.RemoveAll(layerAlias)
 	ScrollBars.RemoveAll(layerAlias)
 	Selectors.RemoveAll(layerAlias)
+	Sliders.RemoveAll(layerAlias)
+	sliderValueFormatters.RemoveAll(layerAlias)
+	Spinners.RemoveAll(layerAlias)
 	Textboxes.RemoveAll(layerAlias)
+	textboxSyntaxMemory.RemoveAll(layerAlias)
 	TextFields.RemoveAll(layerAlias)
+	textFieldValidators.RemoveAll(layerAlias)
+	textFieldSuggestions.RemoveAll(layerAlias)
 	Tooltips.RemoveAll(layerAlias)
+	TreeViews.RemoveAll(layerAlias)
+	treeViewChildProviders.RemoveAll(layerAlias)
+	Tables.RemoveAll(layerAlias)
+	tableDataMemory.RemoveAll(layerAlias)
+	TabControls.RemoveAll(layerAlias)
+	tabCloseHandlers.RemoveAll(layerAlias)
+	FileMenus.RemoveAll(layerAlias)
+	fileMenuSelectHandlers.RemoveAll(layerAlias)
 	Viewports.RemoveAll(layerAlias)
+	eventHandlers.RemoveAll(layerAlias)
+	removeLayerLayout(layerAlias)
+	removeLayerModal(layerAlias)
+	removeLayerModalDialog(layerAlias)
+	removeLayerContextMenu(layerAlias)
+	shortcutRegistry.deleteAll(layerAlias, "")
 	// Remove the layer itself
 	Layers.Remove(layerAlias)
 
@@ -906,6 +927,32 @@ func (shared *LayerInstanceType) AddSelector(styleEntry types.TuiStyleEntryType,
 	return selectorInstance
 }
 
+/*
+AddSlider is a method which allows you to add a new slider control to the current layer.
+
+Example:
+
+	sl := layerInstance.AddSlider(style, 10, 5, 20, 0, 100, 5, 50, true, true)
+*/
+func (shared *LayerInstanceType) AddSlider(styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, length int, minimumValue int, maximumValue int, step int, defaultValue int, isHorizontal bool, isEnabled bool) SliderInstanceType {
+	sliderAlias := getUUID()
+	sliderInstance := Slider.Add(shared.layerAlias, sliderAlias, styleEntry, xLocation, yLocation, length, minimumValue, maximumValue, step, defaultValue, isHorizontal, isEnabled)
+	return sliderInstance
+}
+
+/*
+AddSpinner is a method which allows you to add a new spinner control to the current layer.
+
+Example:
+
+	sp := layerInstance.AddSpinner(style, 10, 5, 8, 0, 100, 1, 50, 0, true)
+*/
+func (shared *LayerInstanceType) AddSpinner(styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, width int, minimumValue float64, maximumValue float64, step float64, defaultValue float64, decimalPlaces int, isEnabled bool) SpinnerInstanceType {
+	spinnerAlias := getUUID()
+	spinnerInstance := Spinner.Add(shared.layerAlias, spinnerAlias, styleEntry, xLocation, yLocation, width, minimumValue, maximumValue, step, defaultValue, decimalPlaces, isEnabled)
+	return spinnerInstance
+}
+
 /*
 AddTextField is a method which allows you to add a new text field control to the current layer.
 
@@ -945,6 +992,62 @@ func (shared *LayerInstanceType) AddTooltip(tooltipValue string, styleEntry type
 	return tooltipInstance
 }
 
+/*
+AddTabControl is a method which allows you to add a new tab control to the current layer. In addition, the following
+should be noted:
+
+  - Each tab added to the tab control owns a child layer of the current layer, which holds the controls of its page
+    and is only shown while the tab is selected.
+
+Example:
+
+	tabs := layerInstance.AddTabControl(style, 0, 0, 60, 20)
+	generalPage := tabs.AddTab("General", false)
+*/
+func (shared *LayerInstanceType) AddTabControl(styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) TabControlInstanceType {
+	tabControlAlias := getUUID()
+	tabControlInstance := TabControl.Add(shared.layerAlias, tabControlAlias, styleEntry, xLocation, yLocation, width, height)
+	return tabControlInstance
+}
+
+/*
+AddTable is a method which allows you to add a new table control to the current layer. In addition, the following
+should be noted:
+
+  - The rows of the table are read from the data source given as they are drawn. Use NewStringTableDataSource for
+    rows already held in memory, or implement TableDataSource to supply a large number of rows on demand.
+
+  - Scroll bars are drawn directly to the right of, and below, the table when its rows or columns do not fit.
+
+Example:
+
+	tbl := layerInstance.AddTable(style, columns, NewStringTableDataSource(rows), 2, 2, 40, 10)
+*/
+func (shared *LayerInstanceType) AddTable(styleEntry types.TuiStyleEntryType, columns []types.TableColumnEntryType, dataSource TableDataSource, xLocation int, yLocation int, width int, height int) TableInstanceType {
+	tableAlias := getUUID()
+	tableInstance := Table.Add(shared.layerAlias, tableAlias, styleEntry, columns, dataSource, xLocation, yLocation, width, height)
+	return tableInstance
+}
+
+/*
+AddTreeView is a method which allows you to add a new tree view control to the current layer. In addition, the
+following should be noted:
+
+  - The tree view starts with no nodes. Nodes can be added with AddNode, or loaded on demand by registering a child
+    provider with SetChildProvider.
+
+  - A vertical scroll bar is drawn directly to the right of the tree view when its rows do not fit.
+
+Example:
+
+	tv := layerInstance.AddTreeView(style, 2, 2, 30, 10)
+*/
+func (shared *LayerInstanceType) AddTreeView(styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) TreeViewInstanceType {
+	treeViewAlias := getUUID()
+	treeViewInstance := TreeView.Add(shared.layerAlias, treeViewAlias, styleEntry, xLocation, yLocation, width, height)
+	return treeViewInstance
+}
+
 /*
 AddViewport is a method which allows you to add a viewport to a given text layer. A viewport is a read-only text display
 control that can show text with markup codes for colorization. It supports scrollback history and text wrapping.
@@ -986,7 +1089,7 @@ In addition, the following should be noted:
 
 - The top level headings widths are always dynamic based on how large the heading is.
 
-- The file menu reuses existing selectors for dropdown functionality.
+- To use nested menus, separators, disabled items, checkable items, or shortcuts, use AddFileMenuWithMenuEntries.
 
 Example:
 
@@ -998,6 +1101,26 @@ func (shared *LayerInstanceType) AddFileMenu(styleEntry types.TuiStyleEntryType,
 	return fileMenuInstance
 }
 
+/*
+AddFileMenuWithMenuEntries is a method which allows you to add a file menu to a layer, where the dropdown of each
+heading is built from a menu entry. In addition, the following should be noted:
+
+- Menu entries support nested menus to any depth, separators, disabled items, checkable items, and shortcut hints.
+
+- An ampersand in a heading marks the letter which opens it when pressed along with the alt key.
+
+- The shortcut hint of an item also works as its shortcut, even while the file menu is closed.
+
+Example:
+
+	fm := layerInstance.AddFileMenuWithMenuEntries(style, []string{"&File", "&Edit"}, menuEntries, 0, 0, true)
+*/
+func (shared *LayerInstanceType) AddFileMenuWithMenuEntries(styleEntry types.TuiStyleEntryType, menuHeadings []string, menuEntries []types.MenuEntryType, xLocation int, yLocation int, isEnabled bool) FileMenuInstanceType {
+	menuAlias := getUUID()
+	fileMenuInstance := FileMenu.AddWithMenuEntries(shared.layerAlias, menuAlias, styleEntry, menuHeadings, menuEntries, xLocation, yLocation, isEnabled)
+	return fileMenuInstance
+}
+
 /*
 DeleteAllButtons is a method which allows you to remove all buttons from the current layer.
 
@@ -1086,6 +1209,28 @@ func (shared *LayerInstanceType) DeleteAllSelectors() {
 	Selectors.RemoveAll(shared.layerAlias)
 }
 
+/*
+DeleteAllSliders is a method which allows you to remove all sliders from the current layer.
+
+Example:
+
+	layerInstance.DeleteAllSliders()
+*/
+func (shared *LayerInstanceType) DeleteAllSliders() {
+	Slider.DeleteAll(shared.layerAlias)
+}
+
+/*
+DeleteAllSpinners is a method which allows you to remove all spinners from the current layer.
+
+Example:
+
+	layerInstance.DeleteAllSpinners()
+*/
+func (shared *LayerInstanceType) DeleteAllSpinners() {
+	Spinner.DeleteAll(shared.layerAlias)
+}
+
 /*
 DeleteAllTextFields is a method which allows you to remove all text fields from the current layer.
 
@@ -1094,7 +1239,7 @@ Example:
 	layerInstance.DeleteAllTextFields()
 */
 func (shared *LayerInstanceType) DeleteAllTextFields() {
-	TextFields.RemoveAll(shared.layerAlias)
+	TextField.DeleteAll(shared.layerAlias)
 }
 
 /*
@@ -1119,6 +1264,40 @@ func (shared *LayerInstanceType) DeleteAllTooltips() {
 	Tooltips.RemoveAll(shared.layerAlias)
 }
 
+/*
+DeleteAllTabControls is a method which allows you to remove all tab controls from the current layer, along with the
+layers of all of their tabs.
+
+Example:
+
+	layerInstance.DeleteAllTabControls()
+*/
+func (shared *LayerInstanceType) DeleteAllTabControls() {
+	TabControl.DeleteAll(shared.layerAlias)
+}
+
+/*
+DeleteAllTables is a method which allows you to remove all tables from the current layer.
+
+Example:
+
+	layerInstance.DeleteAllTables()
+*/
+func (shared *LayerInstanceType) DeleteAllTables() {
+	Table.DeleteAll(shared.layerAlias)
+}
+
+/*
+DeleteAllTreeViews is a method which allows you to remove all tree views from the current layer.
+
+Example:
+
+	layerInstance.DeleteAllTreeViews()
+*/
+func (shared *LayerInstanceType) DeleteAllTreeViews() {
+	TreeView.DeleteAll(shared.layerAlias)
+}
+
 /*
 DeleteAllViewports is a method which allows you to remove all viewports from the current layer.
 
@@ -1138,7 +1317,7 @@ Example:
 	layerInstance.DeleteAllFileMenus()
 */
 func (shared *LayerInstanceType) DeleteAllFileMenus() {
-	FileMenus.RemoveAll(shared.layerAlias)
+	FileMenu.DeleteAll(shared.layerAlias)
 }
 
 /*
@@ -1546,6 +1725,68 @@ func (shared *LayerInstanceType) SetIsVisible(isVisible bool) {
 	setLayerIsVisible(shared.layerAlias, isVisible)
 }
 
+/*
+SetFocusScope is a method which allows you to keep keyboard focus within the current layer. In addition, the
+following should be noted:
+
+  - While a control on the layer, or on one of its children, has focus, tab and shift+tab only move between the
+    controls on those layers. This prevents focus from escaping a dialog.
+
+- Focus can still be moved elsewhere with the mouse, or by calling SetFocus.
+
+Example:
+
+	dialogLayer.SetFocusScope(true)
+*/
+func (shared *LayerInstanceType) SetFocusScope(isFocusScope bool) {
+	validateLayer(shared.layerAlias)
+	Layers.Get(shared.layerAlias).IsFocusScope = isFocusScope
+}
+
+/*
+SetAnchor is a method which allows you to control how the current layer follows changes in the size of its container
+when the terminal is resized. In addition, the following should be noted:
+
+  - The container of a layer is the terminal itself, or its parent layer if it has one.
+
+  - Anchor styles are combined from constants.AnchorLeft, constants.AnchorTop, constants.AnchorRight, and
+    constants.AnchorBottom. By default, layers are anchored to nothing, which keeps them fixed at their current
+    location and size.
+
+  - If a layer is anchored to both the left and right edges, it stretches horizontally so that its distance from the
+    right edge never changes. If it is only anchored to the right edge, it moves instead. The top and bottom edges
+    work the same way vertically.
+
+  - Distances to the right and bottom edges are measured at the time this method is called.
+
+  - If an invalid anchor style is specified, then a panic will be generated to fail as fast as possible.
+
+Example:
+
+	layerInstance.SetAnchor(constants.AnchorLeft | constants.AnchorRight | constants.AnchorBottom)
+*/
+func (shared *LayerInstanceType) SetAnchor(anchorStyle int) {
+	validateLayer(shared.layerAlias)
+	validateLayerAnchorStyle(anchorStyle)
+	layerEntry := Layers.Get(shared.layerAlias)
+	containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+	layerEntry.AnchorStyle = anchorStyle
+	layerEntry.AnchorRightMargin = containerWidth - (layerEntry.ScreenXLocation + layerEntry.Width)
+	layerEntry.AnchorBottomMargin = containerHeight - (layerEntry.ScreenYLocation + layerEntry.Height)
+}
+
+/*
+GetAnchor is a method which allows you to obtain the anchor style of the current layer.
+
+Example:
+
+	anchorStyle := layerInstance.GetAnchor()
+*/
+func (shared *LayerInstanceType) GetAnchor() int {
+	validateLayer(shared.layerAlias)
+	return Layers.Get(shared.layerAlias).AnchorStyle
+}
+
 /*
 SetTopmost is a method which allows you to set the current layer to be the topmost layer within its parent hierarchy.
 
@@ -1700,28 +1941,7 @@ Example:
 	layerInstance.Resize(100, 50)
 */
 func (shared *LayerInstanceType) Resize(width int, height int) {
-	validateLayer(shared.layerAlias)
-	validateLayerSize(shared.layerAlias, width, height)
-	layerEntry := Layers.Get(shared.layerAlias)
-
-	// Create a new character memory with the new dimensions
-	newCharacterMemory := make([][]types.CharacterEntryType, height)
-	for i := range newCharacterMemory {
-		newCharacterMemory[i] = make([]types.CharacterEntryType, width)
-		for j := range newCharacterMemory[i] {
-			newCharacterMemory[i][j] = types.NewCharacterEntry()
-			newCharacterMemory[i][j].AttributeEntry = layerEntry.DefaultAttribute
-			newCharacterMemory[i][j].LayerAlias = layerEntry.LayerAlias
-			newCharacterMemory[i][j].ParentAlias = layerEntry.ParentAlias
-		}
-	}
-
-	// Copy the existing character memory to the new one
-	copyCharacterMemory(layerEntry.CharacterMemory, newCharacterMemory, 0, 0, layerEntry.Width, layerEntry.Height)
-
-	layerEntry.Width = width
-	layerEntry.Height = height
-	layerEntry.CharacterMemory = newCharacterMemory
+	resizeLayer(shared.layerAlias, width, height)
 }
 
 /*
@@ -1932,6 +2152,45 @@ func moveLayerByRelativeValue(layerAlias string, xLocation int, yLocation int) {
 	layerEntry.ScreenYLocation += yLocation
 }
 
+/*
+resizeLayer is a method which allows you to change the width and height of a layer. In addition, the following should
+be noted:
+
+- Any existing content which still fits within the new dimensions is preserved.
+
+- If a layout is attached to the layer, its controls are repositioned to fit the new dimensions.
+
+Example:
+
+	resizeLayer("myLayer", 100, 50)
+*/
+func resizeLayer(layerAlias string, width int, height int) {
+	validateLayer(layerAlias)
+	validateLayerSize(layerAlias, width, height)
+	layerEntry := Layers.Get(layerAlias)
+
+	// Create a new character memory with the new dimensions
+	newCharacterMemory := make([][]types.CharacterEntryType, height)
+	for i := range newCharacterMemory {
+		newCharacterMemory[i] = make([]types.CharacterEntryType, width)
+		for j := range newCharacterMemory[i] {
+			newCharacterMemory[i][j] = types.NewCharacterEntry()
+			newCharacterMemory[i][j].AttributeEntry = layerEntry.DefaultAttribute
+			newCharacterMemory[i][j].LayerAlias = layerEntry.LayerAlias
+			newCharacterMemory[i][j].ParentAlias = layerEntry.ParentAlias
+		}
+	}
+
+	// Copy the existing character memory to the new one
+	copyCharacterMemory(layerEntry.CharacterMemory, newCharacterMemory, 0, 0, layerEntry.Width, layerEntry.Height)
+
+	layerEntry.Width = width
+	layerEntry.Height = height
+	layerEntry.CharacterMemory = newCharacterMemory
+	layerEntry.MarkFullyDirty()
+	updateLayerLayout(layerAlias)
+}
+
 /*
 DeleteAllLayers is a method which allows you to remove all layers from memory and reinitialize screen memory.
 
@@ -1987,6 +2246,45 @@ func setLayerIsVisible(layerAlias string, isVisible bool) {
 	layerEntry.IsVisible = isVisible
 }
 
+/*
+isLayerShown is a method which allows you to detect if a layer is actually shown on screen. A layer which is visible
+is still hidden if any of its parents are not.
+
+Example:
+
+	isShown := isLayerShown("myLayer")
+*/
+func isLayerShown(layerAlias string) bool {
+	for layerAlias != "" {
+		if !Layers.IsExists(layerAlias) || !Layers.Get(layerAlias).IsVisible {
+			return false
+		}
+		layerAlias = Layers.Get(layerAlias).ParentAlias
+	}
+	return true
+}
+
+/*
+isLayerWithinLayer is a method which allows you to detect if a layer is the same as a given ancestor layer, or is
+one of its children at any depth.
+
+Example:
+
+	isWithin := isLayerWithinLayer("childLayer", "parentLayer")
+*/
+func isLayerWithinLayer(layerAlias string, ancestorAlias string) bool {
+	for layerAlias != "" {
+		if layerAlias == ancestorAlias {
+			return true
+		}
+		if !Layers.IsExists(layerAlias) {
+			return false
+		}
+		layerAlias = Layers.Get(layerAlias).ParentAlias
+	}
+	return false
+}
+
 /*
 validateLayerSize is a method which allows you to check if the given width and height are valid for a layer.
 
@@ -2002,3 +2300,84 @@ func validateLayerSize(layerAlias string, width int, height int) {
 		panic(fmt.Sprintf("The layer '%s' could not be resized since a height of '%d' was specified!", layerAlias, height))
 	}
 }
+
+/*
+validateLayerAnchorStyle is a method which allows you to check if the given anchor style is a valid combination of
+anchor constants.
+
+Example:
+
+	validateLayerAnchorStyle(constants.AnchorLeft | constants.AnchorRight)
+*/
+func validateLayerAnchorStyle(anchorStyle int) {
+	if anchorStyle < constants.AnchorNone || anchorStyle > constants.AnchorAll {
+		safeSttyPanic(fmt.Sprintf("The anchor style '%d' is invalid!", anchorStyle))
+	}
+}
+
+/*
+getLayerContainerSize is a method which allows you to obtain the width and height of the area a layer is positioned
+within. In addition, the following should be noted:
+
+- If the layer has a parent, the size of the parent layer is returned.
+
+- Otherwise, the size of the terminal is returned.
+
+Example:
+
+	containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+*/
+func getLayerContainerSize(layerEntry *types.LayerEntryType) (int, int) {
+	if layerEntry.ParentAlias != "" && Layers.IsExists(layerEntry.ParentAlias) {
+		parentLayerEntry := Layers.Get(layerEntry.ParentAlias)
+		return parentLayerEntry.Width, parentLayerEntry.Height
+	}
+	return commonResource.terminalWidth, commonResource.terminalHeight
+}
+
+/*
+applyLayerAnchors is a method which allows you to reposition and resize every layer within a container according to
+its anchor style. In addition, the following should be noted:
+
+  - Layers are processed from the container specified downward, so that parent layers are resized before any of
+    their children.
+
+  - Layers are never shrunk below a width or height of one.
+
+Example:
+
+	applyLayerAnchors("")
+*/
+func applyLayerAnchors(parentAlias string) {
+	for _, layerEntry := range Layers.GetAllEntries() {
+		if layerEntry.ParentAlias != parentAlias || layerEntry.LayerAlias == parentAlias {
+			continue
+		}
+		containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+		anchorStyle := layerEntry.AnchorStyle
+		width := layerEntry.Width
+		height := layerEntry.Height
+		if anchorStyle&constants.AnchorLeft != 0 && anchorStyle&constants.AnchorRight != 0 {
+			width = containerWidth - layerEntry.ScreenXLocation - layerEntry.AnchorRightMargin
+		} else if anchorStyle&constants.AnchorRight != 0 {
+			layerEntry.ScreenXLocation = containerWidth - layerEntry.AnchorRightMargin - width
+		}
+		if anchorStyle&constants.AnchorTop != 0 && anchorStyle&constants.AnchorBottom != 0 {
+			height = containerHeight - layerEntry.ScreenYLocation - layerEntry.AnchorBottomMargin
+		} else if anchorStyle&constants.AnchorBottom != 0 {
+			layerEntry.ScreenYLocation = containerHeight - layerEntry.AnchorBottomMargin - height
+		}
+		if width < 1 {
+			width = 1
+		}
+		if height < 1 {
+			height = 1
+		}
+		if width != layerEntry.Width || height != layerEntry.Height {
+			resizeLayer(layerEntry.LayerAlias, width, height)
+		}
+		if layerEntry.IsParent {
+			applyLayerAnchors(layerEntry.LayerAlias)
+		}
+	}
+}
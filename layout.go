@@ -0,0 +1,872 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+)
+
+const (
+	layoutTypeStack = iota
+	layoutTypeGrid
+	layoutTypeDock
+)
+
+/*
+layoutEntryType is a structure which represents a layout container and the children it is responsible for
+positioning.
+*/
+type layoutEntryType struct {
+	layoutType    int
+	isHorizontal  bool
+	spacing       int
+	columnWeights []float64
+	columnSpacing int
+	rowSpacing    int
+	children      []*layoutChildEntryType
+	parent        *layoutEntryType
+	layerAlias    string
+	margin        int
+}
+
+/*
+layoutChildEntryType is a structure which represents a single control or nested layout held by a layout container.
+*/
+type layoutChildEntryType struct {
+	control        *BaseControlInstanceType
+	layout         *layoutEntryType
+	length         int
+	columnSpan     int
+	dockStyle      int
+	measuredWidth  int
+	measuredHeight int
+}
+
+/*
+Layout is a type which represents any layout container that can be attached to a layer or nested inside another
+layout.
+*/
+type Layout interface {
+	getLayoutEntry() *layoutEntryType
+}
+
+/*
+LayoutInstanceType is a structure which provides the functionality shared by all layout containers.
+*/
+type LayoutInstanceType struct {
+	layoutEntry *layoutEntryType
+}
+
+/*
+StackLayoutInstanceType is a structure which represents a layout container that places its children one after another
+either horizontally or vertically.
+*/
+type StackLayoutInstanceType struct {
+	LayoutInstanceType
+}
+
+/*
+GridLayoutInstanceType is a structure which represents a layout container that places its children in rows of
+proportionally sized columns.
+*/
+type GridLayoutInstanceType struct {
+	LayoutInstanceType
+}
+
+/*
+DockLayoutInstanceType is a structure which represents a layout container that attaches its children to the edges of
+the available area.
+*/
+type DockLayoutInstanceType struct {
+	LayoutInstanceType
+}
+
+/*
+layerLayouts is a variable which holds the root layout container attached to each layer.
+*/
+var layerLayouts = memory.NewMemoryManager[layoutEntryType]()
+
+/*
+NewStackLayout is a constructor which allows you to create a new stack layout. In addition, the following should be
+noted:
+
+- If horizontal, children are placed from left to right and stretched to the full available height.
+
+- Otherwise, children are placed from top to bottom and stretched to the full available width.
+
+- The spacing specifies the number of blank cells left between each child.
+
+Example:
+
+	stackLayout := NewStackLayout(false, 1)
+*/
+func NewStackLayout(isHorizontal bool, spacing int) *StackLayoutInstanceType {
+	validateLayoutSpacing(spacing)
+	var stackLayoutInstance StackLayoutInstanceType
+	stackLayoutInstance.layoutEntry = &layoutEntryType{layoutType: layoutTypeStack, isHorizontal: isHorizontal, spacing: spacing}
+	return &stackLayoutInstance
+}
+
+/*
+NewGridLayout is a constructor which allows you to create a new grid layout. In addition, the following should be
+noted:
+
+  - Each column weight specifies the fraction of the available width the column receives. For example, weights of
+    1 and 2 give the second column twice the width of the first.
+
+  - Any width left over from rounding is given to the last column.
+
+  - Each row is as tall as the tallest child placed in it.
+
+  - If no column weights, or a weight of zero or less, is provided, a panic will be generated to fail as fast as
+    possible.
+
+Example:
+
+	gridLayout := NewGridLayout([]float64{1, 3}, 1, 0)
+*/
+func NewGridLayout(columnWeights []float64, columnSpacing int, rowSpacing int) *GridLayoutInstanceType {
+	validateLayoutColumnWeights(columnWeights)
+	validateLayoutSpacing(columnSpacing)
+	validateLayoutSpacing(rowSpacing)
+	var gridLayoutInstance GridLayoutInstanceType
+	gridLayoutInstance.layoutEntry = &layoutEntryType{layoutType: layoutTypeGrid, columnWeights: append([]float64{}, columnWeights...), columnSpacing: columnSpacing, rowSpacing: rowSpacing}
+	return &gridLayoutInstance
+}
+
+/*
+NewDockLayout is a constructor which allows you to create a new dock layout. In addition, the following should be
+noted:
+
+- Children are docked in the order they are added, with each one taking space from what remains.
+
+- A child docked with 'constants.DockFill' receives all the space left over.
+
+Example:
+
+	dockLayout := NewDockLayout()
+*/
+func NewDockLayout() *DockLayoutInstanceType {
+	var dockLayoutInstance DockLayoutInstanceType
+	dockLayoutInstance.layoutEntry = &layoutEntryType{layoutType: layoutTypeDock}
+	return &dockLayoutInstance
+}
+
+/*
+getLayoutEntry is a method which allows you to obtain the layout entry backing a layout instance.
+
+Example:
+
+	layoutEntry := layoutInstance.getLayoutEntry()
+*/
+func (shared *LayoutInstanceType) getLayoutEntry() *layoutEntryType {
+	return shared.layoutEntry
+}
+
+/*
+Update is a method which allows you to recompute the position and size of every child in a layout. In addition, the
+following should be noted:
+
+- Layouts are updated automatically when children are added or when the layer they are attached to is resized.
+
+- This is only needed if you change the size of a control manually and want the layout to take it into account.
+
+- If the layout is not attached to a layer, this method does nothing.
+
+Example:
+
+	layoutInstance.Update()
+*/
+func (shared *LayoutInstanceType) Update() {
+	updateLayout(shared.layoutEntry)
+}
+
+/*
+AddControl is a method which allows you to add a control to a stack layout. In addition, the following should be
+noted:
+
+  - The length is the number of cells the control occupies along the direction of the stack. Use
+    'constants.LayoutLengthAuto' to keep the size the control was created with, or 'constants.LayoutLengthFill'
+    to share any remaining space with other filling children.
+
+  - The current size of the control is remembered when it is added, and is used whenever its size is measured.
+
+Example:
+
+	stackLayout.AddControl(&button, constants.LayoutLengthAuto)
+*/
+func (shared *StackLayoutInstanceType) AddControl(control ControlInstanceType, length int) *StackLayoutInstanceType {
+	validateLayoutLength(length)
+	addLayoutControl(shared.layoutEntry, control, &layoutChildEntryType{length: length})
+	return shared
+}
+
+/*
+AddLayout is a method which allows you to nest another layout inside a stack layout. In addition, the following
+should be noted:
+
+  - The length is interpreted in the same way as for controls, with automatic lengths being measured from the
+    children of the nested layout.
+
+  - If the layout provided is already nested or attached to a layer, a panic will be generated to fail as fast as
+    possible.
+
+Example:
+
+	stackLayout.AddLayout(gridLayout, constants.LayoutLengthFill)
+*/
+func (shared *StackLayoutInstanceType) AddLayout(layout Layout, length int) *StackLayoutInstanceType {
+	validateLayoutLength(length)
+	addLayoutChild(shared.layoutEntry, layout, &layoutChildEntryType{length: length})
+	return shared
+}
+
+/*
+AddControl is a method which allows you to add a control to the next free cell of a grid layout. In addition, the
+following should be noted:
+
+- The column span specifies how many columns the control stretches across.
+
+- If the control does not fit in the remainder of the current row, it is placed at the start of the next row.
+
+Example:
+
+	gridLayout.AddControl(&label, 1)
+*/
+func (shared *GridLayoutInstanceType) AddControl(control ControlInstanceType, columnSpan int) *GridLayoutInstanceType {
+	validateLayoutColumnSpan(shared.layoutEntry, columnSpan)
+	addLayoutControl(shared.layoutEntry, control, &layoutChildEntryType{columnSpan: columnSpan})
+	return shared
+}
+
+/*
+AddLayout is a method which allows you to nest another layout in the next free cell of a grid layout. In addition, the
+following should be noted:
+
+  - If the layout provided is already nested or attached to a layer, a panic will be generated to fail as fast as
+    possible.
+
+Example:
+
+	gridLayout.AddLayout(stackLayout, 2)
+*/
+func (shared *GridLayoutInstanceType) AddLayout(layout Layout, columnSpan int) *GridLayoutInstanceType {
+	validateLayoutColumnSpan(shared.layoutEntry, columnSpan)
+	addLayoutChild(shared.layoutEntry, layout, &layoutChildEntryType{columnSpan: columnSpan})
+	return shared
+}
+
+/*
+AddControl is a method which allows you to dock a control to an edge of a dock layout. In addition, the following
+should be noted:
+
+  - The length is the width of controls docked to the left or right, or the height of controls docked to the top
+    or bottom. Use 'constants.LayoutLengthAuto' to keep the size the control was created with.
+
+  - The length is ignored for controls docked with 'constants.DockFill'.
+
+Example:
+
+	dockLayout.AddControl(&statusLabel, constants.DockBottom, 1)
+*/
+func (shared *DockLayoutInstanceType) AddControl(control ControlInstanceType, dockStyle int, length int) *DockLayoutInstanceType {
+	validateLayoutDockStyle(dockStyle)
+	validateLayoutLength(length)
+	addLayoutControl(shared.layoutEntry, control, &layoutChildEntryType{dockStyle: dockStyle, length: length})
+	return shared
+}
+
+/*
+AddLayout is a method which allows you to dock another layout to an edge of a dock layout. In addition, the following
+should be noted:
+
+  - If the layout provided is already nested or attached to a layer, a panic will be generated to fail as fast as
+    possible.
+
+Example:
+
+	dockLayout.AddLayout(stackLayout, constants.DockFill, 0)
+*/
+func (shared *DockLayoutInstanceType) AddLayout(layout Layout, dockStyle int, length int) *DockLayoutInstanceType {
+	validateLayoutDockStyle(dockStyle)
+	validateLayoutLength(length)
+	addLayoutChild(shared.layoutEntry, layout, &layoutChildEntryType{dockStyle: dockStyle, length: length})
+	return shared
+}
+
+/*
+SetLayout is a method which allows you to attach a layout to a layer, so that all controls in the layout are
+positioned within the layer automatically. In addition, the following should be noted:
+
+- The margin specifies the number of cells left blank around the edges of the layer.
+
+- Controls are positioned immediately, and again every time the layer is resized.
+
+- Only one layout can be attached to a layer. Attaching a new one replaces the old one.
+
+- Passing in nil detaches the current layout, leaving all controls where they are.
+
+Example:
+
+	layerInstance.SetLayout(stackLayout, 1)
+*/
+func (shared *LayerInstanceType) SetLayout(layout Layout, margin int) {
+	validateLayer(shared.layerAlias)
+	validateLayoutSpacing(margin)
+	if previousLayoutEntry := layerLayouts.Get(shared.layerAlias); previousLayoutEntry != nil {
+		previousLayoutEntry.layerAlias = ""
+		layerLayouts.Remove(shared.layerAlias)
+	}
+	if layout == nil {
+		return
+	}
+	layoutEntry := layout.getLayoutEntry()
+	validateLayoutIsUnattached(layoutEntry)
+	layoutEntry.layerAlias = shared.layerAlias
+	layoutEntry.margin = margin
+	layerLayouts.Add(shared.layerAlias, layoutEntry)
+	updateLayerLayout(shared.layerAlias)
+}
+
+/*
+addLayoutControl is a method which allows you to add a control as a child of a layout and recompute the layout.
+
+Example:
+
+	addLayoutControl(layoutEntry, &button, &layoutChildEntryType{length: 1})
+*/
+func addLayoutControl(layoutEntry *layoutEntryType, control ControlInstanceType, childEntry *layoutChildEntryType) {
+	baseControlInstance := *control.getBaseControlInstance()
+	childEntry.control = &baseControlInstance
+	childEntry.measuredWidth, childEntry.measuredHeight = baseControlInstance.GetSize()
+	if childEntry.measuredWidth < 1 {
+		childEntry.measuredWidth = 1
+	}
+	if childEntry.measuredHeight < 1 {
+		childEntry.measuredHeight = 1
+	}
+	layoutEntry.children = append(layoutEntry.children, childEntry)
+	updateLayout(layoutEntry)
+}
+
+/*
+addLayoutChild is a method which allows you to nest a layout as a child of another layout and recompute the layout.
+
+Example:
+
+	addLayoutChild(layoutEntry, gridLayout, &layoutChildEntryType{columnSpan: 1})
+*/
+func addLayoutChild(layoutEntry *layoutEntryType, layout Layout, childEntry *layoutChildEntryType) {
+	childLayoutEntry := layout.getLayoutEntry()
+	validateLayoutIsUnattached(childLayoutEntry)
+	for currentLayoutEntry := layoutEntry; currentLayoutEntry != nil; currentLayoutEntry = currentLayoutEntry.parent {
+		if currentLayoutEntry == childLayoutEntry {
+			safeSttyPanic("A layout could not be added since it would end up containing itself!")
+		}
+	}
+	childLayoutEntry.parent = layoutEntry
+	childEntry.layout = childLayoutEntry
+	layoutEntry.children = append(layoutEntry.children, childEntry)
+	updateLayout(layoutEntry)
+}
+
+/*
+updateLayout is a method which allows you to recompute the layer layout a layout entry belongs to.
+
+Example:
+
+	updateLayout(layoutEntry)
+*/
+func updateLayout(layoutEntry *layoutEntryType) {
+	rootLayoutEntry := layoutEntry
+	for rootLayoutEntry.parent != nil {
+		rootLayoutEntry = rootLayoutEntry.parent
+	}
+	if rootLayoutEntry.layerAlias != "" {
+		updateLayerLayout(rootLayoutEntry.layerAlias)
+	}
+}
+
+/*
+updateLayerLayout is a method which allows you to position every control in the layout attached to a layer so that
+it fits the current size of the layer. In addition, the following should be noted:
+
+- If no layout is attached to the layer, this method does nothing.
+
+Example:
+
+	updateLayerLayout("myLayer")
+*/
+func updateLayerLayout(layerAlias string) {
+	layoutEntry := layerLayouts.Get(layerAlias)
+	if layoutEntry == nil || !Layers.IsExists(layerAlias) {
+		return
+	}
+	layerEntry := Layers.Get(layerAlias)
+	margin := layoutEntry.margin
+	arrangeLayout(layoutEntry, margin, margin, layerEntry.Width-(margin*2), layerEntry.Height-(margin*2))
+}
+
+/*
+removeLayerLayout is a method which allows you to detach the layout from a layer, if one is attached.
+
+Example:
+
+	removeLayerLayout("myLayer")
+*/
+func removeLayerLayout(layerAlias string) {
+	if layoutEntry := layerLayouts.Get(layerAlias); layoutEntry != nil {
+		layoutEntry.layerAlias = ""
+		layerLayouts.Remove(layerAlias)
+	}
+}
+
+/*
+measureLayout is a method which allows you to obtain the width and height a layout needs in order to give every child
+its preferred size.
+
+Example:
+
+	width, height := measureLayout(layoutEntry)
+*/
+func measureLayout(layoutEntry *layoutEntryType) (int, int) {
+	width := 0
+	height := 0
+	switch layoutEntry.layoutType {
+	case layoutTypeStack:
+		for currentIndex, currentChild := range layoutEntry.children {
+			childWidth, childHeight := measureLayoutChild(currentChild)
+			if currentChild.length > 0 {
+				if layoutEntry.isHorizontal {
+					childWidth = currentChild.length
+				} else {
+					childHeight = currentChild.length
+				}
+			}
+			if currentIndex > 0 {
+				childWidth, childHeight = getLayoutSpacedLength(layoutEntry, childWidth, childHeight)
+			}
+			if layoutEntry.isHorizontal {
+				width += childWidth
+				height = max(height, childHeight)
+			} else {
+				width = max(width, childWidth)
+				height += childHeight
+			}
+		}
+	case layoutTypeGrid:
+		numberOfColumns := len(layoutEntry.columnWeights)
+		columnWidths := make([]int, numberOfColumns)
+		for _, currentCell := range getGridLayoutCells(layoutEntry) {
+			childWidth, _ := measureLayoutChild(currentCell.child)
+			widthPerColumn := (childWidth - (layoutEntry.columnSpacing * (currentCell.child.columnSpan - 1)) + currentCell.child.columnSpan - 1) / currentCell.child.columnSpan
+			for currentColumn := currentCell.column; currentColumn < currentCell.column+currentCell.child.columnSpan; currentColumn++ {
+				columnWidths[currentColumn] = max(columnWidths[currentColumn], widthPerColumn)
+			}
+		}
+		for _, currentColumnWidth := range columnWidths {
+			width += currentColumnWidth
+		}
+		width += layoutEntry.columnSpacing * (numberOfColumns - 1)
+		rowHeights := getGridLayoutRowHeights(layoutEntry)
+		for currentRow, currentRowHeight := range rowHeights {
+			if currentRow > 0 {
+				height += layoutEntry.rowSpacing
+			}
+			height += currentRowHeight
+		}
+	case layoutTypeDock:
+		for currentIndex := len(layoutEntry.children) - 1; currentIndex >= 0; currentIndex-- {
+			currentChild := layoutEntry.children[currentIndex]
+			childWidth, childHeight := measureLayoutChild(currentChild)
+			switch currentChild.dockStyle {
+			case constants.DockTop, constants.DockBottom:
+				if currentChild.length > 0 {
+					childHeight = currentChild.length
+				}
+				width = max(width, childWidth)
+				height += childHeight
+			case constants.DockLeft, constants.DockRight:
+				if currentChild.length > 0 {
+					childWidth = currentChild.length
+				}
+				width += childWidth
+				height = max(height, childHeight)
+			default:
+				width = max(width, childWidth)
+				height = max(height, childHeight)
+			}
+		}
+	}
+	return width, height
+}
+
+/*
+measureLayoutChild is a method which allows you to obtain the preferred width and height of a layout child.
+
+Example:
+
+	width, height := measureLayoutChild(childEntry)
+*/
+func measureLayoutChild(childEntry *layoutChildEntryType) (int, int) {
+	if childEntry.layout != nil {
+		width, height := measureLayout(childEntry.layout)
+		return max(width, 1), max(height, 1)
+	}
+	return childEntry.measuredWidth, childEntry.measuredHeight
+}
+
+/*
+getLayoutSpacedLength is a method which allows you to add the spacing of a stack layout to the measured size of a
+child, along the direction of the stack.
+
+Example:
+
+	width, height = getLayoutSpacedLength(layoutEntry, width, height)
+*/
+func getLayoutSpacedLength(layoutEntry *layoutEntryType, width int, height int) (int, int) {
+	if layoutEntry.isHorizontal {
+		return width + layoutEntry.spacing, height
+	}
+	return width, height + layoutEntry.spacing
+}
+
+/*
+arrangeLayout is a method which allows you to position and size every child of a layout within the area provided.
+
+Example:
+
+	arrangeLayout(layoutEntry, 0, 0, 80, 25)
+*/
+func arrangeLayout(layoutEntry *layoutEntryType, xLocation int, yLocation int, width int, height int) {
+	switch layoutEntry.layoutType {
+	case layoutTypeStack:
+		arrangeStackLayout(layoutEntry, xLocation, yLocation, width, height)
+	case layoutTypeGrid:
+		arrangeGridLayout(layoutEntry, xLocation, yLocation, width)
+	case layoutTypeDock:
+		arrangeDockLayout(layoutEntry, xLocation, yLocation, width, height)
+	}
+}
+
+/*
+arrangeStackLayout is a method which allows you to place the children of a stack layout one after another. In
+addition, the following should be noted:
+
+- Children with a fixed or automatic length are given that length first.
+
+  - Any space remaining is divided evenly between children which fill, with the last one receiving any cells left
+    over from rounding.
+
+Example:
+
+	arrangeStackLayout(layoutEntry, 0, 0, 80, 25)
+*/
+func arrangeStackLayout(layoutEntry *layoutEntryType, xLocation int, yLocation int, width int, height int) {
+	availableLength := height
+	if layoutEntry.isHorizontal {
+		availableLength = width
+	}
+	childLengths := make([]int, len(layoutEntry.children))
+	numberOfFillingChildren := 0
+	for currentIndex, currentChild := range layoutEntry.children {
+		if currentIndex > 0 {
+			availableLength -= layoutEntry.spacing
+		}
+		switch {
+		case currentChild.length == constants.LayoutLengthFill:
+			numberOfFillingChildren++
+			continue
+		case currentChild.length > 0:
+			childLengths[currentIndex] = currentChild.length
+		default:
+			childWidth, childHeight := measureLayoutChild(currentChild)
+			childLengths[currentIndex] = childHeight
+			if layoutEntry.isHorizontal {
+				childLengths[currentIndex] = childWidth
+			}
+		}
+		availableLength -= childLengths[currentIndex]
+	}
+	if availableLength < 0 {
+		availableLength = 0
+	}
+	fillingChildrenPlaced := 0
+	for currentIndex, currentChild := range layoutEntry.children {
+		if currentChild.length != constants.LayoutLengthFill {
+			continue
+		}
+		fillingChildrenPlaced++
+		childLengths[currentIndex] = availableLength / numberOfFillingChildren
+		if fillingChildrenPlaced == numberOfFillingChildren {
+			childLengths[currentIndex] = availableLength - (availableLength/numberOfFillingChildren)*(numberOfFillingChildren-1)
+		}
+	}
+	for currentIndex, currentChild := range layoutEntry.children {
+		if layoutEntry.isHorizontal {
+			arrangeLayoutChild(currentChild, xLocation, yLocation, childLengths[currentIndex], height)
+			xLocation += childLengths[currentIndex] + layoutEntry.spacing
+		} else {
+			arrangeLayoutChild(currentChild, xLocation, yLocation, width, childLengths[currentIndex])
+			yLocation += childLengths[currentIndex] + layoutEntry.spacing
+		}
+	}
+}
+
+/*
+gridLayoutCellType is a structure which represents the row and column a child of a grid layout is placed in.
+*/
+type gridLayoutCellType struct {
+	child  *layoutChildEntryType
+	row    int
+	column int
+}
+
+/*
+getGridLayoutCells is a method which allows you to obtain the row and column each child of a grid layout is placed in.
+
+Example:
+
+	gridCells := getGridLayoutCells(layoutEntry)
+*/
+func getGridLayoutCells(layoutEntry *layoutEntryType) []gridLayoutCellType {
+	var gridCells []gridLayoutCellType
+	numberOfColumns := len(layoutEntry.columnWeights)
+	currentRow := 0
+	currentColumn := 0
+	for _, currentChild := range layoutEntry.children {
+		if currentColumn+currentChild.columnSpan > numberOfColumns {
+			currentRow++
+			currentColumn = 0
+		}
+		gridCells = append(gridCells, gridLayoutCellType{child: currentChild, row: currentRow, column: currentColumn})
+		currentColumn += currentChild.columnSpan
+	}
+	return gridCells
+}
+
+/*
+getGridLayoutRowHeights is a method which allows you to obtain the height of each row of a grid layout, which is the
+height of the tallest child in that row.
+
+Example:
+
+	rowHeights := getGridLayoutRowHeights(layoutEntry)
+*/
+func getGridLayoutRowHeights(layoutEntry *layoutEntryType) []int {
+	var rowHeights []int
+	for _, currentCell := range getGridLayoutCells(layoutEntry) {
+		if currentCell.row >= len(rowHeights) {
+			rowHeights = append(rowHeights, 0)
+		}
+		_, childHeight := measureLayoutChild(currentCell.child)
+		rowHeights[currentCell.row] = max(rowHeights[currentCell.row], childHeight)
+	}
+	return rowHeights
+}
+
+/*
+arrangeGridLayout is a method which allows you to place the children of a grid layout in rows of proportionally sized
+columns. In addition, the following should be noted:
+
+- Children keep their preferred height, while their width is stretched to fill the columns they span.
+
+Example:
+
+	arrangeGridLayout(layoutEntry, 0, 0, 80)
+*/
+func arrangeGridLayout(layoutEntry *layoutEntryType, xLocation int, yLocation int, width int) {
+	numberOfColumns := len(layoutEntry.columnWeights)
+	availableWidth := width - (layoutEntry.columnSpacing * (numberOfColumns - 1))
+	if availableWidth < 0 {
+		availableWidth = 0
+	}
+	totalWeight := 0.0
+	for _, currentWeight := range layoutEntry.columnWeights {
+		totalWeight += currentWeight
+	}
+	columnWidths := make([]int, numberOfColumns)
+	columnLocations := make([]int, numberOfColumns)
+	remainingWidth := availableWidth
+	columnLocation := xLocation
+	for currentColumn, currentWeight := range layoutEntry.columnWeights {
+		columnWidths[currentColumn] = int(float64(availableWidth) * currentWeight / totalWeight)
+		if currentColumn == numberOfColumns-1 {
+			columnWidths[currentColumn] = remainingWidth
+		}
+		remainingWidth -= columnWidths[currentColumn]
+		columnLocations[currentColumn] = columnLocation
+		columnLocation += columnWidths[currentColumn] + layoutEntry.columnSpacing
+	}
+	rowHeights := getGridLayoutRowHeights(layoutEntry)
+	rowLocations := make([]int, len(rowHeights))
+	rowLocation := yLocation
+	for currentRow, currentRowHeight := range rowHeights {
+		rowLocations[currentRow] = rowLocation
+		rowLocation += currentRowHeight + layoutEntry.rowSpacing
+	}
+	for _, currentCell := range getGridLayoutCells(layoutEntry) {
+		lastColumn := currentCell.column + currentCell.child.columnSpan - 1
+		cellWidth := columnLocations[lastColumn] + columnWidths[lastColumn] - columnLocations[currentCell.column]
+		_, childHeight := measureLayoutChild(currentCell.child)
+		arrangeLayoutChild(currentCell.child, columnLocations[currentCell.column], rowLocations[currentCell.row], cellWidth, childHeight)
+	}
+}
+
+/*
+arrangeDockLayout is a method which allows you to attach the children of a dock layout to the edges of the area
+provided, in the order they were added.
+
+Example:
+
+	arrangeDockLayout(layoutEntry, 0, 0, 80, 25)
+*/
+func arrangeDockLayout(layoutEntry *layoutEntryType, xLocation int, yLocation int, width int, height int) {
+	for _, currentChild := range layoutEntry.children {
+		childWidth, childHeight := measureLayoutChild(currentChild)
+		if currentChild.length > 0 {
+			childWidth = currentChild.length
+			childHeight = currentChild.length
+		}
+		if childWidth > width {
+			childWidth = width
+		}
+		if childHeight > height {
+			childHeight = height
+		}
+		switch currentChild.dockStyle {
+		case constants.DockTop:
+			arrangeLayoutChild(currentChild, xLocation, yLocation, width, childHeight)
+			yLocation += childHeight
+			height -= childHeight
+		case constants.DockBottom:
+			arrangeLayoutChild(currentChild, xLocation, yLocation+height-childHeight, width, childHeight)
+			height -= childHeight
+		case constants.DockLeft:
+			arrangeLayoutChild(currentChild, xLocation, yLocation, childWidth, height)
+			xLocation += childWidth
+			width -= childWidth
+		case constants.DockRight:
+			arrangeLayoutChild(currentChild, xLocation+width-childWidth, yLocation, childWidth, height)
+			width -= childWidth
+		default:
+			arrangeLayoutChild(currentChild, xLocation, yLocation, width, height)
+		}
+	}
+}
+
+/*
+arrangeLayoutChild is a method which allows you to position and size a single layout child. In addition, the
+following should be noted:
+
+- Nested layouts arrange their own children within the area provided.
+
+- Controls are never given a width or height smaller than one.
+
+- Controls embedded in a control, such as the scroll bars of a textbox, are moved and resized along with it.
+
+- Controls which have since been deleted are skipped.
+
+Example:
+
+	arrangeLayoutChild(childEntry, 0, 0, 10, 1)
+*/
+func arrangeLayoutChild(childEntry *layoutChildEntryType, xLocation int, yLocation int, width int, height int) {
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	if childEntry.layout != nil {
+		arrangeLayout(childEntry.layout, xLocation, yLocation, width, height)
+		return
+	}
+	childEntry.control.SetPosition(xLocation, yLocation)
+	childEntry.control.SetSize(width, height)
+}
+
+/*
+validateLayoutSpacing is a method which allows you to check that a spacing or margin is not negative.
+
+Example:
+
+	validateLayoutSpacing(1)
+*/
+func validateLayoutSpacing(spacing int) {
+	if spacing < 0 {
+		safeSttyPanic(fmt.Sprintf("The layout spacing '%d' is invalid since it is negative!", spacing))
+	}
+}
+
+/*
+validateLayoutLength is a method which allows you to check that a layout length is positive, automatic, or filling.
+
+Example:
+
+	validateLayoutLength(constants.LayoutLengthFill)
+*/
+func validateLayoutLength(length int) {
+	if length < constants.LayoutLengthFill {
+		safeSttyPanic(fmt.Sprintf("The layout length '%d' is invalid!", length))
+	}
+}
+
+/*
+validateLayoutColumnWeights is a method which allows you to check that a grid layout has at least one column and that
+every column weight is positive.
+
+Example:
+
+	validateLayoutColumnWeights([]float64{1, 2})
+*/
+func validateLayoutColumnWeights(columnWeights []float64) {
+	if len(columnWeights) == 0 {
+		safeSttyPanic("The grid layout could not be created since no columns were specified!")
+	}
+	for _, currentWeight := range columnWeights {
+		if currentWeight <= 0 {
+			safeSttyPanic(fmt.Sprintf("The grid layout could not be created since the column weight '%f' is not positive!", currentWeight))
+		}
+	}
+}
+
+/*
+validateLayoutColumnSpan is a method which allows you to check that a column span fits within a grid layout.
+
+Example:
+
+	validateLayoutColumnSpan(layoutEntry, 2)
+*/
+func validateLayoutColumnSpan(layoutEntry *layoutEntryType, columnSpan int) {
+	if columnSpan < 1 || columnSpan > len(layoutEntry.columnWeights) {
+		safeSttyPanic(fmt.Sprintf("The column span '%d' is invalid for a grid layout with '%d' columns!", columnSpan, len(layoutEntry.columnWeights)))
+	}
+}
+
+/*
+validateLayoutDockStyle is a method which allows you to check that a dock style is one of the dock constants.
+
+Example:
+
+	validateLayoutDockStyle(constants.DockTop)
+*/
+func validateLayoutDockStyle(dockStyle int) {
+	if dockStyle < constants.DockFill || dockStyle > constants.DockRight {
+		safeSttyPanic(fmt.Sprintf("The dock style '%d' is invalid!", dockStyle))
+	}
+}
+
+/*
+validateLayoutIsUnattached is a method which allows you to check that a layout is not already nested inside another
+layout or attached to a layer.
+
+Example:
+
+	validateLayoutIsUnattached(layoutEntry)
+*/
+func validateLayoutIsUnattached(layoutEntry *layoutEntryType) {
+	if layoutEntry.parent != nil || layoutEntry.layerAlias != "" {
+		safeSttyPanic("The layout could not be added since it is already in use!")
+	}
+}
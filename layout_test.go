@@ -0,0 +1,134 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+TestLayoutStack is a test which verifies that a vertical stack layout places controls one after another and
+recomputes their positions when the layer is resized.
+
+Example:
+
+	Expected Inputs:
+	    A vertical stack with an automatic, a fixed, and a filling child attached to a layer with a margin.
+
+	Expected Outputs:
+	    Each control is stretched across the layer, the filling control takes the remaining height, the scroll bars
+	    of the textbox follow it, and all controls are repositioned after the layer is resized.
+*/
+func TestLayoutStack(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	button := layer1.AddButton("OK", styleEntry, 0, 0, 8, 3, true)
+	label := layer1.AddLabel("Name", styleEntry, 0, 0, 10)
+	textbox := layer1.AddTextbox(styleEntry, 0, 0, 5, 5, true)
+	stackLayout := NewStackLayout(false, 1)
+	stackLayout.AddControl(&button, constants.LayoutLengthAuto).AddControl(&label, 1).AddControl(&textbox, constants.LayoutLengthFill)
+	layer1.SetLayout(stackLayout, 1)
+	assert.Equalf(test, []int{1, 1, 38, 3}, getLayoutTestBounds(&button.BaseControlInstanceType), "The automatically sized control was not placed correctly!")
+	assert.Equalf(test, []int{1, 5, 38, 1}, getLayoutTestBounds(&label.BaseControlInstanceType), "The fixed length control was not placed correctly!")
+	assert.Equalf(test, []int{1, 7, 38, 12}, getLayoutTestBounds(&textbox.BaseControlInstanceType), "The filling control was not placed correctly!")
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textbox.controlAlias)
+	assert.Equalf(test, []int{0, 20, 40}, getLayoutTestScrollbarBounds(layer1.layerAlias, textboxEntry.HorizontalScrollbarAlias), "The horizontal scroll bar did not follow the textbox!")
+	assert.Equalf(test, []int{40, 6, 14}, getLayoutTestScrollbarBounds(layer1.layerAlias, textboxEntry.VerticalScrollbarAlias), "The vertical scroll bar did not follow the textbox!")
+	layer1.Resize(30, 10)
+	assert.Equalf(test, []int{1, 1, 28, 3}, getLayoutTestBounds(&button.BaseControlInstanceType), "The automatically sized control was not updated after a resize!")
+	assert.Equalf(test, []int{1, 7, 28, 2}, getLayoutTestBounds(&textbox.BaseControlInstanceType), "The filling control was not updated after a resize!")
+	assert.Equalf(test, []int{0, 10, 30}, getLayoutTestScrollbarBounds(layer1.layerAlias, textboxEntry.HorizontalScrollbarAlias), "The horizontal scroll bar was not updated after a resize!")
+	assert.Equalf(test, []int{30, 6, 4}, getLayoutTestScrollbarBounds(layer1.layerAlias, textboxEntry.VerticalScrollbarAlias), "The vertical scroll bar was not updated after a resize!")
+	label.Delete()
+	layer1.Resize(20, 10)
+	assert.Equalf(test, []int{1, 1, 18, 3}, getLayoutTestBounds(&button.BaseControlInstanceType), "Controls were not updated when another control in the layout was deleted!")
+}
+
+/*
+TestLayoutGrid is a test which verifies that a grid layout divides the available width between columns according
+to their weights.
+
+Example:
+
+	Expected Inputs:
+	    A grid with two weighted columns, holding controls of different heights and one which spans both columns.
+
+	Expected Outputs:
+	    Controls are stretched to the width of their columns, keep their height, and wrap onto a new row when the
+	    current row is full.
+*/
+func TestLayoutGrid(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	firstButton := layer1.AddButton("A", styleEntry, 0, 0, 5, 3, true)
+	secondButton := layer1.AddButton("B", styleEntry, 0, 0, 5, 1, true)
+	thirdButton := layer1.AddButton("C", styleEntry, 0, 0, 5, 2, true)
+	gridLayout := NewGridLayout([]float64{1, 3}, 1, 0)
+	gridLayout.AddControl(&firstButton, 1).AddControl(&secondButton, 1).AddControl(&thirdButton, 2)
+	layer1.SetLayout(gridLayout, 0)
+	assert.Equalf(test, []int{0, 0, 9, 3}, getLayoutTestBounds(&firstButton.BaseControlInstanceType), "The first column was not sized by its weight!")
+	assert.Equalf(test, []int{10, 0, 30, 1}, getLayoutTestBounds(&secondButton.BaseControlInstanceType), "The second column was not sized by its weight!")
+	assert.Equalf(test, []int{0, 3, 40, 2}, getLayoutTestBounds(&thirdButton.BaseControlInstanceType), "The spanning control was not placed on the next row!")
+}
+
+/*
+TestLayoutDockWithNestedLayout is a test which verifies that a dock layout attaches controls to the edges of a layer
+and gives the remaining space to a nested layout.
+
+Example:
+
+	Expected Inputs:
+	    A dock layout with top, bottom, and left controls, and a horizontal stack layout filling the center.
+
+	Expected Outputs:
+	    Each docked control occupies its edge, and the nested stack divides the remaining area evenly, both before
+	    and after the layer is resized.
+*/
+func TestLayoutDockWithNestedLayout(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	topLabel := layer1.AddLabel("Title", styleEntry, 0, 0, 10)
+	bottomButton := layer1.AddButton("OK", styleEntry, 0, 0, 8, 3, true)
+	leftButton := layer1.AddButton("Menu", styleEntry, 0, 0, 10, 3, true)
+	firstButton := layer1.AddButton("1", styleEntry, 0, 0, 3, 3, true)
+	secondButton := layer1.AddButton("2", styleEntry, 0, 0, 3, 3, true)
+	stackLayout := NewStackLayout(true, 0)
+	stackLayout.AddControl(&firstButton, constants.LayoutLengthFill).AddControl(&secondButton, constants.LayoutLengthFill)
+	dockLayout := NewDockLayout()
+	dockLayout.AddControl(&topLabel, constants.DockTop, 1).AddControl(&bottomButton, constants.DockBottom, constants.LayoutLengthAuto)
+	dockLayout.AddControl(&leftButton, constants.DockLeft, constants.LayoutLengthAuto).AddLayout(stackLayout, constants.DockFill, 0)
+	layer1.SetLayout(dockLayout, 0)
+	assert.Equalf(test, []int{0, 0, 40, 1}, getLayoutTestBounds(&topLabel.BaseControlInstanceType), "The top control was not docked correctly!")
+	assert.Equalf(test, []int{0, 17, 40, 3}, getLayoutTestBounds(&bottomButton.BaseControlInstanceType), "The bottom control was not docked correctly!")
+	assert.Equalf(test, []int{0, 1, 10, 16}, getLayoutTestBounds(&leftButton.BaseControlInstanceType), "The left control was not docked correctly!")
+	assert.Equalf(test, []int{10, 1, 15, 16}, getLayoutTestBounds(&firstButton.BaseControlInstanceType), "The nested layout was not given the remaining space!")
+	assert.Equalf(test, []int{25, 1, 15, 16}, getLayoutTestBounds(&secondButton.BaseControlInstanceType), "The nested layout was not given the remaining space!")
+	layer1.Resize(31, 10)
+	assert.Equalf(test, []int{0, 7, 31, 3}, getLayoutTestBounds(&bottomButton.BaseControlInstanceType), "The bottom control was not updated after a resize!")
+	assert.Equalf(test, []int{10, 1, 10, 6}, getLayoutTestBounds(&firstButton.BaseControlInstanceType), "The nested layout was not updated after a resize!")
+	assert.Equalf(test, []int{20, 1, 11, 6}, getLayoutTestBounds(&secondButton.BaseControlInstanceType), "The last filling control did not receive the space left over!")
+	assert.Panicsf(test, func() { NewStackLayout(false, 0).AddLayout(stackLayout, 1) }, "Adding a layout which is already in use should panic!")
+}
+
+/*
+getLayoutTestBounds is a method which allows you to obtain the bounds of a control as a slice, so that it can be
+compared easily in layout tests.
+
+Example:
+
+	bounds := getLayoutTestBounds(&button.BaseControlInstanceType)
+*/
+func getLayoutTestBounds(control *BaseControlInstanceType) []int {
+	xLocation, yLocation, width, height := control.GetBounds()
+	return []int{xLocation, yLocation, width, height}
+}
+
+/*
+getLayoutTestScrollbarBounds is a method which allows you to obtain the location and length of a scroll bar as a
+slice, so that it can be compared easily in layout tests.
+
+Example:
+
+	bounds := getLayoutTestScrollbarBounds("layer1", textboxEntry.VerticalScrollbarAlias)
+*/
+func getLayoutTestScrollbarBounds(layerAlias string, scrollbarAlias string) []int {
+	scrollbarEntry := ScrollBars.Get(layerAlias, scrollbarAlias)
+	return []int{scrollbarEntry.XLocation, scrollbarEntry.YLocation, scrollbarEntry.Length}
+}
@@ -0,0 +1,776 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+	"strings"
+	"time"
+)
+
+/*
+modalEntryType is a structure which represents a layer that is currently shown as a modal, along with the backdrop
+layer used to dim everything behind it.
+*/
+type modalEntryType struct {
+	layerAlias               string
+	backdropLayerAlias       string
+	dimAlphaValue            float32
+	previouslyFocusedControl controlIdentifierType
+}
+
+/*
+modalMemoryType is a structure which holds every modal layer currently shown, with the most recently shown modal
+last.
+*/
+type modalMemoryType struct {
+	modalEntries  []modalEntryType
+	dialogEntries []modalDialogEntryType
+}
+
+/*
+modalMemory is a variable which holds every modal layer currently shown.
+*/
+var modalMemory modalMemoryType
+
+/*
+modalDialogButtonType is a structure which represents a button shown by a modal dialog and the result it returns.
+*/
+type modalDialogButtonType struct {
+	label  string
+	result int
+}
+
+/*
+modalDialogEntryType is a structure which represents a ready-made dialog currently shown, along with the handler
+called once it is closed.
+*/
+type modalDialogEntryType struct {
+	layerAlias     string
+	textFieldAlias string
+	defaultValue   string
+	buttons        []modalDialogButtonType
+	// Track the result of each button shown, by its control alias, so that enter can press the one with focus
+	buttonResults map[string]int
+	handler       func(result int, value string)
+}
+
+/*
+modalDialogResultType is a structure which holds how a ready-made dialog was closed, so that it can be passed back to
+a caller waiting for it.
+*/
+type modalDialogResultType struct {
+	result int
+	value  string
+}
+
+/*
+DialogResultHandlerType is a type which represents a callback that is called when a message box or confirmation
+dialog is closed. The result is one of the 'constants.DialogResult' constants.
+*/
+type DialogResultHandlerType func(result int)
+
+/*
+InputPromptHandlerType is a type which represents a callback that is called when an input prompt is closed, with the
+value answered and whether it was accepted.
+*/
+type InputPromptHandlerType func(value string, isAccepted bool)
+
+/*
+ShowModal is a method which allows you to turn a layer into a modal layer, so that it captures all user input until
+it is closed. In addition, the following should be noted:
+
+  - Every layer behind the modal layer is dimmed by the alpha value provided, which can range from 0.0 (no dimming)
+    to 1.0 (totally black).
+
+  - Mouse clicks outside the modal layer are ignored, and only controls on the modal layer or its children can
+    receive keyboard input or be reached by pressing tab.
+
+  - The modal layer is brought in front of all its siblings. If the layer has a parent, only the parent layer is
+    dimmed and blocked.
+
+  - Modal layers can be stacked. Showing a new modal layer while another is shown makes the new one capture input
+    until it is closed.
+
+- If the layer is already a modal layer, this method does nothing.
+
+- If the alpha value is outside the range of 0.0 to 1.0, a panic will be generated to fail as fast as possible.
+
+Example:
+
+	layerInstance.ShowModal(0.5)
+*/
+func (shared *LayerInstanceType) ShowModal(dimAlphaValue float32) {
+	validateLayer(shared.layerAlias)
+	validateModalDimAlphaValue(dimAlphaValue)
+	if shared.IsModal() {
+		return
+	}
+	layerEntry := Layers.Get(shared.layerAlias)
+	modalEntry := modalEntryType{layerAlias: shared.layerAlias, backdropLayerAlias: getUUID(), dimAlphaValue: dimAlphaValue}
+	modalEntry.previouslyFocusedControl = eventStateMemory.currentlyFocusedControl
+	containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+	layer.Add(modalEntry.backdropLayerAlias, 0, 0, containerWidth, containerHeight, -1, layerEntry.ParentAlias)
+	drawModalBackdrop(modalEntry)
+	layer.SetTopmostLayer(shared.layerAlias)
+	modalMemory.modalEntries = append(modalMemory.modalEntries, modalEntry)
+	if !isLayerWithinActiveModal(eventStateMemory.currentlyFocusedControl.layerAlias) {
+		setFocusedControl("", "", constants.NullControlType)
+	}
+}
+
+/*
+CloseModal is a method which allows you to return a modal layer to a normal layer, so that the layers behind it can
+receive input again. In addition, the following should be noted:
+
+- The backdrop dimming the layers behind is removed, but the layer itself remains visible.
+
+- The control which had focus before the modal layer was shown regains focus.
+
+- If the layer is not a modal layer, this method does nothing.
+
+Example:
+
+	layerInstance.CloseModal()
+*/
+func (shared *LayerInstanceType) CloseModal() {
+	removeLayerModal(shared.layerAlias)
+}
+
+/*
+IsModal is a method which allows you to detect if a layer is currently shown as a modal layer.
+
+Example:
+
+	isModal := layerInstance.IsModal()
+*/
+func (shared *LayerInstanceType) IsModal() bool {
+	for _, currentModalEntry := range modalMemory.modalEntries {
+		if currentModalEntry.layerAlias == shared.layerAlias {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+MessageBox is a method which allows you to show a modal message to the user and wait until it is dismissed. In
+addition, the following should be noted:
+
+- The message can span multiple lines by separating them with '\n'.
+
+- The message box is dismissed by pressing its button, or by pressing enter or escape.
+
+  - This method blocks until the message box is dismissed, so it must be called from your main loop and never from an
+    event handler. Use ShowMessageBox from event handlers instead.
+
+- If no terminal screen is available, such as when running in debug mode, this method returns immediately.
+
+Example:
+
+	MessageBox("Saved", "Your file was saved successfully.", styleEntry)
+*/
+func MessageBox(title string, message string, styleEntry types.TuiStyleEntryType) {
+	showBlockingModalDialog(title, message, styleEntry, getMessageBoxButtons(), false, "")
+}
+
+/*
+ShowMessageBox is a method which allows you to show a modal message to the user without waiting for it to be
+dismissed. In addition, the following should be noted:
+
+- The message box is dismissed the same way as with MessageBox.
+
+  - The handler is called with 'constants.DialogResultOk' once the message box is dismissed, and can be nil. Since
+    this method returns straight away, it can be called from an event handler.
+
+Example:
+
+	ShowMessageBox("Saved", "Your file was saved successfully.", styleEntry, nil)
+*/
+func ShowMessageBox(title string, message string, styleEntry types.TuiStyleEntryType, handler DialogResultHandlerType) {
+	openModalDialog(title, message, styleEntry, getMessageBoxButtons(), false, "", func(result int, value string) {
+		if handler != nil {
+			handler(result)
+		}
+	})
+}
+
+/*
+Confirm is a method which allows you to ask the user a question and wait for them to answer yes, no, or cancel. In
+addition, the following should be noted:
+
+  - The result returned is 'constants.DialogResultYes', 'constants.DialogResultNo', or
+    'constants.DialogResultCancel'.
+
+  - Pressing enter answers yes, pressing escape cancels, and pressing 'y', 'n', or 'c' selects the matching
+    button.
+
+  - This method blocks until the user answers, so it must be called from your main loop and never from an event
+    handler. Use ShowConfirm from event handlers instead.
+
+- If no terminal screen is available, such as when running in debug mode, cancel is returned immediately.
+
+Example:
+
+	if Confirm("Quit", "Save changes before quitting?", styleEntry) == constants.DialogResultYes {
+		saveChanges()
+	}
+*/
+func Confirm(title string, message string, styleEntry types.TuiStyleEntryType) int {
+	result, _ := showBlockingModalDialog(title, message, styleEntry, getConfirmButtons(), false, "")
+	return result
+}
+
+/*
+ShowConfirm is a method which allows you to ask the user a question without waiting for them to answer. In addition,
+the following should be noted:
+
+- The question is answered the same way as with Confirm.
+
+  - The handler is called with the answer once the user answers, and can be nil. Since this method returns straight
+    away, it can be called from an event handler.
+
+Example:
+
+	ShowConfirm("Quit", "Save changes before quitting?", styleEntry, func(result int) {
+		if result == constants.DialogResultYes {
+			saveChanges()
+		}
+	})
+*/
+func ShowConfirm(title string, message string, styleEntry types.TuiStyleEntryType, handler DialogResultHandlerType) {
+	openModalDialog(title, message, styleEntry, getConfirmButtons(), false, "", func(result int, value string) {
+		if handler != nil {
+			handler(result)
+		}
+	})
+}
+
+/*
+InputPrompt is a method which allows you to ask the user to type in a value and wait for them to accept or cancel
+it. In addition, the following should be noted:
+
+  - The value typed is returned along with true if the user pressed OK or enter. If the user pressed cancel or
+    escape, the default value is returned along with false.
+
+  - This method blocks until the user answers, so it must be called from your main loop and never from an event
+    handler. Use ShowInputPrompt from event handlers instead.
+
+  - If no terminal screen is available, such as when running in debug mode, the default value and false are returned
+    immediately.
+
+Example:
+
+	fileName, isAccepted := InputPrompt("Save As", "File name:", "untitled.txt", styleEntry)
+*/
+func InputPrompt(title string, message string, defaultValue string, styleEntry types.TuiStyleEntryType) (string, bool) {
+	result, value := showBlockingModalDialog(title, message, styleEntry, getInputPromptButtons(), true, defaultValue)
+	return getInputPromptValue(result, value, defaultValue)
+}
+
+/*
+ShowInputPrompt is a method which allows you to ask the user to type in a value without waiting for them to accept
+or cancel it. In addition, the following should be noted:
+
+- The value is accepted or cancelled the same way as with InputPrompt.
+
+  - The handler is called with the value typed and true if it was accepted, or with the default value and false if
+    it was cancelled. Since this method returns straight away, it can be called from an event handler.
+
+Example:
+
+	ShowInputPrompt("Save As", "File name:", "untitled.txt", styleEntry, func(value string, isAccepted bool) {
+		if isAccepted {
+			saveFile(value)
+		}
+	})
+*/
+func ShowInputPrompt(title string, message string, defaultValue string, styleEntry types.TuiStyleEntryType, handler InputPromptHandlerType) {
+	openModalDialog(title, message, styleEntry, getInputPromptButtons(), true, defaultValue, func(result int, value string) {
+		if handler != nil {
+			handler(getInputPromptValue(result, value, defaultValue))
+		}
+	})
+}
+
+/*
+getMessageBoxButtons is a method which allows you to obtain the buttons shown by a message box.
+
+Example:
+
+	buttons := getMessageBoxButtons()
+*/
+func getMessageBoxButtons() []modalDialogButtonType {
+	return []modalDialogButtonType{{label: "OK", result: constants.DialogResultOk}}
+}
+
+/*
+getConfirmButtons is a method which allows you to obtain the buttons shown by a confirmation dialog.
+
+Example:
+
+	buttons := getConfirmButtons()
+*/
+func getConfirmButtons() []modalDialogButtonType {
+	return []modalDialogButtonType{
+		{label: "Yes", result: constants.DialogResultYes},
+		{label: "No", result: constants.DialogResultNo},
+		{label: "Cancel", result: constants.DialogResultCancel},
+	}
+}
+
+/*
+getInputPromptButtons is a method which allows you to obtain the buttons shown by an input prompt.
+
+Example:
+
+	buttons := getInputPromptButtons()
+*/
+func getInputPromptButtons() []modalDialogButtonType {
+	return []modalDialogButtonType{
+		{label: "OK", result: constants.DialogResultOk},
+		{label: "Cancel", result: constants.DialogResultCancel},
+	}
+}
+
+/*
+getInputPromptValue is a method which allows you to obtain the value an input prompt answers with, which is the
+default value unless the value typed was accepted.
+
+Example:
+
+	value, isAccepted := getInputPromptValue(result, value, "untitled.txt")
+*/
+func getInputPromptValue(result int, value string, defaultValue string) (string, bool) {
+	if result != constants.DialogResultOk {
+		return defaultValue, false
+	}
+	return value, true
+}
+
+/*
+showBlockingModalDialog is a method which allows you to show a modal dialog and wait for the user to respond. In
+addition, the following should be noted:
+
+  - If a background event updater is running, events keep being dispatched by it while this method waits. Otherwise,
+    such as with a simulation screen, events are dispatched by this method itself.
+
+  - If this method is called while an event is being dispatched, such as from an event handler, then a panic will be
+    generated to fail as fast as possible. This is checked whether or not a background event updater is running,
+    since the dialog could never receive the events needed to close it while the goroutine dispatching events is
+    blocked waiting for it.
+
+- If no terminal screen is available, the last button is pressed straight away.
+
+Example:
+
+	result, value := showBlockingModalDialog("Title", "Message", styleEntry, buttons, false, "")
+*/
+func showBlockingModalDialog(title string, message string, styleEntry types.TuiStyleEntryType, buttons []modalDialogButtonType, isInputFieldShown bool, defaultValue string) (int, string) {
+	validateModalDialogNotInEventHandler()
+	for KeyboardMemory.GetFromBuffer() != nil {
+	}
+	resultChannel := make(chan modalDialogResultType, 1)
+	dialogLayerAlias := openModalDialog(title, message, styleEntry, buttons, isInputFieldShown, defaultValue, func(result int, value string) {
+		resultChannel <- modalDialogResultType{result: result, value: value}
+	})
+	if commonResource.screen == nil {
+		closeModalDialog(dialogLayerAlias, buttons[len(buttons)-1].result)
+	}
+	for {
+		select {
+		case dialogResult := <-resultChannel:
+			return dialogResult.result, dialogResult.value
+		default:
+		}
+		if commonResource.isEventUpdaterRunning {
+			time.Sleep(10 * time.Millisecond)
+		} else {
+			UpdateEventQueues()
+		}
+	}
+}
+
+/*
+openModalDialog is a method which allows you to build a modal dialog window and show it without waiting for the user
+to respond. In addition, the following should be noted:
+
+- The dialog is centered on the terminal and sized to fit its title, message, and buttons.
+
+  - The input field and buttons are added to the tab index, and the dialog layer is made a focus scope so that tab
+    only moves between them. Focus is given to the input field if there is one, or to the first button otherwise.
+
+  - Enter presses the button with focus, or the first button if no button has focus. The last button is pressed by
+    escape.
+
+  - Once a button is pressed, the dialog is removed and the handler is called with its result and the value of the
+    input field. If the dialog layer is deleted by other means, the last button is treated as pressed.
+
+- The alias of the dialog layer is returned.
+
+Example:
+
+	dialogLayerAlias := openModalDialog("Title", "Message", styleEntry, buttons, false, "", handler)
+*/
+func openModalDialog(title string, message string, styleEntry types.TuiStyleEntryType, buttons []modalDialogButtonType, isInputFieldShown bool, defaultValue string, handler func(result int, value string)) string {
+	messageLines := strings.Split(message, "\n")
+	dialogWidth := stringformat.GetWidthOfRunesWhenPrinted([]rune(title)) + 4
+	for _, currentLine := range messageLines {
+		if lineWidth := stringformat.GetWidthOfRunesWhenPrinted([]rune(currentLine)); lineWidth > dialogWidth {
+			dialogWidth = lineWidth
+		}
+	}
+	buttonsWidth := 0
+	for currentIndex, currentButton := range buttons {
+		if currentIndex > 0 {
+			buttonsWidth++
+		}
+		buttonsWidth += stringformat.GetWidthOfRunesWhenPrinted([]rune(currentButton.label)) + 4
+	}
+	if buttonsWidth > dialogWidth {
+		dialogWidth = buttonsWidth
+	}
+	if isInputFieldShown && dialogWidth < 30 {
+		dialogWidth = 30
+	}
+	dialogWidth += 4
+	textFieldYLocation := len(messageLines) + 3
+	buttonsYLocation := textFieldYLocation
+	if isInputFieldShown {
+		buttonsYLocation += 2
+	}
+	dialogHeight := buttonsYLocation + 4
+	if dialogWidth > commonResource.terminalWidth {
+		dialogWidth = commonResource.terminalWidth
+	}
+	if dialogHeight > commonResource.terminalHeight {
+		dialogHeight = commonResource.terminalHeight
+	}
+	dialogXLocation := (commonResource.terminalWidth - dialogWidth) / 2
+	dialogYLocation := (commonResource.terminalHeight - dialogHeight) / 2
+	dialogLayer := AddLayer(dialogXLocation, dialogYLocation, dialogWidth, dialogHeight, -1, nil)
+	dialogLayer.DrawWindow(styleEntry, 0, 0, dialogWidth, dialogHeight, true)
+	if title != "" {
+		dialogLayer.DrawFrameLabel(styleEntry, title, 2, 0)
+	}
+	for currentIndex, currentLine := range messageLines {
+		if 2+currentIndex < dialogHeight-1 {
+			dialogLayer.AddLabel(currentLine, styleEntry, 2, 2+currentIndex, dialogWidth-4)
+		}
+	}
+	dialogLayer.SetFocusScope(true)
+	dialogEntry := modalDialogEntryType{layerAlias: dialogLayer.layerAlias, buttons: buttons, buttonResults: make(map[string]int), defaultValue: defaultValue, handler: handler}
+	focusedControl := controlIdentifierType{}
+	if isInputFieldShown && textFieldYLocation < dialogHeight {
+		textFieldInstance := dialogLayer.AddTextField(styleEntry, 2, textFieldYLocation, dialogWidth-4, constants.DefaultInputPromptMaxLength, false, defaultValue, true)
+		textFieldInstance.OnSubmit(func(control *BaseControlInstanceType) {
+			closeModalDialog(dialogLayer.layerAlias, buttons[0].result)
+		})
+		textFieldInstance.SetTabOrder(0)
+		dialogEntry.textFieldAlias = textFieldInstance.controlAlias
+		focusedControl = textFieldInstance.getFocusIdentifier()
+	}
+	buttonXLocation := (dialogWidth - buttonsWidth) / 2
+	for _, currentButton := range buttons {
+		buttonResult := currentButton.result
+		buttonWidth := stringformat.GetWidthOfRunesWhenPrinted([]rune(currentButton.label)) + 4
+		if buttonXLocation >= 0 && buttonXLocation < dialogWidth && buttonsYLocation < dialogHeight {
+			buttonInstance := dialogLayer.AddButton(currentButton.label, styleEntry, buttonXLocation, buttonsYLocation, buttonWidth, 3, true)
+			buttonInstance.OnPress(func(control *BaseControlInstanceType) {
+				closeModalDialog(dialogLayer.layerAlias, buttonResult)
+			})
+			buttonInstance.AddToTabIndex()
+			dialogEntry.buttonResults[buttonInstance.controlAlias] = buttonResult
+			if focusedControl.controlAlias == "" {
+				focusedControl = buttonInstance.getFocusIdentifier()
+			}
+		}
+		buttonXLocation += buttonWidth + 1
+	}
+	modalMemory.dialogEntries = append(modalMemory.dialogEntries, dialogEntry)
+	dialogLayer.ShowModal(constants.DefaultModalDimAlphaValue)
+	if focusedControl.controlAlias != "" {
+		setFocusedControl(focusedControl.layerAlias, focusedControl.controlAlias, focusedControl.controlType)
+	}
+	UpdateDisplay(false)
+	return dialogLayer.layerAlias
+}
+
+/*
+closeModalDialog is a method which allows you to remove a modal dialog as if one of its buttons was pressed, and call
+its handler with the result given. If the dialog is no longer shown, then no operation takes place.
+
+Example:
+
+	closeModalDialog(dialogLayerAlias, constants.DialogResultOk)
+*/
+func closeModalDialog(layerAlias string, result int) {
+	dialogIndex := getModalDialogIndex(layerAlias)
+	if dialogIndex == -1 {
+		return
+	}
+	dialogEntry := modalMemory.dialogEntries[dialogIndex]
+	// The entry is removed first, so that deleting the dialog layer does not close the dialog a second time.
+	modalMemory.dialogEntries = append(modalMemory.dialogEntries[:dialogIndex], modalMemory.dialogEntries[dialogIndex+1:]...)
+	value := dialogEntry.defaultValue
+	if dialogEntry.textFieldAlias != "" && TextFields.IsExists(layerAlias, dialogEntry.textFieldAlias) {
+		textFieldInstance := TextFieldInstanceType{BaseControlInstanceType{layerAlias: layerAlias, controlAlias: dialogEntry.textFieldAlias, controlType: constants.TYPE_TEXTFIELD}}
+		value = textFieldInstance.GetValue()
+	}
+	removeLayerTabIndex(layerAlias)
+	if Layers.IsExists(layerAlias) {
+		deleteLayer(layerAlias)
+	}
+	UpdateDisplay(false)
+	dialogEntry.handler(result, value)
+}
+
+/*
+removeLayerModalDialog is a method which allows you to close the modal dialog shown on a layer which is being
+deleted, as if its last button was pressed. If no dialog is shown on the layer, then no operation takes place.
+
+Example:
+
+	removeLayerModalDialog("layer1")
+*/
+func removeLayerModalDialog(layerAlias string) {
+	dialogIndex := getModalDialogIndex(layerAlias)
+	if dialogIndex == -1 {
+		return
+	}
+	buttons := modalMemory.dialogEntries[dialogIndex].buttons
+	closeModalDialog(layerAlias, buttons[len(buttons)-1].result)
+}
+
+/*
+getModalDialogIndex is a method which allows you to obtain where the modal dialog shown on a layer is stored. If no
+dialog is shown on the layer, -1 is returned.
+
+Example:
+
+	dialogIndex := getModalDialogIndex("layer1")
+*/
+func getModalDialogIndex(layerAlias string) int {
+	for currentIndex, dialogEntry := range modalMemory.dialogEntries {
+		if dialogEntry.layerAlias == layerAlias {
+			return currentIndex
+		}
+	}
+	return -1
+}
+
+/*
+updateModalDialogKeyboardEvent is a method which allows you to select the buttons of the modal dialog capturing input
+with the keyboard. In addition, the following should be noted:
+
+- This method is only given keystrokes which no control used.
+
+  - While a dialog is capturing input, every keystroke is consumed, so that none of them reach shortcuts or the
+    keyboard buffer.
+
+- In the event that a screen update is required this method returns true.
+
+Example:
+
+	isUpdated, isConsumed := updateModalDialogKeyboardEvent(keystroke)
+*/
+func updateModalDialogKeyboardEvent(keystroke []rune) (bool, bool) {
+	modalEntry := getActiveModalEntry()
+	if keystroke == nil || modalEntry == nil {
+		return false, false
+	}
+	dialogIndex := getModalDialogIndex(modalEntry.layerAlias)
+	if dialogIndex == -1 {
+		return false, false
+	}
+	result := getModalDialogKeystrokeResult(string(keystroke), modalMemory.dialogEntries[dialogIndex])
+	if result == constants.DialogResultNone {
+		return false, true
+	}
+	closeModalDialog(modalEntry.layerAlias, result)
+	return true, true
+}
+
+/*
+getModalDialogKeystrokeResult is a method which allows you to obtain the dialog result selected by a keystroke. In
+addition, the following should be noted:
+
+  - Enter selects the button with focus, or the first button if no button of the dialog has focus. Escape selects the
+    last button.
+
+- A single character selects the first button whose label starts with that character, ignoring case.
+
+- If the keystroke does not select any button, 'constants.DialogResultNone' is returned.
+
+Example:
+
+	result := getModalDialogKeystrokeResult("y", dialogEntry)
+*/
+func getModalDialogKeystrokeResult(keystroke string, dialogEntry modalDialogEntryType) int {
+	buttons := dialogEntry.buttons
+	switch keystroke {
+	case "enter":
+		focusedControl := eventStateMemory.currentlyFocusedControl
+		if focusedControl.layerAlias == dialogEntry.layerAlias && focusedControl.controlType == constants.CellTypeButton {
+			if result, isButtonFound := dialogEntry.buttonResults[focusedControl.controlAlias]; isButtonFound {
+				return result
+			}
+		}
+		return buttons[0].result
+	case "esc", "escape":
+		return buttons[len(buttons)-1].result
+	}
+	if len([]rune(keystroke)) == 1 {
+		for _, currentButton := range buttons {
+			if strings.HasPrefix(strings.ToLower(currentButton.label), strings.ToLower(keystroke)) {
+				return currentButton.result
+			}
+		}
+	}
+	return constants.DialogResultNone
+}
+
+/*
+getActiveModalEntry is a method which allows you to obtain the modal layer currently capturing input. If no modal
+layer is shown, nil is returned instead.
+
+Example:
+
+	modalEntry := getActiveModalEntry()
+*/
+func getActiveModalEntry() *modalEntryType {
+	if len(modalMemory.modalEntries) == 0 {
+		return nil
+	}
+	return &modalMemory.modalEntries[len(modalMemory.modalEntries)-1]
+}
+
+/*
+isLayerWithinActiveModal is a method which allows you to detect if a layer is allowed to receive input while a modal
+layer is shown. In addition, the following should be noted:
+
+- If no modal layer is shown, every layer is allowed to receive input.
+
+- Otherwise, only the modal layer and its children are allowed to receive input.
+
+Example:
+
+	isAllowed := isLayerWithinActiveModal("myLayer")
+*/
+func isLayerWithinActiveModal(layerAlias string) bool {
+	modalEntry := getActiveModalEntry()
+	if modalEntry == nil {
+		return true
+	}
+	for layerAlias != "" {
+		if layerAlias == modalEntry.layerAlias {
+			return true
+		}
+		if !Layers.IsExists(layerAlias) {
+			return false
+		}
+		layerAlias = Layers.Get(layerAlias).ParentAlias
+	}
+	return false
+}
+
+/*
+isMouseInputBlockedByModal is a method which allows you to detect if a mouse event should be ignored because it
+happened outside the modal layer currently shown. In addition, the following should be noted:
+
+  - Only mouse clicks and wheel movements are blocked. Plain mouse movements are still processed so that controls can
+    reset their hover and pressed states.
+
+- Drag operations which have already started are never blocked.
+
+Example:
+
+	isBlocked := isMouseInputBlockedByModal(10, 5, true)
+*/
+func isMouseInputBlockedByModal(mouseXLocation int, mouseYLocation int, isButtonOrWheelActive bool) bool {
+	if !isButtonOrWheelActive || eventStateMemory.stateId != constants.EventStateNone || getActiveModalEntry() == nil {
+		return false
+	}
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	return !isLayerWithinActiveModal(characterEntry.LayerAlias)
+}
+
+/*
+drawModalBackdrop is a method which allows you to dim the entire backdrop layer of a modal layer.
+
+Example:
+
+	drawModalBackdrop(modalEntry)
+*/
+func drawModalBackdrop(modalEntry modalEntryType) {
+	backdropLayerEntry := Layers.Get(modalEntry.backdropLayerAlias)
+	if backdropLayerEntry == nil || modalEntry.dimAlphaValue <= 0 {
+		return
+	}
+	drawShadow(backdropLayerEntry, types.NewAttributeEntry(), 0, 0, backdropLayerEntry.Width, backdropLayerEntry.Height, modalEntry.dimAlphaValue)
+}
+
+/*
+updateModalBackdrops is a method which allows you to resize the backdrop of every modal layer so that it still covers
+the area behind it, for example after the terminal has been resized.
+
+Example:
+
+	updateModalBackdrops()
+*/
+func updateModalBackdrops() {
+	for _, currentModalEntry := range modalMemory.modalEntries {
+		if !Layers.IsExists(currentModalEntry.backdropLayerAlias) {
+			continue
+		}
+		backdropLayerEntry := Layers.Get(currentModalEntry.backdropLayerAlias)
+		containerWidth, containerHeight := getLayerContainerSize(backdropLayerEntry)
+		if containerWidth != backdropLayerEntry.Width || containerHeight != backdropLayerEntry.Height {
+			resizeLayer(currentModalEntry.backdropLayerAlias, containerWidth, containerHeight)
+			drawModalBackdrop(currentModalEntry)
+		}
+	}
+}
+
+/*
+removeLayerModal is a method which allows you to stop a layer from being a modal layer. In addition, the following
+should be noted:
+
+  - The alias provided can be either the modal layer or its backdrop layer, so that deleting either one cleans up
+    the modal properly.
+
+- If the layer is not part of a modal, this method does nothing.
+
+Example:
+
+	removeLayerModal("myLayer")
+*/
+func removeLayerModal(layerAlias string) {
+	for currentIndex, currentModalEntry := range modalMemory.modalEntries {
+		if currentModalEntry.layerAlias != layerAlias && currentModalEntry.backdropLayerAlias != layerAlias {
+			continue
+		}
+		modalMemory.modalEntries = append(modalMemory.modalEntries[:currentIndex], modalMemory.modalEntries[currentIndex+1:]...)
+		if currentModalEntry.backdropLayerAlias != layerAlias && Layers.IsExists(currentModalEntry.backdropLayerAlias) {
+			layer.Delete(currentModalEntry.backdropLayerAlias)
+		}
+		if isLayerWithinActiveModal(currentModalEntry.previouslyFocusedControl.layerAlias) {
+			eventStateMemory.currentlyFocusedControl = currentModalEntry.previouslyFocusedControl
+		}
+		return
+	}
+}
+
+/*
+validateModalDimAlphaValue is a method which allows you to check that a dimming alpha value is within range.
+
+Example:
+
+	validateModalDimAlphaValue(0.5)
+*/
+func validateModalDimAlphaValue(dimAlphaValue float32) {
+	if dimAlphaValue < 0 || dimAlphaValue > 1 {
+		safeSttyPanic(fmt.Sprintf("The modal dimming value '%f' is invalid since it must be between 0.0 and 1.0!", dimAlphaValue))
+	}
+}
@@ -0,0 +1,224 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+	"time"
+)
+
+/*
+TestModalLayerCapturesInput is a test which verifies that a modal layer blocks input to the layers behind it and
+dims them until it is closed.
+
+Example:
+
+	Expected Inputs:
+	    A button on a background layer and a button on a modal layer, clicked while the modal is shown and after it
+	    is closed.
+
+	Expected Outputs:
+	    Only the modal button responds while the modal is shown, the background is drawn darker, and the background
+	    button responds again once the modal is closed.
+*/
+func TestModalLayerCapturesInput(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(30, 10)
+	styleEntry := NewTuiStyleEntry()
+	backgroundLayer := AddLayer(0, 0, 30, 10, 1, nil)
+	backgroundLayer.Color(constants.ColorWhite, constants.ColorBlue)
+	backgroundLayer.FillLayer(" ")
+	backgroundButton := backgroundLayer.AddButton("Back", styleEntry, 1, 1, 8, 3, true)
+	modalLayer := AddLayer(14, 3, 14, 5, 2, nil)
+	modalButton := modalLayer.AddButton("Front", styleEntry, 1, 1, 9, 3, true)
+	numberOfBackgroundPresses := 0
+	numberOfModalPresses := 0
+	backgroundButton.OnPress(func(control *BaseControlInstanceType) {
+		numberOfBackgroundPresses++
+	})
+	modalButton.OnPress(func(control *BaseControlInstanceType) {
+		numberOfModalPresses++
+	})
+	UpdateDisplay(false)
+	_, _, undimmedStyle, _ := screen.GetContent(0, 9)
+	modalLayer.ShowModal(0.5)
+	assert.Truef(test, modalLayer.IsModal(), "The layer was not reported as being modal!")
+	UpdateDisplay(false)
+	_, _, dimmedStyle, _ := screen.GetContent(0, 9)
+	assert.NotEqualf(test, undimmedStyle, dimmedStyle, "The layers behind the modal were not dimmed!")
+
+	clickModalTestLocation(screen, 3, 2)
+	assert.Equalf(test, 0, numberOfBackgroundPresses, "A button behind the modal layer received a click!")
+	clickModalTestLocation(screen, 17, 5)
+	assert.Equalf(test, 1, numberOfModalPresses, "A button on the modal layer did not receive a click!")
+
+	modalLayer.CloseModal()
+	assert.Falsef(test, modalLayer.IsModal(), "The layer was still reported as being modal after it was closed!")
+	UpdateDisplay(false)
+	_, _, restoredStyle, _ := screen.GetContent(0, 9)
+	assert.Equalf(test, undimmedStyle, restoredStyle, "The layers behind the modal were not restored after it was closed!")
+	clickModalTestLocation(screen, 3, 2)
+	assert.Equalf(test, 1, numberOfBackgroundPresses, "A button behind the modal layer did not respond after it was closed!")
+	assert.Panicsf(test, func() { backgroundLayer.ShowModal(2) }, "An invalid dimming value should panic!")
+}
+
+/*
+TestModalTabSkipsLayersBehind is a test which verifies that pressing tab never moves focus outside a modal layer.
+
+Example:
+
+	Expected Inputs:
+	    Tab pressed repeatedly while a modal layer with one text field is shown above a layer with another.
+
+	Expected Outputs:
+	    Focus always remains on the text field belonging to the modal layer, and keystrokes are typed into it.
+*/
+func TestModalTabSkipsLayersBehind(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(30, 10)
+	styleEntry := NewTuiStyleEntry()
+	backgroundLayer := AddLayer(0, 0, 30, 10, 1, nil)
+	backgroundTextField := backgroundLayer.AddTextField(styleEntry, 1, 1, 10, 10, false, "", true)
+	modalLayer := AddLayer(10, 4, 15, 4, 2, nil)
+	modalTextField := modalLayer.AddTextField(styleEntry, 1, 1, 10, 10, false, "", true)
+	ClearTabIndex()
+	addTabIndex(backgroundLayer.layerAlias, backgroundTextField.controlAlias, constants.CellTypeTextField)
+	addTabIndex(modalLayer.layerAlias, modalTextField.controlAlias, constants.CellTypeTextField)
+	modalLayer.ShowModal(0)
+	for currentPress := 0; currentPress < 3; currentPress++ {
+		screen.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+		UpdateEventQueues()
+		assert.Truef(test, isControlCurrentlyFocused(modalLayer.layerAlias, modalTextField.controlAlias, constants.CellTypeTextField), "Tab moved focus outside the modal layer!")
+	}
+	screen.InjectKey(tcell.KeyRune, 'x', tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, "x", modalTextField.GetValue(), "The text field on the modal layer did not receive a keystroke!")
+	assert.Equalf(test, "", backgroundTextField.GetValue(), "A text field behind the modal layer received a keystroke!")
+	ClearTabIndex()
+}
+
+/*
+TestModalDialogs is a test which verifies that the ready-made dialogs return the answer given by the user and clean
+up after themselves.
+
+Example:
+
+	Expected Inputs:
+	    Keystrokes answering a confirmation, including tab and shift+tab followed by enter, an input prompt, and a
+	    message box.
+
+	Expected Outputs:
+	    Each dialog returns the matching result, enter presses the button tab moved focus to, and no dialog layers,
+	    modal entries, or tab index entries remain afterwards.
+*/
+func TestModalDialogs(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(40, 20)
+	styleEntry := NewTuiStyleEntry()
+	AddLayer(0, 0, 40, 20, 1, nil)
+	numberOfLayers := len(Layers.GetAllEntries())
+
+	screen.InjectKey(tcell.KeyRune, 'n', tcell.ModNone)
+	assert.Equalf(test, constants.DialogResultNo, Confirm("Quit", "Save changes?", styleEntry), "Pressing 'n' did not answer no!")
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogResultYes, Confirm("Quit", "Save changes?", styleEntry), "Pressing enter did not answer yes!")
+	screen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogResultCancel, Confirm("Quit", "Save changes?", styleEntry), "Pressing escape did not cancel!")
+	screen.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogResultNo, Confirm("Quit", "Save changes?", styleEntry), "Pressing enter after tab did not press the button with focus!")
+	screen.InjectKey(tcell.KeyBacktab, 0, tcell.ModNone)
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogResultCancel, Confirm("Quit", "Save changes?", styleEntry), "Tab did not wrap around the buttons of the dialog!")
+
+	for _, currentRune := range "abc" {
+		screen.InjectKey(tcell.KeyRune, currentRune, tcell.ModNone)
+	}
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	value, isAccepted := InputPrompt("Name", "Enter a name:", "", styleEntry)
+	assert.Equalf(test, "abc", value, "The value typed into the input prompt was not returned!")
+	assert.Truef(test, isAccepted, "The input prompt was not accepted by pressing enter!")
+	screen.InjectKey(tcell.KeyRune, 'z', tcell.ModNone)
+	screen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	value, isAccepted = InputPrompt("Name", "Enter a name:", "default", styleEntry)
+	assert.Equalf(test, "default", value, "The default value was not returned when the input prompt was cancelled!")
+	assert.Falsef(test, isAccepted, "The input prompt was accepted when it was cancelled!")
+
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	MessageBox("Done", "All files saved.\nHave a nice day.", styleEntry)
+	assert.Equalf(test, numberOfLayers, len(Layers.GetAllEntries()), "Dialog layers were left behind after the dialogs closed!")
+	assert.Nilf(test, getActiveModalEntry(), "A modal entry was left behind after the dialogs closed!")
+	assert.Emptyf(test, eventStateMemory.tabIndexMemory, "Tab index entries were left behind after the dialogs closed!")
+}
+
+/*
+TestModalDialogsFromEventHandlers is a test which verifies that the ready-made dialogs can be opened from event
+handlers without waiting for the user, while the dialogs which wait refuse to be.
+
+Example:
+
+	Expected Inputs:
+	    A button which opens a confirmation when clicked, answered with a keystroke, an input prompt whose layer is
+	    deleted before it is answered, and a blocking dialog opened while an event is being dispatched, both with and
+	    without a background event updater running.
+
+	Expected Outputs:
+	    Clicking the button returns straight away, the handler receives the answer once it is given, deleting the
+	    dialog layer cancels the prompt, and the blocking dialog panics in both cases.
+*/
+func TestModalDialogsFromEventHandlers(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(40, 20)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 40, 20, 1, nil)
+	numberOfLayers := len(Layers.GetAllEntries())
+	confirmResult := constants.DialogResultNone
+	buttonInstance := layer1.AddButton("Quit", styleEntry, 1, 1, 8, 3, true)
+	buttonInstance.OnPress(func(control *BaseControlInstanceType) {
+		ShowConfirm("Quit", "Save changes?", styleEntry, func(result int) {
+			confirmResult = result
+		})
+	})
+	UpdateDisplay(false)
+	clickModalTestLocation(screen, 3, 2)
+	assert.Truef(test, len(Layers.GetAllEntries()) > numberOfLayers, "The confirmation was not shown when the button was clicked!")
+	assert.Equalf(test, constants.DialogResultNone, confirmResult, "The confirmation handler was called before it was answered!")
+	screen.InjectKey(tcell.KeyRune, 'n', tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, constants.DialogResultNo, confirmResult, "The confirmation handler did not receive the answer given!")
+	assert.Equalf(test, numberOfLayers, len(Layers.GetAllEntries()), "The confirmation layer was left behind after it was answered!")
+
+	isPromptAccepted := true
+	promptValue := ""
+	dialogLayerAlias := openModalDialog("Name", "Enter a name:", styleEntry, getInputPromptButtons(), true, "default", func(result int, value string) {
+		promptValue, isPromptAccepted = getInputPromptValue(result, value, "default")
+	})
+	deleteLayer(dialogLayerAlias)
+	assert.Equalf(test, "default", promptValue, "Deleting the input prompt layer did not answer with the default value!")
+	assert.Falsef(test, isPromptAccepted, "Deleting the input prompt layer did not cancel it!")
+	assert.Nilf(test, getActiveModalEntry(), "A modal entry was left behind after the dialog layer was deleted!")
+
+	eventStateMemory.dispatchDepth++
+	defer func() { eventStateMemory.dispatchDepth-- }()
+	assert.Panicsf(test, func() {
+		Confirm("Quit", "Save changes?", styleEntry)
+	}, "A blocking dialog opened while an event was being dispatched did not panic!")
+	commonResource.isEventUpdaterRunning = true
+	defer func() { commonResource.isEventUpdaterRunning = false }()
+	assert.Panicsf(test, func() {
+		Confirm("Quit", "Save changes?", styleEntry)
+	}, "A blocking dialog opened from the background event updater did not panic!")
+}
+
+/*
+clickModalTestLocation is a method which allows you to press and release the first mouse button at a given location
+on a simulation screen.
+
+Example:
+
+	clickModalTestLocation(screen, 3, 2)
+*/
+func clickModalTestLocation(screen tcell.SimulationScreen, xLocation int, yLocation int) {
+	screen.InjectMouse(xLocation, yLocation, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	time.Sleep(60 * time.Millisecond)
+	screen.InjectMouse(xLocation, yLocation, tcell.ButtonNone, tcell.ModNone)
+	UpdateEventQueues()
+}
@@ -1,7 +1,9 @@
 package consolizer
 
 import (
+	"github.com/supercom32/consolizer/constants"
 	"sync"
+	"time"
 )
 
 /*
@@ -18,6 +20,12 @@ type mouseMemoryType struct {
 	yLocation     int
 	buttonPressed uint
 	wheelState    string
+	// Track consecutive clicks, so that double and triple clicks can be detected
+	clickXLocation int
+	clickYLocation int
+	clickButton    uint
+	clickTime      time.Time
+	clickCount     int
 }
 
 // MouseMemory is the global instance for managing the current mouse state.
@@ -54,6 +62,7 @@ func ClearMouseMemory() {
 	MouseMemory.yLocation = -1
 	MouseMemory.buttonPressed = 0
 	MouseMemory.wheelState = ""
+	MouseMemory.clickCount = 0
 	PreviousMouseMemory.Lock()
 	defer func() {
 		PreviousMouseMemory.Unlock()
@@ -71,6 +80,9 @@ SetMouseStatus is a method which updates the current mouse status while preservi
 
 - The current mouse state is updated with the provided parameters.
 
+  - When a button is pressed at the same location as the last one, within the double click interval, the click count
+    is increased. A fourth click starts counting again from one.
+
 - This method is thread-safe as it uses mutex locks to prevent race conditions.
 
 Example:
@@ -90,6 +102,19 @@ func SetMouseStatus(xLocation int, yLocation int, buttonPressed uint, wheelState
 	defer func() {
 		MouseMemory.Unlock()
 	}()
+	if buttonPressed != 0 && PreviousMouseMemory.buttonPressed == 0 {
+		if MouseMemory.clickCount > 0 && MouseMemory.clickCount < 3 && MouseMemory.clickButton == buttonPressed &&
+			MouseMemory.clickXLocation == xLocation && MouseMemory.clickYLocation == yLocation &&
+			time.Since(MouseMemory.clickTime) <= constants.DefaultDoubleClickInterval*time.Millisecond {
+			MouseMemory.clickCount++
+		} else {
+			MouseMemory.clickCount = 1
+		}
+		MouseMemory.clickXLocation = xLocation
+		MouseMemory.clickYLocation = yLocation
+		MouseMemory.clickButton = buttonPressed
+		MouseMemory.clickTime = time.Now()
+	}
 	MouseMemory.xLocation = xLocation
 	MouseMemory.yLocation = yLocation
 	MouseMemory.buttonPressed = buttonPressed
@@ -114,6 +139,28 @@ func GetMouseStatus() (int, int, uint, string) {
 		MouseMemory.wheelState
 }
 
+/*
+GetMouseClickCount is a method which allows you to obtain the number of times the mouse button currently pressed was
+clicked in a row. In addition, the following should be noted:
+
+- A single click returns 1, a double click returns 2, and a triple click returns 3.
+
+- Clicks only count towards the same series if they are made at the same location, within the double click interval.
+
+Example:
+
+	if GetMouseClickCount() == 2 {
+		// Handle a double click.
+	}
+*/
+func GetMouseClickCount() int {
+	MouseMemory.Lock()
+	defer func() {
+		MouseMemory.Unlock()
+	}()
+	return MouseMemory.clickCount
+}
+
 /*
 GetPreviousMouseStatus is a method which retrieves the previous mouse status before the most recent update. In addition, the following should be noted:
 
@@ -5,6 +5,7 @@ import (
 	"github.com/supercom32/consolizer/memory"
 	"github.com/supercom32/consolizer/stringformat"
 	"github.com/supercom32/consolizer/types"
+	"sort"
 )
 
 type RadioButtonInstanceType struct {
@@ -265,6 +266,82 @@ func (shared *radioButtonType) updateMouseEvent() bool {
 	return isUpdateRequired
 }
 
+/*
+updateKeyboardEvent is a method which allows you to use the radio button currently focused with the keyboard. In
+addition, the following should be noted:
+
+- Space selects the radio button focused.
+
+  - The arrow keys move focus to the previous or next radio button of the same group, in the order they appear on the
+    screen, and select it. Disabled or hidden radio buttons are skipped, and moving past either end wraps around.
+
+- In the event that a screen update is required this method returns true.
+
+Example:
+
+	isUpdated, isConsumed := radioButton.updateKeyboardEvent([]rune("down"))
+*/
+func (shared *radioButtonType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	if focusedControlType != constants.CellTypeRadioButton || !RadioButtons.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false, false
+	}
+	direction := 0
+	switch string(keystroke) {
+	case " ":
+		selectRadioButton(focusedLayerAlias, focusedControlAlias)
+		return true, true
+	case "up", "left":
+		direction = -1
+	case "down", "right":
+		direction = 1
+	default:
+		return false, false
+	}
+	radioButtonGroup := shared.getRadioButtonGroup(focusedLayerAlias, focusedControlAlias)
+	for currentIndex, radioButtonEntry := range radioButtonGroup {
+		if radioButtonEntry.Alias == focusedControlAlias {
+			nextRadioButtonEntry := radioButtonGroup[(currentIndex+direction+len(radioButtonGroup))%len(radioButtonGroup)]
+			selectRadioButton(focusedLayerAlias, nextRadioButtonEntry.Alias)
+			setFocusedControl(focusedLayerAlias, nextRadioButtonEntry.Alias, constants.CellTypeRadioButton)
+			break
+		}
+	}
+	return true, true
+}
+
+/*
+getRadioButtonGroup is a method which allows you to obtain the radio buttons which share a group with the radio
+button given, sorted in the order they appear on the screen from top to bottom and left to right. Radio buttons
+which are disabled or hidden are left out, except for the one given.
+
+Example:
+
+	radioButtonGroup := radioButton.getRadioButtonGroup("Layer1", "Radio1")
+*/
+func (shared *radioButtonType) getRadioButtonGroup(layerAlias string, radioButtonAlias string) []*types.RadioButtonEntryType {
+	radioButtonEntry := RadioButtons.Get(layerAlias, radioButtonAlias)
+	var radioButtonGroup []*types.RadioButtonEntryType
+	for _, currentRadioButtonEntry := range RadioButtons.GetAllEntries(layerAlias) {
+		if currentRadioButtonEntry.GroupId != radioButtonEntry.GroupId {
+			continue
+		}
+		if currentRadioButtonEntry.Alias != radioButtonAlias && (!currentRadioButtonEntry.IsEnabled || !currentRadioButtonEntry.IsVisible) {
+			continue
+		}
+		radioButtonGroup = append(radioButtonGroup, currentRadioButtonEntry)
+	}
+	sort.Slice(radioButtonGroup, func(firstIndex int, secondIndex int) bool {
+		if radioButtonGroup[firstIndex].YLocation != radioButtonGroup[secondIndex].YLocation {
+			return radioButtonGroup[firstIndex].YLocation < radioButtonGroup[secondIndex].YLocation
+		}
+		return radioButtonGroup[firstIndex].XLocation < radioButtonGroup[secondIndex].XLocation
+	})
+	return radioButtonGroup
+}
+
 /*
 selectRadioButton is a method which allows you to select a radio button on a given text layer. In addition, the
 following information should be noted:
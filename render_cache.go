@@ -0,0 +1,445 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/supercom32/consolizer/types"
+)
+
+/*
+layerRenderCacheEntryType is a structure which holds a text layer as it looked the last time it was rendered, along
+with everything needed to detect how it has changed since.
+*/
+type layerRenderCacheEntryType struct {
+	renderedLayerEntry types.LayerEntryType
+	characterMemoryId  *types.CharacterEntryType
+	controlRegion      types.DirtyRegionEntryType
+	screenRegion       types.DirtyRegionEntryType
+	xOrigin            int
+	yOrigin            int
+}
+
+/*
+renderCacheType is a structure which holds the state required to update the display incrementally. In addition, the
+following should be noted:
+
+  - The composed layer holds the final image of every text layer rendered together, and is reused between updates so
+    that only dirty regions need to be recomposited.
+*/
+type renderCacheType struct {
+	isValid            bool
+	width              int
+	height             int
+	layerAliases       []string
+	layerEntries       map[string]*layerRenderCacheEntryType
+	composedLayerEntry types.LayerEntryType
+	tooltipRegion      types.DirtyRegionEntryType
+}
+
+/*
+renderCacheMemory is a variable which holds the render cache used by UpdateDisplay.
+*/
+var renderCacheMemory renderCacheType
+
+/*
+resetRenderCache is a method which allows you to discard everything cached from previous display updates, so that the
+next update redraws the entire screen.
+
+Example:
+
+	resetRenderCache()
+*/
+func resetRenderCache() {
+	renderCacheMemory = renderCacheType{}
+}
+
+/*
+updateRenderCache is a method which allows you to bring the render cache up to date with the current state of every
+text layer, and obtain the screen regions which need to be recomposited as a result. In addition, the following should
+be noted:
+
+  - Regions are collected from the dirty region of each layer, the areas drawn by controls, and the old and new
+    locations of any layer which was moved, resized, hidden, shown or deleted.
+
+  - If the layer order or terminal size changed, or a refresh is forced, the entire screen is returned as dirty and
+    every layer is rendered from scratch.
+
+- Dirty regions of all text layers are cleared once they have been collected.
+
+Example:
+
+	dirtyRegions := updateRenderCache(sortedLayerAliasSlice, false)
+*/
+func updateRenderCache(sortedLayerAliasSlice LayerAliasZOrderPairList, isRefreshForced bool) []types.DirtyRegionEntryType {
+	screenRegion := types.DirtyRegionEntryType{Width: commonResource.terminalWidth, Height: commonResource.terminalHeight}
+	layerAliases := make([]string, len(sortedLayerAliasSlice))
+	for currentIndex, currentPair := range sortedLayerAliasSlice {
+		layerAliases[currentIndex] = currentPair.Key
+	}
+	isFullRedrawRequired := isRefreshForced || !renderCacheMemory.isValid || !isStringSliceEqual(layerAliases, renderCacheMemory.layerAliases)
+	if !renderCacheMemory.isValid || renderCacheMemory.width != screenRegion.Width || renderCacheMemory.height != screenRegion.Height {
+		renderCacheMemory.composedLayerEntry = types.NewLayerEntry("", "", screenRegion.Width, screenRegion.Height)
+		renderCacheMemory.layerEntries = make(map[string]*layerRenderCacheEntryType)
+		renderCacheMemory.width = screenRegion.Width
+		renderCacheMemory.height = screenRegion.Height
+		isFullRedrawRequired = true
+	}
+	if isRefreshForced {
+		renderCacheMemory.layerEntries = make(map[string]*layerRenderCacheEntryType)
+	}
+	renderCacheMemory.isValid = true
+	renderCacheMemory.layerAliases = layerAliases
+
+	// The tooltips drawn during the last update must always be erased.
+	dirtyRegions := []types.DirtyRegionEntryType{renderCacheMemory.tooltipRegion}
+	isLayerRendered := make(map[string]bool)
+	for _, layerAlias := range layerAliases {
+		if !Layers.IsExists(layerAlias) {
+			continue
+		}
+		layerEntry := Layers.Get(layerAlias)
+		cacheEntry, isCached := renderCacheMemory.layerEntries[layerAlias]
+		if !layerEntry.IsVisible {
+			if isCached {
+				dirtyRegions = append(dirtyRegions, cacheEntry.screenRegion)
+				delete(renderCacheMemory.layerEntries, layerAlias)
+			}
+			layerEntry.ClearDirtyRegion()
+			continue
+		}
+		isLayerRendered[layerAlias] = true
+		xOrigin, yOrigin, layerScreenRegion := getLayerScreenRegion(layerEntry)
+		layerScreenRegion = layerScreenRegion.GetIntersection(screenRegion)
+		if !isCached || cacheEntry.characterMemoryId != getCharacterMemoryId(layerEntry) ||
+			cacheEntry.renderedLayerEntry.Width != layerEntry.Width || cacheEntry.renderedLayerEntry.Height != layerEntry.Height {
+			if isCached {
+				dirtyRegions = append(dirtyRegions, cacheEntry.screenRegion)
+			}
+			cacheEntry = &layerRenderCacheEntryType{}
+			cacheEntry.renderedLayerEntry = getLayerEntryRegion(layerEntry, types.DirtyRegionEntryType{Width: layerEntry.Width, Height: layerEntry.Height})
+			renderControls(cacheEntry.renderedLayerEntry)
+			cacheEntry.controlRegion = cacheEntry.renderedLayerEntry.GetDirtyRegion()
+			cacheEntry.characterMemoryId = getCharacterMemoryId(layerEntry)
+			renderCacheMemory.layerEntries[layerAlias] = cacheEntry
+			dirtyRegions = append(dirtyRegions, layerScreenRegion)
+		} else {
+			previousDefaultAttribute := cacheEntry.renderedLayerEntry.DefaultAttribute
+			renderedCharacterMemory := cacheEntry.renderedLayerEntry.CharacterMemory
+			renderedDirtyRegion := cacheEntry.renderedLayerEntry.DirtyRegion
+			cacheEntry.renderedLayerEntry = *layerEntry
+			cacheEntry.renderedLayerEntry.CharacterMemory = renderedCharacterMemory
+			cacheEntry.renderedLayerEntry.DirtyRegion = renderedDirtyRegion
+			changedRegion := updateLayerRenderCacheEntry(layerEntry, cacheEntry)
+			if cacheEntry.xOrigin != xOrigin || cacheEntry.yOrigin != yOrigin || cacheEntry.screenRegion != layerScreenRegion ||
+				previousDefaultAttribute != layerEntry.DefaultAttribute {
+				dirtyRegions = append(dirtyRegions, cacheEntry.screenRegion, layerScreenRegion)
+			} else {
+				dirtyRegions = append(dirtyRegions, changedRegion.GetTranslated(xOrigin, yOrigin).GetIntersection(layerScreenRegion))
+			}
+		}
+		cacheEntry.xOrigin = xOrigin
+		cacheEntry.yOrigin = yOrigin
+		cacheEntry.screenRegion = layerScreenRegion
+		layerEntry.ClearDirtyRegion()
+	}
+	for layerAlias, cacheEntry := range renderCacheMemory.layerEntries {
+		if !isLayerRendered[layerAlias] {
+			dirtyRegions = append(dirtyRegions, cacheEntry.screenRegion)
+			delete(renderCacheMemory.layerEntries, layerAlias)
+		}
+	}
+	if isFullRedrawRequired {
+		return []types.DirtyRegionEntryType{screenRegion}
+	}
+	return getMergedDirtyRegions(dirtyRegions, screenRegion)
+}
+
+/*
+updateLayerRenderCacheEntry is a method which allows you to bring a cached text layer up to date with its source, and
+obtain the area of the layer which actually changed as a result. In addition, the following should be noted:
+
+  - Only cells inside the dirty region of the source layer, or inside the area previously drawn by controls, are copied
+    from the source layer.
+
+  - Controls are always redrawn, but cells which controls draw identically to the previous update are not reported as
+    changed.
+
+Example:
+
+	changedRegion := updateLayerRenderCacheEntry(layerEntry, cacheEntry)
+*/
+func updateLayerRenderCacheEntry(layerEntry *types.LayerEntryType, cacheEntry *layerRenderCacheEntryType) types.DirtyRegionEntryType {
+	renderedLayerEntry := &cacheEntry.renderedLayerEntry
+	layerDirtyRegion := layerEntry.GetDirtyRegion()
+	previousControlRegion := cacheEntry.controlRegion
+	previousControlLayerEntry := getLayerEntryRegion(renderedLayerEntry, previousControlRegion)
+	restoreRegion := layerDirtyRegion.GetUnion(previousControlRegion)
+	for currentRow := restoreRegion.YLocation; currentRow < restoreRegion.YLocation+restoreRegion.Height; currentRow++ {
+		copy(renderedLayerEntry.CharacterMemory[currentRow][restoreRegion.XLocation:restoreRegion.XLocation+restoreRegion.Width],
+			layerEntry.CharacterMemory[currentRow][restoreRegion.XLocation:restoreRegion.XLocation+restoreRegion.Width])
+	}
+	renderedLayerEntry.ClearDirtyRegion()
+	renderControls(*renderedLayerEntry)
+	cacheEntry.controlRegion = renderedLayerEntry.GetDirtyRegion()
+
+	// Compare everything controls touched, now or previously, against what was there before.
+	changedRegion := layerDirtyRegion
+	compareRegion := previousControlRegion.GetUnion(cacheEntry.controlRegion)
+	for currentRow := compareRegion.YLocation; currentRow < compareRegion.YLocation+compareRegion.Height; currentRow++ {
+		for currentColumn := compareRegion.XLocation; currentColumn < compareRegion.XLocation+compareRegion.Width; currentColumn++ {
+			currentCell := types.DirtyRegionEntryType{XLocation: currentColumn, YLocation: currentRow, Width: 1, Height: 1}
+			if layerDirtyRegion.IsIntersecting(currentCell) {
+				continue
+			}
+			previousCharacterEntry := layerEntry.CharacterMemory[currentRow][currentColumn]
+			if previousControlRegion.IsIntersecting(currentCell) {
+				previousCharacterEntry = previousControlLayerEntry.CharacterMemory[currentRow-previousControlRegion.YLocation][currentColumn-previousControlRegion.XLocation]
+			}
+			if renderedLayerEntry.CharacterMemory[currentRow][currentColumn] != previousCharacterEntry {
+				changedRegion = changedRegion.GetUnion(currentCell)
+			}
+		}
+	}
+	return changedRegion
+}
+
+/*
+renderLayersInRegion is a method which allows you to render the cached text layers to the specified root text layer,
+while only modifying cells inside the region provided. In addition, the following should be noted:
+
+  - Layers are drawn in the same order and with the same rules as renderLayers, so the result is identical to rendering
+    the entire screen.
+
+  - Source layers are shifted by the offset provided before being drawn. This allows a portion of a parent layer to be
+    composited with its children without rendering the parent in full.
+
+Example:
+
+	renderLayersInRegion(&rootLayer, aliases, region, 0, 0)
+*/
+func renderLayersInRegion(rootLayerEntry *types.LayerEntryType, sortedLayerAliasSlice LayerAliasZOrderPairList, region types.DirtyRegionEntryType, xOffset int, yOffset int) {
+	isOpaque := true
+	for currentListIndex := 0; currentListIndex < len(sortedLayerAliasSlice); currentListIndex++ {
+		if !Layers.IsExists(sortedLayerAliasSlice[currentListIndex].Key) {
+			continue
+		}
+		cacheEntry, isCached := renderCacheMemory.layerEntries[sortedLayerAliasSlice[currentListIndex].Key]
+		if isCached && cacheEntry.renderedLayerEntry.ParentAlias == rootLayerEntry.LayerAlias && cacheEntry.renderedLayerEntry.LayerAlias != rootLayerEntry.LayerAlias {
+			currentLayerEntry := cacheEntry.renderedLayerEntry
+			currentLayerEntry.ScreenXLocation -= xOffset
+			currentLayerEntry.ScreenYLocation -= yOffset
+			if currentLayerEntry.IsParent {
+				layerRegion := types.DirtyRegionEntryType{XLocation: currentLayerEntry.ScreenXLocation, YLocation: currentLayerEntry.ScreenYLocation, Width: currentLayerEntry.Width, Height: currentLayerEntry.Height}
+				layerRegion = layerRegion.GetIntersection(region)
+				if !layerRegion.IsEmpty() {
+					localRegion := layerRegion.GetTranslated(-currentLayerEntry.ScreenXLocation, -currentLayerEntry.ScreenYLocation)
+					renderedLayerEntry := getLayerEntryRegion(&currentLayerEntry, localRegion)
+					renderedLayerEntry.ScreenXLocation = layerRegion.XLocation
+					renderedLayerEntry.ScreenYLocation = layerRegion.YLocation
+					renderLayersInRegion(&renderedLayerEntry, sortedLayerAliasSlice, types.DirtyRegionEntryType{Width: localRegion.Width, Height: localRegion.Height}, localRegion.XLocation, localRegion.YLocation)
+					overlayLayersInRegion(&renderedLayerEntry, rootLayerEntry, isOpaque, region)
+				}
+			} else {
+				overlayLayersInRegion(&currentLayerEntry, rootLayerEntry, isOpaque, region)
+			}
+		}
+		// After the first layer is rendered to the base, allow for transparencies.
+		if isOpaque {
+			isOpaque = false
+		}
+	}
+}
+
+/*
+getLayerScreenRegion is a method which allows you to obtain where a text layer is drawn on the terminal screen. In
+addition, the following should be noted:
+
+- The x and y origin returned is the absolute screen location of the top-left corner of the layer.
+
+  - The region returned is the portion of the layer which can actually be seen, after being clipped by all of its parent
+    layers.
+
+- If any parent layer is hidden or missing, an empty region is returned since the layer will never be drawn.
+
+Example:
+
+	xOrigin, yOrigin, screenRegion := getLayerScreenRegion(layerEntry)
+*/
+func getLayerScreenRegion(layerEntry *types.LayerEntryType) (int, int, types.DirtyRegionEntryType) {
+	layerEntries := []*types.LayerEntryType{layerEntry}
+	for currentLayerEntry := layerEntry; currentLayerEntry.ParentAlias != ""; {
+		if !Layers.IsExists(currentLayerEntry.ParentAlias) || len(layerEntries) > len(renderCacheMemory.layerAliases) {
+			return 0, 0, types.DirtyRegionEntryType{}
+		}
+		currentLayerEntry = Layers.Get(currentLayerEntry.ParentAlias)
+		if !currentLayerEntry.IsVisible || !currentLayerEntry.IsParent {
+			return 0, 0, types.DirtyRegionEntryType{}
+		}
+		layerEntries = append(layerEntries, currentLayerEntry)
+	}
+	xOrigin := 0
+	yOrigin := 0
+	var screenRegion types.DirtyRegionEntryType
+	for currentIndex := len(layerEntries) - 1; currentIndex >= 0; currentIndex-- {
+		xOrigin += layerEntries[currentIndex].ScreenXLocation
+		yOrigin += layerEntries[currentIndex].ScreenYLocation
+		layerRegion := types.DirtyRegionEntryType{XLocation: xOrigin, YLocation: yOrigin, Width: layerEntries[currentIndex].Width, Height: layerEntries[currentIndex].Height}
+		if currentIndex == len(layerEntries)-1 {
+			screenRegion = layerRegion
+		} else {
+			screenRegion = screenRegion.GetIntersection(layerRegion)
+		}
+	}
+	return xOrigin, yOrigin, screenRegion
+}
+
+/*
+getLayerEntryRegion is a method which allows you to obtain a copy of a portion of a text layer. In addition, the
+following should be noted:
+
+- All layer settings are copied from the source, but the width and height match the region requested.
+
+- The copy does not share character memory with the source.
+
+Example:
+
+	layerEntryCopy := getLayerEntryRegion(&layerEntry, region)
+*/
+func getLayerEntryRegion(layerEntry *types.LayerEntryType, region types.DirtyRegionEntryType) types.LayerEntryType {
+	layerEntryCopy := *layerEntry
+	layerEntryCopy.Width = region.Width
+	layerEntryCopy.Height = region.Height
+	layerEntryCopy.CharacterMemory = make([][]types.CharacterEntryType, region.Height)
+	for currentRow := 0; currentRow < region.Height; currentRow++ {
+		layerEntryCopy.CharacterMemory[currentRow] = make([]types.CharacterEntryType, region.Width)
+		copy(layerEntryCopy.CharacterMemory[currentRow], layerEntry.CharacterMemory[region.YLocation+currentRow][region.XLocation:region.XLocation+region.Width])
+	}
+	dirtyRegion := types.NewDirtyRegionEntry()
+	layerEntryCopy.DirtyRegion = &dirtyRegion
+	return layerEntryCopy
+}
+
+/*
+getCharacterMemoryId is a method which allows you to obtain a value which identifies the character memory currently
+used by a text layer. If the memory of a layer is replaced, for example when it is scrolled or resized, the value
+returned will change.
+
+Example:
+
+	characterMemoryId := getCharacterMemoryId(layerEntry)
+*/
+func getCharacterMemoryId(layerEntry *types.LayerEntryType) *types.CharacterEntryType {
+	if len(layerEntry.CharacterMemory) == 0 || len(layerEntry.CharacterMemory[0]) == 0 {
+		return nil
+	}
+	return &layerEntry.CharacterMemory[0][0]
+}
+
+/*
+getMergedDirtyRegions is a method which allows you to combine a list of dirty regions so that no two regions overlap.
+In addition, the following should be noted:
+
+- Regions are clipped to the bounds provided, and empty regions are discarded.
+
+- Overlapping regions are replaced by a single region which contains both.
+
+Example:
+
+	dirtyRegions = getMergedDirtyRegions(dirtyRegions, screenRegion)
+*/
+func getMergedDirtyRegions(dirtyRegions []types.DirtyRegionEntryType, boundingRegion types.DirtyRegionEntryType) []types.DirtyRegionEntryType {
+	var mergedRegions []types.DirtyRegionEntryType
+	for _, currentRegion := range dirtyRegions {
+		currentRegion = currentRegion.GetIntersection(boundingRegion)
+		if currentRegion.IsEmpty() {
+			continue
+		}
+		for isMerged := true; isMerged; {
+			isMerged = false
+			for currentIndex, mergedRegion := range mergedRegions {
+				if mergedRegion.IsIntersecting(currentRegion) {
+					currentRegion = currentRegion.GetUnion(mergedRegion)
+					mergedRegions = append(mergedRegions[:currentIndex], mergedRegions[currentIndex+1:]...)
+					isMerged = true
+					break
+				}
+			}
+		}
+		mergedRegions = append(mergedRegions, currentRegion)
+	}
+	return mergedRegions
+}
+
+/*
+clearLayerRegion is a method which allows you to reset every cell inside a region of a text layer to an empty cell.
+
+Example:
+
+	clearLayerRegion(&layerEntry, region)
+*/
+func clearLayerRegion(layerEntry *types.LayerEntryType, region types.DirtyRegionEntryType) {
+	for currentRow := region.YLocation; currentRow < region.YLocation+region.Height; currentRow++ {
+		for currentColumn := region.XLocation; currentColumn < region.XLocation+region.Width; currentColumn++ {
+			layerEntry.CharacterMemory[currentRow][currentColumn] = types.NewCharacterEntry()
+		}
+	}
+	layerEntry.MarkDirtyRegion(region.XLocation, region.YLocation, region.Width, region.Height)
+}
+
+/*
+drawLayerRegionsToScreen is a method which allows you to render only specific regions of a text layer to the visible
+terminal screen. In addition, the following should be noted:
+
+- If no terminal screen is available, this method does nothing.
+
+Example:
+
+	drawLayerRegionsToScreen(&layerEntry, dirtyRegions, false)
+*/
+func drawLayerRegionsToScreen(layerEntry *types.LayerEntryType, regions []types.DirtyRegionEntryType, isForcedRefreshRequired bool) {
+	if commonResource.screen == nil {
+		return
+	}
+	layerRegion := types.DirtyRegionEntryType{Width: layerEntry.Width, Height: layerEntry.Height}
+	for _, currentRegion := range regions {
+		currentRegion = currentRegion.GetIntersection(layerRegion)
+		for currentRow := currentRegion.YLocation; currentRow < currentRegion.YLocation+currentRegion.Height; currentRow++ {
+			for currentCharacter := currentRegion.XLocation; currentCharacter < currentRegion.XLocation+currentRegion.Width; currentCharacter++ {
+				style := tcell.StyleDefault
+				attributeEntry := layerEntry.CharacterMemory[currentRow][currentCharacter].AttributeEntry
+				style = style.Foreground(tcell.Color(attributeEntry.ForegroundColor))
+				style = style.Background(tcell.Color(attributeEntry.BackgroundColor))
+				style = style.Blink(attributeEntry.IsBlinking)
+				style = style.Bold(attributeEntry.IsBold)
+				style = style.Reverse(attributeEntry.IsReversed)
+				style = style.Underline(attributeEntry.IsUnderlined)
+				var character = layerEntry.CharacterMemory[currentRow][currentCharacter].Character
+				r2 := []rune("")
+				commonResource.screen.SetContent(currentCharacter, currentRow, character, r2, style)
+			}
+		}
+	}
+	if isForcedRefreshRequired {
+		commonResource.screen.Sync()
+	}
+	commonResource.screen.Show()
+}
+
+/*
+isStringSliceEqual is a method which allows you to detect if two string slices contain the same values in the same
+order.
+
+Example:
+
+	isEqual := isStringSliceEqual(firstSlice, secondSlice)
+*/
+func isStringSliceEqual(firstSlice []string, secondSlice []string) bool {
+	if len(firstSlice) != len(secondSlice) {
+		return false
+	}
+	for currentIndex := range firstSlice {
+		if firstSlice[currentIndex] != secondSlice[currentIndex] {
+			return false
+		}
+	}
+	return true
+}
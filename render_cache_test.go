@@ -0,0 +1,108 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+/*
+TestRenderCacheMatchesFullRender is a test which verifies that updating the display incrementally produces exactly the
+same screen as recompositing every layer from scratch.
+
+Example:
+
+	Expected Inputs:
+	    A series of prints, moves, visibility changes, z-order changes and control updates across parent, child and
+	    transparent layers.
+
+	Expected Outputs:
+	    After every change, the incrementally updated screen matches a forced full refresh.
+*/
+func TestRenderCacheMatchesFullRender(test *testing.T) {
+	layer1, layer2, layer3, styleEntry := CommonTestSetup()
+	childLayer := AddLayer(2, 2, 10, 5, 4, layer2)
+	childLayer.Color(15, 1)
+	childLayer.FillLayer("c")
+	layer3.SetAlpha(0.5)
+	button := layer1.AddButton("OK", styleEntry, 1, 1, 8, 3, true)
+	textField := layer2.AddTextField(styleEntry, 1, 1, 10, 20, false, "abc", true)
+	changes := []func(){
+		func() { layer1.Locate(5, 5); layer1.Print("Hello") },
+		func() { layer2.MoveLayerByAbsoluteValue(6, 4) },
+		func() { childLayer.Locate(1, 1); childLayer.Print("Child") },
+		func() { childLayer.MoveLayerByRelativeValue(-3, 1) },
+		func() { textField.SetValue("changed") },
+		func() { button.SetLabel("Cancel") },
+		func() { layer3.FillArea("#", 10, 10, 5, 3) },
+		func() { layer2.SetIsVisible(false) },
+		func() { layer2.SetIsVisible(true) },
+		func() { layer1.DrawShadow(12, 2, 6, 4, 0.5) },
+		func() { layer2.SetZOrder(0) },
+		func() { childLayer.Delete() },
+	}
+	UpdateDisplay(false)
+	for currentIndex, currentChange := range changes {
+		currentChange()
+		UpdateDisplay(false)
+		obtainedValue := commonResource.screenLayer.GetBasicAnsiString()
+		UpdateDisplay(true)
+		expectedValue := commonResource.screenLayer.GetBasicAnsiString()
+		assert.Equalf(test, expectedValue, obtainedValue, "The incremental display update for change %d did not match a full refresh!", currentIndex)
+	}
+}
+
+/*
+TestRenderCacheOnlyDirtyRegionsDrawn is a test which verifies that only regions which changed are sent to the terminal
+screen.
+
+Example:
+
+	Expected Inputs:
+	    A marker written directly to the simulation screen, followed by a print elsewhere on the layer.
+
+	Expected Outputs:
+	    The printed text appears on screen, while the marker in the untouched region is left alone until a forced
+	    refresh redraws everything.
+*/
+func TestRenderCacheOnlyDirtyRegionsDrawn(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(20, 5)
+	layer1 := AddLayer(0, 0, 20, 5, 1, nil)
+	layer1.FillLayer(".")
+	UpdateDisplay(false)
+	screen.SetContent(0, 4, 'X', nil, tcell.StyleDefault)
+	screen.Show()
+	layer1.Locate(0, 0)
+	layer1.Print("Hi")
+	UpdateDisplay(false)
+	assert.Equalf(test, "Hi..................", GetSimulationScreenRow(screen, 0), "The printed text was not drawn to the screen!")
+	assert.Equalf(test, "X...................", GetSimulationScreenRow(screen, 4), "A region which did not change was drawn to the screen!")
+	UpdateDisplay(true)
+	assert.Equalf(test, "....................", GetSimulationScreenRow(screen, 4), "A forced refresh did not redraw the entire screen!")
+}
+
+/*
+TestRenderCacheLayerDirtyRegion is a test which verifies that drawing to a layer records the area modified, and that
+updating the display clears it.
+
+Example:
+
+	Expected Inputs:
+	    A print and a filled area on a layer.
+
+	Expected Outputs:
+	    The dirty region covers both areas before the display is updated, and is empty afterwards.
+*/
+func TestRenderCacheLayerDirtyRegion(test *testing.T) {
+	layer1, _, _, _ := CommonTestSetup()
+	UpdateDisplay(false)
+	layerEntry := Layers.Get(layer1.layerAlias)
+	assert.Truef(test, layerEntry.GetDirtyRegion().IsEmpty(), "The dirty region was not cleared by the display update!")
+	layer1.Locate(2, 3)
+	layer1.Print("abc")
+	layer1.FillArea("#", 10, 6, 4, 2)
+	dirtyRegion := layerEntry.GetDirtyRegion()
+	assert.Equalf(test, []int{2, 3, 12, 5}, []int{dirtyRegion.XLocation, dirtyRegion.YLocation, dirtyRegion.Width, dirtyRegion.Height}, "The dirty region did not cover the areas drawn!")
+	UpdateDisplay(false)
+	assert.Truef(test, layerEntry.GetDirtyRegion().IsEmpty(), "The dirty region was not cleared by the display update!")
+}
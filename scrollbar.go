@@ -305,14 +305,9 @@ func (shared *scrollbarType) computeValueByHandlePosition(layerAlias string, scr
 	if scrollbarEntry.HandlePosition < 0 {
 		scrollbarEntry.HandlePosition = 0
 	}
-	// If you scroll to the last square of a scroll bar, set value to max since that's what a user
-	// expects to happen.
-	if scrollbarEntry.HandlePosition == scrollbarEntry.Length-3 {
-		scrollbarEntry.ScrollValue = scrollbarEntry.MaxScrollValue
-		return
-	}
-	percentScrolled := float64(scrollbarEntry.HandlePosition) / float64(scrollbarEntry.Length)
-	scrollbarEntry.ScrollValue = int(float64(scrollbarEntry.MaxScrollValue) * percentScrolled)
+	// The value is scaled by the whole length of the scroll bar, including its arrows, so it only reaches the maximum
+	// on the last square.
+	scrollbarEntry.ScrollValue = getValueByTrackPosition(scrollbarEntry.HandlePosition, scrollbarEntry.Length-3, scrollbarEntry.Length, scrollbarEntry.MaxScrollValue)
 }
 
 /*
@@ -340,16 +335,58 @@ func (shared *scrollbarType) computeHandlePositionByScrollValue(layerAlias strin
 	if scrollbarEntry.ScrollValue < 0 {
 		scrollbarEntry.ScrollValue = 0
 	}
-	percentScrolled := float64(0)
+	scrollbarEntry.HandlePosition = getTrackPositionByValue(scrollbarEntry.ScrollValue, scrollbarEntry.MaxScrollValue, scrollbarEntry.Length-3)
+}
+
+/*
+getValueByTrackPosition is a method which allows you to obtain the value represented by a position along a track,
+such as the one a scroll bar handle moves along. Position 0 represents a value of 0, and the last position represents
+the maximum value. In addition, the following should be noted:
+
+  - Positions in between are scaled linearly by the position scale given, rounding the value down. Passing the last
+    position as the scale spreads the values evenly along the track.
+
+  - The last position always represents the maximum value, since that's what a user expects to happen when moving a
+    handle all the way to the end.
+
+Example:
+
+	value := getValueByTrackPosition(5, 10, 10, 100)
+*/
+func getValueByTrackPosition(position int, lastPosition int, positionScale int, maximumValue int) int {
+	if position >= lastPosition {
+		return maximumValue
+	}
+	if position <= 0 || positionScale <= 0 {
+		return 0
+	}
+	percentScrolled := float64(position) / float64(positionScale)
+	return int(float64(maximumValue) * percentScrolled)
+}
+
+/*
+getTrackPositionByValue is a method which allows you to obtain the position along a track which represents a value
+between 0 and a maximum value. This is the reverse of getValueByTrackPosition. In addition, the following should be
+noted:
+
+- Values outside of the range are clamped to the first or last position.
+
+- If the maximum value is 0, the first position is returned.
+
+Example:
+
+	position := getTrackPositionByValue(50, 100, 10)
+*/
+func getTrackPositionByValue(value int, maximumValue int, lastPosition int) int {
 	// Protect against divide by zero cases.
-	if scrollbarEntry.MaxScrollValue != 0 {
-		percentScrolled = float64(scrollbarEntry.ScrollValue) / float64(scrollbarEntry.MaxScrollValue)
+	if maximumValue <= 0 || value <= 0 {
+		return 0
 	}
-	scrollbarEntry.HandlePosition = int(float64(scrollbarEntry.Length-3) * percentScrolled)
-	// Protect in case drawing over the bar limit.
-	if scrollbarEntry.HandlePosition >= scrollbarEntry.Length {
-		scrollbarEntry.HandlePosition = scrollbarEntry.Length - 3
+	if value >= maximumValue {
+		return lastPosition
 	}
+	percentScrolled := float64(value) / float64(maximumValue)
+	return int(float64(lastPosition) * percentScrolled)
 }
 
 /*
@@ -245,3 +245,32 @@ func TestScrollbarKeyboardEvent(test *testing.T) {
 		fmt.Println("Scrollbar value is incorrect")
 	}
 }
+
+/*
+TestScrollbarTrackMath is a test which verifies that the math shared by scroll bars and sliders converts between
+positions along a track and values in both directions.
+
+Example:
+
+	Expected Inputs:
+	    Positions and values at the start, middle, and end of a track, values outside of the range, a maximum value
+	    of 0, and the handle of a scroll bar moved along it.
+
+	Expected Outputs:
+	    Positions and values scale linearly, the ends of the track match the ends of the range, values outside of the
+	    range are clamped, a maximum value of 0 never divides by zero, and scroll bars keep scaling values by their
+	    whole length.
+*/
+func TestScrollbarTrackMath(test *testing.T) {
+	assert.Equalf(test, []int{0, 50, 100}, []int{getValueByTrackPosition(0, 10, 10, 100), getValueByTrackPosition(5, 10, 10, 100), getValueByTrackPosition(10, 10, 10, 100)}, "Positions were not converted to values linearly!")
+	assert.Equalf(test, []int{0, 5, 10}, []int{getTrackPositionByValue(0, 100, 10), getTrackPositionByValue(50, 100, 10), getTrackPositionByValue(100, 100, 10)}, "Values were not converted to positions linearly!")
+	assert.Equalf(test, []int{0, 10}, []int{getTrackPositionByValue(-5, 100, 10), getTrackPositionByValue(150, 100, 10)}, "Values outside of the range were not clamped!")
+	assert.Equalf(test, 0, getTrackPositionByValue(5, 0, 10), "A maximum value of 0 did not return the first position!")
+
+	layer1, _, _, styleEntry := CommonTestSetup()
+	scrollbarInstance := layer1.AddScrollbar(styleEntry, 2, 2, 10, 100, 0, 1, false)
+	scrollbarInstance.setHandlePosition(5)
+	assert.Equalf(test, 49, scrollbarInstance.getScrollValue(), "The scroll bar value was not scaled by its whole length!")
+	scrollbarInstance.setHandlePosition(7)
+	assert.Equalf(test, ScrollBars.Get(layer1.layerAlias, scrollbarInstance.controlAlias).MaxScrollValue, scrollbarInstance.getScrollValue(), "The last square of the scroll bar did not give the maximum value!")
+}
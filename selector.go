@@ -489,6 +489,37 @@ func (shared *SelectorInstanceType) DeleteItem(index int) {
 	}
 }
 
+/*
+updateScrollbarBounds is a method which allows you to place the scroll bar of a selector along its right edge, based on
+its current position and size. In addition, the following should be noted:
+
+- If a border is drawn, the scroll bar is placed over the border instead of inside it.
+
+  - The limit of the scroll bar is recalculated, since it depends on the height of the selector. Whether the scroll
+    bar is shown is left unchanged, since a dropdown hides it while its tray is closed.
+
+Example:
+
+	Selector.updateScrollbarBounds("layer1", "selector1")
+*/
+func (shared *selectorType) updateScrollbarBounds(layerAlias string, selectorAlias string) {
+	selectorEntry := Selectors.Get(layerAlias, selectorAlias)
+	if selectorEntry == nil || !ScrollBars.IsExists(layerAlias, selectorEntry.ScrollbarAlias) {
+		return
+	}
+	scrollBarEntry := ScrollBars.Get(layerAlias, selectorEntry.ScrollbarAlias)
+	scrollBarEntry.XLocation = selectorEntry.XLocation + (selectorEntry.ItemWidth * selectorEntry.NumberOfColumns) - 1
+	scrollBarEntry.YLocation = selectorEntry.YLocation
+	scrollBarEntry.Length = selectorEntry.Height
+	if selectorEntry.IsBorderDrawn {
+		scrollBarEntry.XLocation += 2
+		scrollBarEntry.YLocation--
+		scrollBarEntry.Length += 2
+	}
+	scrollBarEntry.MaxScrollValue = len(selectorEntry.SelectionEntry.SelectionValue) - (selectorEntry.Height * selectorEntry.NumberOfColumns)
+	scrollbar.computeHandlePositionByScrollValue(layerAlias, selectorEntry.ScrollbarAlias)
+}
+
 /*
 Add is a method which allows you to add a selector to a given text layer. Once called, an instance of your control is
 returned which will allow you to read or manipulate the properties for it. The style of the selector will be determined
@@ -544,18 +575,8 @@ func (shared *selectorType) Add(layerAlias string, selectorAlias string, styleEn
 	// Calculate max scroll value
 	scrollBarMaxValue := len(selectionEntry.SelectionValue) - (selectorHeight * numberOfColumns) + 1
 
-	// Position scrollbar at the edge of the selector area
-	scrollBarXLocation := xLocation + (itemWidth * numberOfColumns) - 1
-	scrollBarYLocation := yLocation
-	scrollBarHeight := selectorHeight
-
-	if isBorderDrawn {
-		scrollBarXLocation = xLocation + (itemWidth * numberOfColumns) + 1
-		scrollBarYLocation = scrollBarYLocation - 1
-		scrollBarHeight = selectorHeight + 2
-	}
-
-	scrollbar.Add(layerAlias, selectorEntry.ScrollbarAlias, styleEntry, scrollBarXLocation, scrollBarYLocation, scrollBarHeight, scrollBarMaxValue, 0, numberOfColumns, false)
+	// The scroll bar is placed at the edge of the selector area once its parent information is set below.
+	scrollbar.Add(layerAlias, selectorEntry.ScrollbarAlias, styleEntry, 0, 0, 0, scrollBarMaxValue, 0, numberOfColumns, false)
 	scrollBarEntry := ScrollBars.Get(layerAlias, selectorEntry.ScrollbarAlias)
 
 	// Set parent control information for scrollbar
@@ -563,6 +584,7 @@ func (shared *selectorType) Add(layerAlias string, selectorAlias string, styleEn
 		scrollBarEntry.ParentControlAlias = selectorAlias
 		scrollBarEntry.ParentControlType = constants.CellTypeSelectorItem
 	}
+	shared.updateScrollbarBounds(layerAlias, selectorAlias)
 
 	if len(selectionEntry.SelectionValue) <= selectorHeight*numberOfColumns || styleEntry.Selector.TextAlignment == constants.AlignmentNoPadding {
 		scrollBarEntry.IsEnabled = false
@@ -927,6 +949,7 @@ func (shared *selectorType) updateKeyboardEventForSelector(layerAlias string, se
 		selectorEntry.IsNewItemSelected = true
 		isScreenUpdateRequired = true
 		isKeystrokeConsumed = true
+		fireSubmitHandler(layerAlias, selectorAlias)
 	}
 	return isScreenUpdateRequired, isKeystrokeConsumed
 }
@@ -998,6 +1021,17 @@ func (shared *selectorType) updateMouseEvent() bool {
 				return isScreenUpdateRequired
 			}
 		}
+		// Check if this selector shows the suggestions of a text field
+		if textFieldAlias := TextField.getSuggestionTrayOwner(characterEntry.LayerAlias, characterEntry.AttributeEntry.CellControlAlias); textFieldAlias != "" {
+			// Focus stays with the text field so that the user can keep typing.
+			setFocusedControl(characterEntry.LayerAlias, textFieldAlias, constants.CellTypeTextField)
+			textFieldEntry := TextFields.Get(characterEntry.LayerAlias, textFieldAlias)
+			if buttonPressed != 0 && textFieldEntry.IsSuggestionTrayOpen {
+				TextField.acceptSuggestion(characterEntry.LayerAlias, textFieldAlias, textFieldEntry, selectorEntry.ItemSelected)
+			}
+			isScreenUpdateRequired = true
+			return isScreenUpdateRequired
+		}
 		// If not part of a dropdown, set the selector as the focused control
 		setFocusedControl(characterEntry.LayerAlias, characterEntry.AttributeEntry.CellControlAlias, constants.CellTypeSelectorItem)
 		setPreviouslyHighlightedControl(characterEntry.LayerAlias, characterEntry.AttributeEntry.CellControlAlias, constants.CellTypeSelectorItem)
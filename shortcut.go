@@ -0,0 +1,404 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"strings"
+)
+
+/*
+ShortcutHandlerType is a type which represents a callback that is called when the chord of a keyboard shortcut is
+pressed. The shortcut which was pressed is passed in, so that one handler can serve several shortcuts.
+*/
+type ShortcutHandlerType func(shortcutEntry types.ShortcutEntryType)
+
+/*
+shortcutBindingType is a structure which holds a keyboard shortcut registered with the shortcut registry, along with
+the keystrokes which make up its chord and the control which must have focus for it to be used, if any.
+*/
+type shortcutBindingType struct {
+	shortcutEntry types.ShortcutEntryType
+	keystrokes    []string
+	control       controlIdentifierType
+	handler       ShortcutHandlerType
+}
+
+/*
+shortcutRegistryType is a structure which holds every keyboard shortcut registered, in the order they were added, as
+well as the keystrokes of a chord which has only been partly pressed so far.
+*/
+type shortcutRegistryType struct {
+	bindings          []shortcutBindingType
+	pendingKeystrokes []string
+}
+
+var shortcutRegistry shortcutRegistryType
+
+/*
+AddShortcut is a method which allows you to register a global keyboard shortcut, which can be used no matter which
+control has focus. In addition, the following should be noted:
+
+  - A chord can be a single keystroke such as "Ctrl+S", or several keystrokes separated by spaces such as
+    "Ctrl+K Ctrl+C". Modifiers can be written in any order, and typed characters match regardless of case. Use
+    "Space" for the space bar.
+
+  - Shortcuts only receive keystrokes which no control has used. Once the first keystroke of a longer chord has been
+    pressed, the rest of the chord is never passed on to controls, and pressing a keystroke which does not continue
+    the chord cancels it.
+
+- Shortcuts of a layer take precedence over global ones, and shortcuts of a control take precedence over both.
+
+  - If the chord is empty, or it is the same as, starts with, or is the start of another global shortcut, then a panic
+    will be generated to fail as fast as possible.
+
+Example:
+
+	AddShortcut("Ctrl+K Ctrl+C", "Comment out the selected lines", func(shortcutEntry types.ShortcutEntryType) {
+		commentOutSelection()
+	})
+*/
+func AddShortcut(chord string, description string, handler ShortcutHandlerType) {
+	shortcutRegistry.add(types.ShortcutEntryType{Chord: chord, Description: description, Scope: constants.ShortcutScopeGlobal}, controlIdentifierType{}, handler)
+}
+
+/*
+AddShortcut is a method which allows you to register a keyboard shortcut which can only be used while a control on
+the current layer, or on one of its children, has focus. Chords are written and validated the same way as for global
+shortcuts, except that only shortcuts of the same layer are checked for conflicts.
+
+Example:
+
+	layerInstance.AddShortcut("F2", "Rename the file selected", func(shortcutEntry types.ShortcutEntryType) {
+		renameFile()
+	})
+*/
+func (shared *LayerInstanceType) AddShortcut(chord string, description string, handler ShortcutHandlerType) {
+	validateLayer(shared.layerAlias)
+	shortcutRegistry.add(types.ShortcutEntryType{Chord: chord, Description: description, Scope: constants.ShortcutScopeLayer, LayerAlias: shared.layerAlias}, controlIdentifierType{}, handler)
+}
+
+/*
+AddShortcut is a method which allows you to register a keyboard shortcut which can only be used while the control
+has focus. Chords are written and validated the same way as for global shortcuts, except that only shortcuts of the
+same control are checked for conflicts. If the control instance no longer exists, then no operation takes place.
+
+Example:
+
+	textField.AddShortcut("Ctrl+E", "Open the value in an editor", func(shortcutEntry types.ShortcutEntryType) {
+		openEditor()
+	})
+*/
+func (shared *BaseControlInstanceType) AddShortcut(chord string, description string, handler ShortcutHandlerType) *BaseControlInstanceType {
+	if shared.getBaseControl() != nil {
+		shortcutEntry := types.ShortcutEntryType{Chord: chord, Description: description, Scope: constants.ShortcutScopeControl, LayerAlias: shared.layerAlias, ControlAlias: shared.controlAlias}
+		shortcutRegistry.add(shortcutEntry, shared.getFocusIdentifier(), handler)
+	}
+	return shared
+}
+
+/*
+DeleteShortcut is a method which allows you to remove a global keyboard shortcut. If no global shortcut has the chord
+provided, then no operation takes place.
+
+Example:
+
+	DeleteShortcut("Ctrl+K Ctrl+C")
+*/
+func DeleteShortcut(chord string) {
+	shortcutRegistry.delete("", "", chord)
+}
+
+/*
+DeleteShortcut is a method which allows you to remove a keyboard shortcut of the current layer. If the layer has no
+shortcut with the chord provided, then no operation takes place.
+
+Example:
+
+	layerInstance.DeleteShortcut("F2")
+*/
+func (shared *LayerInstanceType) DeleteShortcut(chord string) {
+	shortcutRegistry.delete(shared.layerAlias, "", chord)
+}
+
+/*
+DeleteShortcut is a method which allows you to remove a keyboard shortcut of a control. If the control has no
+shortcut with the chord provided, then no operation takes place.
+
+Example:
+
+	textField.DeleteShortcut("Ctrl+E")
+*/
+func (shared *BaseControlInstanceType) DeleteShortcut(chord string) *BaseControlInstanceType {
+	shortcutRegistry.delete(shared.layerAlias, shared.controlAlias, chord)
+	return shared
+}
+
+/*
+GetShortcuts is a method which allows you to obtain every keyboard shortcut registered, in the order they were added,
+such as to list them on a help screen.
+
+Example:
+
+	for _, shortcutEntry := range GetShortcuts() {
+		helpViewport.Println(shortcutEntry.Chord + "  " + shortcutEntry.Description)
+	}
+*/
+func GetShortcuts() []types.ShortcutEntryType {
+	var shortcutEntries []types.ShortcutEntryType
+	for _, binding := range shortcutRegistry.bindings {
+		shortcutEntries = append(shortcutEntries, binding.shortcutEntry)
+	}
+	return shortcutEntries
+}
+
+/*
+GetActiveShortcuts is a method which allows you to obtain the keyboard shortcuts which can be used right now, given
+the control which has focus. Shortcuts are returned from the highest precedence to the lowest, and shortcuts hidden by
+a conflicting shortcut of higher precedence are left out.
+
+Example:
+
+	activeShortcuts := GetActiveShortcuts()
+*/
+func GetActiveShortcuts() []types.ShortcutEntryType {
+	var shortcutEntries []types.ShortcutEntryType
+	for _, binding := range shortcutRegistry.getActiveBindings() {
+		shortcutEntries = append(shortcutEntries, binding.shortcutEntry)
+	}
+	return shortcutEntries
+}
+
+/*
+GetShortcutConflicts is a method which allows you to obtain the keyboard shortcuts of any scope which conflict with a
+chord, because they are the same as it, start with it, or are the start of it. This allows you to check whether a
+shortcut can be added, or which shortcuts it would hide.
+
+Example:
+
+	conflictingShortcuts := GetShortcutConflicts("Ctrl+K")
+*/
+func GetShortcutConflicts(chord string) []types.ShortcutEntryType {
+	keystrokes := getShortcutKeystrokes(chord)
+	var shortcutEntries []types.ShortcutEntryType
+	for _, binding := range shortcutRegistry.bindings {
+		if isShortcutConflicting(keystrokes, binding.keystrokes) {
+			shortcutEntries = append(shortcutEntries, binding.shortcutEntry)
+		}
+	}
+	return shortcutEntries
+}
+
+/*
+GetPendingShortcutChord is a method which allows you to obtain the keystrokes of a chord which has only been partly
+pressed so far, such as to show them on a status bar. If no chord is in progress, an empty string is returned.
+
+Example:
+
+	statusLabel.SetLabel(GetPendingShortcutChord())
+*/
+func GetPendingShortcutChord() string {
+	return strings.Join(shortcutRegistry.pendingKeystrokes, " ")
+}
+
+/*
+add is a method which allows you to register a keyboard shortcut, after making sure its chord is valid and does not
+conflict with another shortcut of the same scope.
+
+Example:
+
+	shortcutRegistry.add(shortcutEntry, controlIdentifierType{}, handler)
+*/
+func (shared *shortcutRegistryType) add(shortcutEntry types.ShortcutEntryType, control controlIdentifierType, handler ShortcutHandlerType) {
+	keystrokes := getShortcutKeystrokes(shortcutEntry.Chord)
+	validateShortcutChord(shortcutEntry.Chord, keystrokes)
+	shortcutEntry.Chord = strings.Join(keystrokes, " ")
+	for _, binding := range shared.bindings {
+		if binding.shortcutEntry.LayerAlias == shortcutEntry.LayerAlias && binding.shortcutEntry.ControlAlias == shortcutEntry.ControlAlias {
+			validateShortcutNotConflicting(shortcutEntry.Chord, binding.shortcutEntry.Chord, isShortcutConflicting(keystrokes, binding.keystrokes))
+		}
+	}
+	shared.bindings = append(shared.bindings, shortcutBindingType{shortcutEntry: shortcutEntry, keystrokes: keystrokes, control: control, handler: handler})
+}
+
+/*
+delete is a method which allows you to remove the keyboard shortcut with a given chord from a scope. Global shortcuts
+have an empty layer alias and control alias, while layer shortcuts have an empty control alias.
+
+Example:
+
+	shortcutRegistry.delete("layer1", "", "F2")
+*/
+func (shared *shortcutRegistryType) delete(layerAlias string, controlAlias string, chord string) {
+	chord = strings.Join(getShortcutKeystrokes(chord), " ")
+	for currentIndex, binding := range shared.bindings {
+		if binding.shortcutEntry.LayerAlias == layerAlias && binding.shortcutEntry.ControlAlias == controlAlias && binding.shortcutEntry.Chord == chord {
+			shared.bindings = append(shared.bindings[:currentIndex], shared.bindings[currentIndex+1:]...)
+			return
+		}
+	}
+}
+
+/*
+deleteAll is a method which allows you to remove every keyboard shortcut of a layer or control. If the control alias
+is empty, the shortcuts of the layer and all of its controls are removed.
+
+Example:
+
+	shortcutRegistry.deleteAll("layer1", "")
+*/
+func (shared *shortcutRegistryType) deleteAll(layerAlias string, controlAlias string) {
+	var remainingBindings []shortcutBindingType
+	for _, binding := range shared.bindings {
+		if binding.shortcutEntry.LayerAlias == layerAlias && (controlAlias == "" || binding.shortcutEntry.ControlAlias == controlAlias) {
+			continue
+		}
+		remainingBindings = append(remainingBindings, binding)
+	}
+	shared.bindings = remainingBindings
+}
+
+/*
+getActiveBindings is a method which allows you to obtain the keyboard shortcuts which can be used right now, from the
+highest precedence to the lowest. In addition, the following should be noted:
+
+- The shortcuts of the control with focus come first, followed by those of its layer and then each of its parents.
+
+  - Global shortcuts come last. Layer and control shortcuts are skipped while their layer is hidden or is behind a
+    modal layer.
+
+- A shortcut is left out if a shortcut which comes before it conflicts with it.
+
+Example:
+
+	activeBindings := shortcutRegistry.getActiveBindings()
+*/
+func (shared *shortcutRegistryType) getActiveBindings() []shortcutBindingType {
+	focusedControl := eventStateMemory.currentlyFocusedControl
+	var candidateBindings []shortcutBindingType
+	if focusedControl.layerAlias != "" && isLayerShown(focusedControl.layerAlias) && isLayerWithinActiveModal(focusedControl.layerAlias) {
+		for _, binding := range shared.bindings {
+			if binding.shortcutEntry.Scope == constants.ShortcutScopeControl && binding.control == focusedControl {
+				candidateBindings = append(candidateBindings, binding)
+			}
+		}
+		for layerAlias := focusedControl.layerAlias; layerAlias != "" && Layers.IsExists(layerAlias); layerAlias = Layers.Get(layerAlias).ParentAlias {
+			for _, binding := range shared.bindings {
+				if binding.shortcutEntry.Scope == constants.ShortcutScopeLayer && binding.shortcutEntry.LayerAlias == layerAlias {
+					candidateBindings = append(candidateBindings, binding)
+				}
+			}
+		}
+	}
+	for _, binding := range shared.bindings {
+		if binding.shortcutEntry.Scope == constants.ShortcutScopeGlobal {
+			candidateBindings = append(candidateBindings, binding)
+		}
+	}
+	var activeBindings []shortcutBindingType
+	for _, candidateBinding := range candidateBindings {
+		isHidden := false
+		for _, activeBinding := range activeBindings {
+			if isShortcutConflicting(candidateBinding.keystrokes, activeBinding.keystrokes) {
+				isHidden = true
+				break
+			}
+		}
+		if !isHidden {
+			activeBindings = append(activeBindings, candidateBinding)
+		}
+	}
+	return activeBindings
+}
+
+/*
+isChordInProgress is a method which allows you to detect if the first keystrokes of a chord have been pressed, so
+that the next keystroke should be given to the shortcut registry before any control.
+
+Example:
+
+	if shortcutRegistry.isChordInProgress() {
+		shortcutRegistry.updateKeyboardEvent(keystroke)
+	}
+*/
+func (shared *shortcutRegistryType) isChordInProgress() bool {
+	return len(shared.pendingKeystrokes) > 0
+}
+
+/*
+updateKeyboardEvent is a method which allows you to run the keyboard shortcut whose chord has just been completed. In
+addition, the following should be noted:
+
+  - If the keystroke, along with any keystrokes pressed before it, is the start of a longer chord, the chord is left
+    in progress and the keystroke is consumed.
+
+  - If a chord was in progress and the keystroke does not continue it, the chord is cancelled and the keystroke is
+    still consumed, so that it does not trigger anything else.
+
+- In the event that a screen update is required this method returns true.
+
+Example:
+
+	isUpdated, isConsumed := shortcutRegistry.updateKeyboardEvent(keystroke)
+*/
+func (shared *shortcutRegistryType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	if keystroke == nil {
+		return false, false
+	}
+	keystrokeShortcut := getKeystrokeShortcut(keystroke)
+	if strings.HasSuffix(keystrokeShortcut, " ") {
+		keystrokeShortcut = strings.TrimSuffix(keystrokeShortcut, " ") + "space"
+	}
+	isChordInProgress := shared.isChordInProgress()
+	pressedKeystrokes := append(append([]string{}, shared.pendingKeystrokes...), keystrokeShortcut)
+	shared.pendingKeystrokes = nil
+	for _, binding := range shared.getActiveBindings() {
+		if !isShortcutConflicting(pressedKeystrokes, binding.keystrokes) || len(pressedKeystrokes) > len(binding.keystrokes) {
+			continue
+		}
+		if len(pressedKeystrokes) < len(binding.keystrokes) {
+			shared.pendingKeystrokes = pressedKeystrokes
+			return false, true
+		}
+		if binding.handler != nil {
+			binding.handler(binding.shortcutEntry)
+		}
+		return true, true
+	}
+	return false, isChordInProgress
+}
+
+/*
+getShortcutKeystrokes is a method which allows you to split a chord, such as "Ctrl+K Ctrl+C", into the names the
+keyboard reports for each of its keystrokes.
+
+Example:
+
+	keystrokes := getShortcutKeystrokes("Ctrl+K Ctrl+C")
+*/
+func getShortcutKeystrokes(chord string) []string {
+	var keystrokes []string
+	for _, keystroke := range strings.Fields(chord) {
+		keystrokes = append(keystrokes, getNormalizedShortcut(keystroke))
+	}
+	return keystrokes
+}
+
+/*
+isShortcutConflicting is a method which allows you to detect if two chords can not be told apart while they are being
+pressed, because they are the same or one is the start of the other.
+
+Example:
+
+	isShortcutConflicting([]string{"ctrl+k"}, []string{"ctrl+k", "ctrl+c"})
+*/
+func isShortcutConflicting(keystrokes []string, otherKeystrokes []string) bool {
+	if len(keystrokes) == 0 || len(otherKeystrokes) == 0 {
+		return false
+	}
+	for currentIndex := 0; currentIndex < len(keystrokes) && currentIndex < len(otherKeystrokes); currentIndex++ {
+		if keystrokes[currentIndex] != otherKeystrokes[currentIndex] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+TestShortcutRegistry is a test which verifies that keyboard shortcuts registered globally, for a layer, or for a
+control are run when their chords are pressed, and that conflicting shortcuts are detected.
+
+Example:
+
+	Expected Inputs:
+	    A global chord of two keystrokes, a global shortcut hidden by one of a layer, a shortcut of a text field, and a
+	    global shortcut for a typed character, pressed with and without the text field focused.
+
+	Expected Outputs:
+	    Chords only run once every keystroke has been pressed, a keystroke which does not continue a chord cancels it,
+	    control and layer shortcuts take precedence over global ones, keystrokes used by a control never reach
+	    shortcuts, conflicts within a scope panic, and deleting a layer removes its shortcuts.
+*/
+func TestShortcutRegistry(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	shortcutRegistry = shortcutRegistryType{}
+	defer func() { shortcutRegistry = shortcutRegistryType{} }()
+	for KeyboardMemory.GetFromBuffer() != nil {
+	}
+	var pressedChords []string
+	shortcutHandler := func(shortcutEntry types.ShortcutEntryType) {
+		pressedChords = append(pressedChords, shortcutEntry.Chord)
+	}
+	AddShortcut("Ctrl+K Ctrl+C", "Comment", shortcutHandler)
+	AddShortcut("F1", "Help", shortcutHandler)
+	AddShortcut("?", "Help", shortcutHandler)
+	layer1.AddShortcut("F1", "Layer help", shortcutHandler)
+	textFieldInstance := layer1.AddTextField(styleEntry, 0, 0, 10, 10, false, "", true)
+	textFieldInstance.AddShortcut("Ctrl+E", "Edit", shortcutHandler)
+	setFocusedControl("", "", 0)
+
+	dispatchEvent(tcell.NewEventKey(tcell.KeyCtrlK, 0, tcell.ModCtrl))
+	assert.Equalf(test, "ctrl+k", GetPendingShortcutChord(), "The first keystroke of a chord was not left in progress!")
+	assert.Nilf(test, KeyboardMemory.GetFromBuffer(), "The first keystroke of a chord was added to the keyboard buffer!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyCtrlC, 0, tcell.ModCtrl))
+	assert.Equalf(test, []string{"ctrl+k ctrl+c"}, pressedChords, "Completing a chord did not run its shortcut!")
+	assert.Equalf(test, "", GetPendingShortcutChord(), "Completing a chord did not end it!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyCtrlK, 0, tcell.ModCtrl))
+	dispatchEvent(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone))
+	assert.Equalf(test, 1, len(pressedChords), "A keystroke which does not continue a chord ran a shortcut!")
+	assert.Nilf(test, KeyboardMemory.GetFromBuffer(), "The keystroke which cancelled a chord was added to the keyboard buffer!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyRune, '?', tcell.ModNone))
+	dispatchEvent(tcell.NewEventKey(tcell.KeyF1, 0, tcell.ModNone))
+	assert.Equalf(test, []string{"?", "f1"}, pressedChords[1:], "Global shortcuts were not run while no control had focus!")
+	assert.Equalf(test, "Help", getShortcutDescription(GetActiveShortcuts(), "f1"), "The global shortcut was not active while no control had focus!")
+
+	SetFocus(&textFieldInstance)
+	pressedChords = nil
+	dispatchEvent(tcell.NewEventKey(tcell.KeyRune, '?', tcell.ModNone))
+	assert.Equalf(test, "?", textFieldInstance.GetValue(), "A keystroke used by the text field focused was not typed into it!")
+	dispatchEvent(tcell.NewEventKey(tcell.KeyCtrlE, 0, tcell.ModCtrl))
+	dispatchEvent(tcell.NewEventKey(tcell.KeyF1, 0, tcell.ModNone))
+	assert.Equalf(test, []string{"ctrl+e", "f1"}, pressedChords, "Keystrokes used by a control reached shortcuts, or control shortcuts were not run!")
+	activeShortcuts := GetActiveShortcuts()
+	assert.Equalf(test, 4, len(activeShortcuts), "Shortcuts hidden by ones of higher precedence were listed as active!")
+	assert.Equalf(test, "Edit", activeShortcuts[0].Description, "The shortcuts of the control focused were not listed first!")
+	assert.Equalf(test, "Layer help", getShortcutDescription(activeShortcuts, "f1"), "The shortcut of the layer did not hide the global one!")
+	assert.Equalf(test, 2, len(GetShortcutConflicts("f1")), "Conflicting shortcuts of other scopes were not reported!")
+	assert.Equalf(test, 1, len(GetShortcutConflicts("Ctrl+K")), "A chord starting with the one given was not reported as conflicting!")
+
+	deleteLayer(layer1.layerAlias)
+	assert.Equalf(test, 3, len(GetShortcuts()), "Deleting a layer did not remove its shortcuts!")
+	DeleteShortcut("f1")
+	assert.Equalf(test, 2, len(GetShortcuts()), "Deleting a global shortcut did not remove it!")
+	assert.Panicsf(test, func() {
+		AddShortcut("ctrl+k", "Conflict", shortcutHandler)
+	}, "A shortcut conflicting with another of the same scope did not panic!")
+}
+
+/*
+getShortcutDescription is a method which allows you to obtain the description of the first shortcut in a list with
+a given chord, for the shortcut tests. If no shortcut has the chord, an empty string is returned.
+
+Example:
+
+	description := getShortcutDescription(GetActiveShortcuts(), "f1")
+*/
+func getShortcutDescription(shortcutEntries []types.ShortcutEntryType, chord string) string {
+	for _, shortcutEntry := range shortcutEntries {
+		if shortcutEntry.Chord == chord {
+			return shortcutEntry.Description
+		}
+	}
+	return ""
+}
@@ -0,0 +1,803 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/types"
+	"strconv"
+)
+
+/*
+SliderInstanceType is a structure which represents an instance of a slider control.
+
+Example:
+
+	var sliderInstance SliderInstanceType
+*/
+type SliderInstanceType struct {
+	BaseControlInstanceType
+}
+
+type sliderType struct{}
+
+/*
+SliderValueFormatterType is a type which represents a callback that converts a value of a slider into the text shown
+for it, such as "50%" or "3 items".
+*/
+type SliderValueFormatterType func(value int) string
+
+/*
+sliderValueFormatterEntryType is a structure which holds the value formatter assigned to a slider.
+*/
+type sliderValueFormatterEntryType struct {
+	formatter SliderValueFormatterType
+}
+
+var Slider sliderType
+
+var Sliders = memory.NewControlMemoryManager[types.SliderEntryType]()
+
+/*
+sliderValueFormatters is a variable which holds the value formatter of every slider which has one assigned, grouped by
+layer.
+*/
+var sliderValueFormatters = memory.NewControlMemoryManager[sliderValueFormatterEntryType]()
+
+/*
+Delete is a method which removes a slider instance.
+
+Example:
+
+	slider.Delete()
+*/
+func (shared *SliderInstanceType) Delete() *SliderInstanceType {
+	shared.BaseControlInstanceType.Delete()
+	return nil
+}
+
+/*
+AddToTabIndex is a method which adds the slider to the tab index of its associated layer. When the slider receives
+focus, the user can move its handle with the arrow keys.
+
+Example:
+
+	slider.AddToTabIndex()
+*/
+func (shared *SliderInstanceType) AddToTabIndex() {
+	addTabIndex(shared.layerAlias, shared.controlAlias, constants.CellTypeSlider)
+}
+
+/*
+GetValue is a method which allows you to obtain the current value of a slider. If the slider instance no longer
+exists, then a value of 0 is always returned. In addition, the following should be noted:
+
+- In range mode, the value of the lower handle is returned.
+
+Example:
+
+	value := slider.GetValue()
+*/
+func (shared *SliderInstanceType) GetValue() int {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		return Sliders.Get(shared.layerAlias, shared.controlAlias).Value
+	}
+	return 0
+}
+
+/*
+SetValue is a method which allows you to set the current value of a slider. If the slider instance no longer exists,
+then no operation takes place. In addition, the following should be noted:
+
+- The value is snapped to the nearest step of the slider, and clamped to its minimum or maximum.
+
+- In range mode, the value of the lower handle is set, and is not allowed to pass the upper handle.
+
+Example:
+
+	slider.SetValue(42)
+*/
+func (shared *SliderInstanceType) SetValue(value int) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		Slider.setHandleValue(shared.layerAlias, shared.controlAlias, constants.SliderHandleLower, value)
+	}
+	return shared
+}
+
+/*
+SetRange is a method which allows you to change the smallest and largest values a slider can be set to, along with
+how much the value changes with each step. If the slider instance no longer exists, then no operation takes place. In
+addition, the following should be noted:
+
+- The current values are snapped and clamped to the new range.
+
+  - If the minimum value is not less than the maximum value, or the step is less than or equal to 0, a panic will be
+    generated.
+
+Example:
+
+	slider.SetRange(0, 255, 5)
+*/
+func (shared *SliderInstanceType) SetRange(minimumValue int, maximumValue int, step int) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		validateSliderRange(minimumValue, maximumValue, step)
+		sliderEntry := Sliders.Get(shared.layerAlias, shared.controlAlias)
+		sliderEntry.MinimumValue = minimumValue
+		sliderEntry.MaximumValue = maximumValue
+		sliderEntry.Step = step
+		sliderEntry.UpperValue = Slider.getSnappedValue(sliderEntry, sliderEntry.UpperValue)
+		Slider.setHandleValue(shared.layerAlias, shared.controlAlias, constants.SliderHandleLower, sliderEntry.Value)
+	}
+	return shared
+}
+
+/*
+SetRangeMode is a method which allows you to turn range mode on or off for a slider. If the slider instance no longer
+exists, then no operation takes place. In addition, the following should be noted:
+
+  - In range mode, the slider has a lower and an upper handle, and the part of the track between them is filled. The
+    handles can never pass each other.
+
+  - When range mode is turned on, the upper handle keeps the value it last had, or is moved to the lower handle if it
+    would otherwise be below it.
+
+Example:
+
+	slider.SetRangeMode(true)
+*/
+func (shared *SliderInstanceType) SetRangeMode(isRangeMode bool) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		sliderEntry := Sliders.Get(shared.layerAlias, shared.controlAlias)
+		sliderEntry.IsRangeMode = isRangeMode
+		sliderEntry.ActiveHandle = constants.SliderHandleLower
+		if sliderEntry.UpperValue < sliderEntry.Value {
+			sliderEntry.UpperValue = sliderEntry.Value
+		}
+	}
+	return shared
+}
+
+/*
+GetSelectedRange is a method which allows you to obtain the values of the lower and upper handles of a slider in
+range mode. If the slider instance no longer exists, then values of 0 are always returned.
+
+Example:
+
+	lowerValue, upperValue := slider.GetSelectedRange()
+*/
+func (shared *SliderInstanceType) GetSelectedRange() (int, int) {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		sliderEntry := Sliders.Get(shared.layerAlias, shared.controlAlias)
+		return sliderEntry.Value, sliderEntry.UpperValue
+	}
+	return 0, 0
+}
+
+/*
+SetSelectedRange is a method which allows you to set the values of the lower and upper handles of a slider in range
+mode. If the slider instance no longer exists, then no operation takes place. In addition, the following should be
+noted:
+
+- Both values are snapped to the nearest step of the slider, and clamped to its minimum or maximum.
+
+- If the lower value is greater than the upper value, the two are swapped.
+
+Example:
+
+	slider.SetSelectedRange(20, 80)
+*/
+func (shared *SliderInstanceType) SetSelectedRange(lowerValue int, upperValue int) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		if lowerValue > upperValue {
+			lowerValue, upperValue = upperValue, lowerValue
+		}
+		sliderEntry := Sliders.Get(shared.layerAlias, shared.controlAlias)
+		sliderEntry.Value = Slider.getSnappedValue(sliderEntry, lowerValue)
+		sliderEntry.UpperValue = Slider.getSnappedValue(sliderEntry, upperValue)
+	}
+	return shared
+}
+
+/*
+SetTickMarks is a method which allows you to show tick marks alongside the track of a slider. If the slider instance
+no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- A tick mark is drawn at the minimum value, and at every multiple of the interval above it.
+
+  - If labels are shown, the value of each tick mark is written next to it. Labels which would overlap the one
+    before them are skipped.
+
+- Tick marks are drawn below a horizontal slider, and to the right of a vertical one.
+
+- An interval of 0 or less hides the tick marks.
+
+Example:
+
+	slider.SetTickMarks(25, true)
+*/
+func (shared *SliderInstanceType) SetTickMarks(interval int, isLabelShown bool) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		sliderEntry := Sliders.Get(shared.layerAlias, shared.controlAlias)
+		sliderEntry.TickInterval = interval
+		sliderEntry.IsTickLabelShown = isLabelShown
+	}
+	return shared
+}
+
+/*
+SetValueShown is a method which allows you to show the current value of a slider next to it. If the slider instance
+no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- The value is shown to the right of a horizontal slider, and below a vertical one.
+
+- In range mode, the values of both handles are shown.
+
+Example:
+
+	slider.SetValueShown(true)
+*/
+func (shared *SliderInstanceType) SetValueShown(isValueShown bool) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		Sliders.Get(shared.layerAlias, shared.controlAlias).IsValueShown = isValueShown
+	}
+	return shared
+}
+
+/*
+SetValueFormatter is a method which allows you to control how the values of a slider are written, both in its value
+readout and in the labels of its tick marks. If the slider instance no longer exists, then no operation takes place.
+In addition, the following should be noted:
+
+- Registering a new formatter replaces any formatter registered previously. Passing in nil removes it.
+
+Example:
+
+	slider.SetValueFormatter(func(value int) string {
+		return fmt.Sprintf("%d%%", value)
+	})
+*/
+func (shared *SliderInstanceType) SetValueFormatter(formatter SliderValueFormatterType) *SliderInstanceType {
+	if Sliders.IsExists(shared.layerAlias, shared.controlAlias) {
+		deleteSliderValueFormatter(shared.layerAlias, shared.controlAlias)
+		if formatter != nil {
+			formatterEntry := sliderValueFormatterEntryType{formatter: formatter}
+			sliderValueFormatters.Add(shared.layerAlias, shared.controlAlias, &formatterEntry)
+		}
+	}
+	return shared
+}
+
+/*
+Add is a method which adds a slider to a given text layer. A slider lets the user pick a whole number from a range by
+moving a handle along a track. Once called, an instance of your control is returned which will allow you to read or
+manipulate the properties for it. In addition, the following should be noted:
+
+- The length is the number of cells the track occupies, and must be at least 2.
+
+- The minimum value of a vertical slider is at the bottom of its track.
+
+  - The value can be changed with the arrow keys, page up and page down, home and end, by scrolling the mouse wheel
+    over the slider, or by clicking or dragging along its track. Clicking the track moves the nearest handle to it.
+
+- In range mode, space switches which handle the keyboard moves.
+
+- Changes to the value can be watched with OnChange, just like any other control.
+
+  - If the minimum value is not less than the maximum value, or the step is less than or equal to 0, a panic will be
+    generated.
+
+Example:
+
+	sliderInstance := Slider.Add("layer1", "slider1", style, 5, 5, 20, 0, 100, 5, 50, true, true)
+*/
+func (shared *sliderType) Add(layerAlias string, sliderAlias string, styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, length int, minimumValue int, maximumValue int, step int, defaultValue int, isHorizontal bool, isEnabled bool) SliderInstanceType {
+	validateSliderLength(length)
+	validateSliderRange(minimumValue, maximumValue, step)
+	sliderEntry := types.NewSliderEntry()
+	sliderEntry.Alias = sliderAlias
+	sliderEntry.StyleEntry = styleEntry
+	sliderEntry.XLocation = xLocation
+	sliderEntry.YLocation = yLocation
+	sliderEntry.Length = length
+	if isHorizontal {
+		sliderEntry.Width = length
+		sliderEntry.Height = 1
+	} else {
+		sliderEntry.Width = 1
+		sliderEntry.Height = length
+	}
+	sliderEntry.MinimumValue = minimumValue
+	sliderEntry.MaximumValue = maximumValue
+	sliderEntry.Step = step
+	sliderEntry.IsHorizontal = isHorizontal
+	sliderEntry.IsEnabled = isEnabled
+	sliderEntry.Value = shared.getSnappedValue(&sliderEntry, defaultValue)
+	sliderEntry.UpperValue = maximumValue
+	Sliders.Add(layerAlias, sliderAlias, &sliderEntry)
+	var sliderInstance SliderInstanceType
+	sliderInstance.layerAlias = layerAlias
+	sliderInstance.controlAlias = sliderAlias
+	sliderInstance.controlType = constants.TYPE_SLIDER
+	return sliderInstance
+}
+
+/*
+Delete is a method which removes a slider from a text layer. In addition, the following should be noted:
+
+- If you attempt to delete a slider which does not exist, then the request will simply be ignored.
+
+Example:
+
+	Slider.Delete("layer1", "slider1")
+*/
+func (shared *sliderType) Delete(layerAlias string, sliderAlias string) {
+	if Sliders.IsExists(layerAlias, sliderAlias) {
+		Sliders.Remove(layerAlias, sliderAlias)
+	}
+	deleteSliderValueFormatter(layerAlias, sliderAlias)
+}
+
+/*
+DeleteAll is a method which deletes all sliders on a given text layer.
+
+Example:
+
+	Slider.DeleteAll("layer1")
+*/
+func (shared *sliderType) DeleteAll(layerAlias string) {
+	Sliders.RemoveAll(layerAlias)
+	sliderValueFormatters.RemoveAll(layerAlias)
+}
+
+/*
+deleteSliderValueFormatter is a method which allows you to remove the value formatter assigned to a slider, if any.
+
+Example:
+
+	deleteSliderValueFormatter("layer1", "slider1")
+*/
+func deleteSliderValueFormatter(layerAlias string, sliderAlias string) {
+	if sliderValueFormatters.IsExists(layerAlias, sliderAlias) {
+		sliderValueFormatters.Remove(layerAlias, sliderAlias)
+	}
+}
+
+/*
+drawOnLayer is a method which draws all sliders on a given text layer.
+
+Example:
+
+	Slider.drawOnLayer(myLayer)
+*/
+func (shared *sliderType) drawOnLayer(layerEntry types.LayerEntryType) {
+	layerAlias := layerEntry.LayerAlias
+	for _, sliderEntry := range Sliders.GetAllEntries(layerAlias) {
+		shared.draw(&layerEntry, layerAlias, sliderEntry)
+	}
+}
+
+/*
+draw is a method which draws a slider on a given text layer, along with its tick marks and value readout if they are
+shown. The style of the slider will be determined by the slider style of its style entry. In addition, the following
+should be noted:
+
+  - Each cell of the track stores its position along the track, counted from the minimum value, so that mouse clicks
+    can be converted back into values. Tick marks and the value readout store no position.
+
+  - When a slider in range mode is focused, the handle moved by the keyboard is drawn with its colors reversed.
+
+Example:
+
+	Slider.draw(&myLayer, "layer1", sliderEntry)
+*/
+func (shared *sliderType) draw(layerEntry *types.LayerEntryType, layerAlias string, sliderEntry *types.SliderEntryType) {
+	localStyleEntry := types.NewTuiStyleEntry(&sliderEntry.StyleEntry)
+	sliderStyle := localStyleEntry.Slider
+	isFocused := eventStateMemory.currentlyFocusedControl.layerAlias == layerAlias &&
+		eventStateMemory.currentlyFocusedControl.controlAlias == sliderEntry.Alias
+	lowerPosition := shared.getPositionByValue(sliderEntry, sliderEntry.Value)
+	upperPosition := lowerPosition
+	filledStartPosition := 0
+	if sliderEntry.IsRangeMode {
+		upperPosition = shared.getPositionByValue(sliderEntry, sliderEntry.UpperValue)
+		filledStartPosition = lowerPosition
+	}
+	attributeEntry := types.NewAttributeEntry()
+	attributeEntry.CellType = constants.CellTypeSlider
+	attributeEntry.CellControlAlias = sliderEntry.Alias
+	for currentPosition := 0; currentPosition < sliderEntry.Length; currentPosition++ {
+		character := sliderStyle.TrackPattern
+		attributeEntry.ForegroundColor = sliderStyle.ForegroundColor
+		attributeEntry.BackgroundColor = sliderStyle.BackgroundColor
+		if currentPosition == lowerPosition || currentPosition == upperPosition {
+			character = sliderStyle.Handle
+			attributeEntry.ForegroundColor = sliderStyle.HandleColor
+			isActiveHandle := (currentPosition == lowerPosition && sliderEntry.ActiveHandle == constants.SliderHandleLower) ||
+				(currentPosition == upperPosition && sliderEntry.ActiveHandle == constants.SliderHandleUpper)
+			if sliderEntry.IsRangeMode && isFocused && isActiveHandle {
+				attributeEntry.ForegroundColor = sliderStyle.BackgroundColor
+				attributeEntry.BackgroundColor = sliderStyle.HandleColor
+			}
+		} else if currentPosition >= filledStartPosition && currentPosition < upperPosition {
+			character = sliderStyle.FilledPattern
+			attributeEntry.ForegroundColor = sliderStyle.FilledColor
+		}
+		attributeEntry.CellControlId = currentPosition
+		xLocation, yLocation := shared.getLocationByPosition(sliderEntry, currentPosition)
+		printLayer(layerEntry, attributeEntry, xLocation, yLocation, []rune{character})
+	}
+
+	attributeEntry.ForegroundColor = sliderStyle.ForegroundColor
+	attributeEntry.BackgroundColor = sliderStyle.BackgroundColor
+	attributeEntry.CellControlId = constants.NullCellId
+	if sliderEntry.TickInterval > 0 {
+		// Labels are placed one after another, so we track where the last one ended to avoid overlapping it.
+		nextFreeLabelLocation := -1
+		for tickValue := sliderEntry.MinimumValue; tickValue <= sliderEntry.MaximumValue; tickValue += sliderEntry.TickInterval {
+			tickPosition := shared.getPositionByValue(sliderEntry, tickValue)
+			xLocation, yLocation := shared.getLocationByPosition(sliderEntry, tickPosition)
+			label := []rune(shared.getFormattedValue(layerAlias, sliderEntry.Alias, tickValue))
+			if sliderEntry.IsHorizontal {
+				printLayer(layerEntry, attributeEntry, xLocation, yLocation+1, []rune{sliderStyle.TickMark})
+				labelXLocation := xLocation - len(label)/2
+				if sliderEntry.IsTickLabelShown && labelXLocation >= nextFreeLabelLocation {
+					printLayer(layerEntry, attributeEntry, labelXLocation, yLocation+2, label)
+					nextFreeLabelLocation = labelXLocation + len(label) + 1
+				}
+			} else {
+				printLayer(layerEntry, attributeEntry, xLocation+1, yLocation, []rune{sliderStyle.TickMark})
+				// Vertical sliders count upwards, so each label must be above the last one drawn.
+				if sliderEntry.IsTickLabelShown && (nextFreeLabelLocation == -1 || yLocation < nextFreeLabelLocation) {
+					printLayer(layerEntry, attributeEntry, xLocation+3, yLocation, label)
+					nextFreeLabelLocation = yLocation
+				}
+			}
+		}
+	}
+	if sliderEntry.IsValueShown {
+		readout := shared.getFormattedValue(layerAlias, sliderEntry.Alias, sliderEntry.Value)
+		if sliderEntry.IsRangeMode {
+			readout = readout + "-" + shared.getFormattedValue(layerAlias, sliderEntry.Alias, sliderEntry.UpperValue)
+		}
+		if sliderEntry.IsHorizontal {
+			printLayer(layerEntry, attributeEntry, sliderEntry.XLocation+sliderEntry.Length+1, sliderEntry.YLocation, []rune(readout))
+		} else {
+			printLayer(layerEntry, attributeEntry, sliderEntry.XLocation, sliderEntry.YLocation+sliderEntry.Length, []rune(readout))
+		}
+	}
+}
+
+/*
+getFormattedValue is a method which allows you to obtain a value of a slider as it should be shown, using the value
+formatter of the slider if one has been assigned.
+
+Example:
+
+	valueAsString := Slider.getFormattedValue("layer1", "slider1", 50)
+*/
+func (shared *sliderType) getFormattedValue(layerAlias string, sliderAlias string, value int) string {
+	if sliderValueFormatters.IsExists(layerAlias, sliderAlias) {
+		return sliderValueFormatters.Get(layerAlias, sliderAlias).formatter(value)
+	}
+	return strconv.Itoa(value)
+}
+
+/*
+getLocationByPosition is a method which allows you to obtain the screen location of a position along the track of a
+slider. Position 0 is the minimum value, which is the leftmost cell of a horizontal slider and the bottom cell of a
+vertical one.
+
+Example:
+
+	xLocation, yLocation := Slider.getLocationByPosition(sliderEntry, 3)
+*/
+func (shared *sliderType) getLocationByPosition(sliderEntry *types.SliderEntryType, position int) (int, int) {
+	if sliderEntry.IsHorizontal {
+		return sliderEntry.XLocation + position, sliderEntry.YLocation
+	}
+	return sliderEntry.XLocation, sliderEntry.YLocation + sliderEntry.Length - 1 - position
+}
+
+/*
+getPositionByValue is a method which allows you to obtain the position along the track of a slider which represents a
+given value. The same math is used as for the handle of a scroll bar.
+
+Example:
+
+	position := Slider.getPositionByValue(sliderEntry, 50)
+*/
+func (shared *sliderType) getPositionByValue(sliderEntry *types.SliderEntryType, value int) int {
+	return getTrackPositionByValue(value-sliderEntry.MinimumValue, sliderEntry.MaximumValue-sliderEntry.MinimumValue, sliderEntry.Length-1)
+}
+
+/*
+getValueByPosition is a method which allows you to obtain the value of a slider which corresponds to a position
+along its track, snapped to the nearest step.
+
+Example:
+
+	value := Slider.getValueByPosition(sliderEntry, 3)
+*/
+func (shared *sliderType) getValueByPosition(sliderEntry *types.SliderEntryType, position int) int {
+	value := sliderEntry.MinimumValue + getValueByTrackPosition(position, sliderEntry.Length-1, sliderEntry.Length-1, sliderEntry.MaximumValue-sliderEntry.MinimumValue)
+	return shared.getSnappedValue(sliderEntry, value)
+}
+
+/*
+getSnappedValue is a method which allows you to obtain the value nearest to the one given which a slider can be set
+to. Values are clamped to the range of the slider and rounded to the nearest step above its minimum. In addition, the
+following should be noted:
+
+  - The maximum value can always be reached, even if the range of the slider is not a multiple of its step.
+
+Example:
+
+	value := Slider.getSnappedValue(sliderEntry, 42)
+*/
+func (shared *sliderType) getSnappedValue(sliderEntry *types.SliderEntryType, value int) int {
+	if value <= sliderEntry.MinimumValue {
+		return sliderEntry.MinimumValue
+	}
+	if value >= sliderEntry.MaximumValue {
+		return sliderEntry.MaximumValue
+	}
+	value = sliderEntry.MinimumValue + getRoundedQuotient(value-sliderEntry.MinimumValue, sliderEntry.Step)*sliderEntry.Step
+	if value > sliderEntry.MaximumValue {
+		return sliderEntry.MaximumValue
+	}
+	return value
+}
+
+/*
+getRoundedQuotient is a method which allows you to divide one non-negative whole number by another, rounding the
+result to the nearest whole number instead of truncating it.
+
+Example:
+
+	quotient := getRoundedQuotient(7, 2)
+*/
+func getRoundedQuotient(dividend int, divisor int) int {
+	return (dividend*2 + divisor) / (divisor * 2)
+}
+
+/*
+setHandleValue is a method which allows you to set the value of one of the handles of a slider. The value is snapped
+to the nearest step and clamped to the range of the slider. In range mode, a handle is also stopped from passing the
+other handle.
+
+Example:
+
+	Slider.setHandleValue("layer1", "slider1", constants.SliderHandleLower, 42)
+*/
+func (shared *sliderType) setHandleValue(layerAlias string, sliderAlias string, handle int, value int) {
+	sliderEntry := Sliders.Get(layerAlias, sliderAlias)
+	value = shared.getSnappedValue(sliderEntry, value)
+	if handle == constants.SliderHandleUpper {
+		if value < sliderEntry.Value {
+			value = sliderEntry.Value
+		}
+		sliderEntry.UpperValue = value
+		return
+	}
+	if sliderEntry.IsRangeMode && value > sliderEntry.UpperValue {
+		value = sliderEntry.UpperValue
+	}
+	sliderEntry.Value = value
+}
+
+/*
+getHandleValue is a method which allows you to obtain the value of one of the handles of a slider.
+
+Example:
+
+	value := Slider.getHandleValue(sliderEntry, constants.SliderHandleUpper)
+*/
+func (shared *sliderType) getHandleValue(sliderEntry *types.SliderEntryType, handle int) int {
+	if handle == constants.SliderHandleUpper {
+		return sliderEntry.UpperValue
+	}
+	return sliderEntry.Value
+}
+
+/*
+getNearestHandle is a method which allows you to find which handle of a slider is closest to a position along its
+track. Outside of range mode, this is always the lower handle. When both handles are at the same position, the upper
+handle is picked unless they are at the very end of the track, so that the handle picked is always free to move.
+
+Example:
+
+	handle := Slider.getNearestHandle(sliderEntry, 3)
+*/
+func (shared *sliderType) getNearestHandle(sliderEntry *types.SliderEntryType, position int) int {
+	if !sliderEntry.IsRangeMode {
+		return constants.SliderHandleLower
+	}
+	lowerDistance := position - shared.getPositionByValue(sliderEntry, sliderEntry.Value)
+	upperDistance := shared.getPositionByValue(sliderEntry, sliderEntry.UpperValue) - position
+	if lowerDistance < 0 {
+		return constants.SliderHandleLower
+	}
+	if upperDistance < 0 || upperDistance < lowerDistance {
+		return constants.SliderHandleUpper
+	}
+	if upperDistance == 0 && lowerDistance == 0 && position < sliderEntry.Length-1 {
+		return constants.SliderHandleUpper
+	}
+	return constants.SliderHandleLower
+}
+
+/*
+stepValue is a method which allows you to move the active handle of a slider by a number of steps. A positive number
+of steps increases the value, while a negative number decreases it. If the slider is disabled, false is returned and
+the value is left unchanged.
+
+Example:
+
+	isUpdateRequired := Slider.stepValue("layer1", "slider1", -1)
+*/
+func (shared *sliderType) stepValue(layerAlias string, sliderAlias string, numberOfSteps int) bool {
+	sliderEntry := Sliders.Get(layerAlias, sliderAlias)
+	if !sliderEntry.IsEnabled {
+		return false
+	}
+	value := shared.getHandleValue(sliderEntry, sliderEntry.ActiveHandle) + numberOfSteps*sliderEntry.Step
+	shared.setHandleValue(layerAlias, sliderAlias, sliderEntry.ActiveHandle, value)
+	return true
+}
+
+/*
+getPageSteps is a method which allows you to obtain how many steps page up and page down move the handle of a
+slider. This is roughly a tenth of its range, and always at least one step.
+
+Example:
+
+	numberOfSteps := Slider.getPageSteps(sliderEntry)
+*/
+func (shared *sliderType) getPageSteps(sliderEntry *types.SliderEntryType) int {
+	numberOfSteps := (sliderEntry.MaximumValue - sliderEntry.MinimumValue) / sliderEntry.Step / 10
+	if numberOfSteps < 1 {
+		return 1
+	}
+	return numberOfSteps
+}
+
+/*
+updateKeyboardEvent is a method which updates the state of the focused slider according to the current keyboard
+event. In addition, the following should be noted:
+
+- Right and up increase the value by one step, while left and down decrease it.
+
+- Page up and page down move the handle by roughly a tenth of the range.
+
+- Home and end move the handle to the minimum and maximum values.
+
+- In range mode, space switches which handle the keyboard moves.
+
+Example:
+
+	isUpdate, isConsumed := Slider.updateKeyboardEvent(keystroke)
+*/
+func (shared *sliderType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	if focusedControlType != constants.CellTypeSlider || !Sliders.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false, false
+	}
+	sliderEntry := Sliders.Get(focusedLayerAlias, focusedControlAlias)
+	if !sliderEntry.IsEnabled {
+		return false, false
+	}
+	switch string(keystroke) {
+	case "right", "up":
+		return shared.stepValue(focusedLayerAlias, focusedControlAlias, 1), true
+	case "left", "down":
+		return shared.stepValue(focusedLayerAlias, focusedControlAlias, -1), true
+	case "pgup":
+		return shared.stepValue(focusedLayerAlias, focusedControlAlias, shared.getPageSteps(sliderEntry)), true
+	case "pgdn":
+		return shared.stepValue(focusedLayerAlias, focusedControlAlias, -shared.getPageSteps(sliderEntry)), true
+	case "home":
+		shared.setHandleValue(focusedLayerAlias, focusedControlAlias, sliderEntry.ActiveHandle, sliderEntry.MinimumValue)
+		return true, true
+	case "end":
+		shared.setHandleValue(focusedLayerAlias, focusedControlAlias, sliderEntry.ActiveHandle, sliderEntry.MaximumValue)
+		return true, true
+	case " ":
+		if !sliderEntry.IsRangeMode {
+			return false, false
+		}
+		if sliderEntry.ActiveHandle == constants.SliderHandleLower {
+			sliderEntry.ActiveHandle = constants.SliderHandleUpper
+		} else {
+			sliderEntry.ActiveHandle = constants.SliderHandleLower
+		}
+		return true, true
+	}
+	return false, false
+}
+
+/*
+updateMouseEvent is a method which updates the state of all sliders according to the current mouse event. In
+addition, the following should be noted:
+
+- Scrolling the mouse wheel over a slider moves its active handle by one step.
+
+  - Clicking the track moves the nearest handle to the position clicked. Holding the button down then drags the
+    handle, in the same way that the handle of a scroll bar is dragged.
+
+Example:
+
+	isUpdateRequired := Slider.updateMouseEvent()
+*/
+func (shared *sliderType) updateMouseEvent() bool {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	mouseXLocation, mouseYLocation, buttonPressed, wheelState := GetMouseStatus()
+	previousMouseXLocation, previousMouseYLocation, previousButtonPressed, _ := GetPreviousMouseStatus()
+	if buttonPressed == 0 {
+		if eventStateMemory.stateId == constants.EventStateDragAndDropScrollbar && focusedControlType == constants.CellTypeSlider {
+			eventStateMemory.stateId = constants.EventStateNone
+		}
+	} else if previousButtonPressed != 0 && eventStateMemory.stateId == constants.EventStateDragAndDropScrollbar {
+		if focusedControlType != constants.CellTypeSlider || !Sliders.IsExists(focusedLayerAlias, focusedControlAlias) {
+			return false
+		}
+		sliderEntry := Sliders.Get(focusedLayerAlias, focusedControlAlias)
+		// Vertical sliders count upwards, so moving the mouse down moves the handle towards the minimum.
+		if sliderEntry.IsHorizontal {
+			sliderEntry.DragPosition = sliderEntry.DragPosition + mouseXLocation - previousMouseXLocation
+		} else {
+			sliderEntry.DragPosition = sliderEntry.DragPosition - (mouseYLocation - previousMouseYLocation)
+		}
+		if sliderEntry.DragPosition < 0 {
+			sliderEntry.DragPosition = 0
+		}
+		if sliderEntry.DragPosition > sliderEntry.Length-1 {
+			sliderEntry.DragPosition = sliderEntry.Length - 1
+		}
+		shared.setHandleValue(focusedLayerAlias, focusedControlAlias, sliderEntry.ActiveHandle, shared.getValueByPosition(sliderEntry, sliderEntry.DragPosition))
+		return true
+	}
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	layerAlias := characterEntry.LayerAlias
+	sliderAlias := characterEntry.AttributeEntry.CellControlAlias
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeSlider || !Sliders.IsExists(layerAlias, sliderAlias) {
+		return false
+	}
+	if wheelState == "Up" {
+		return shared.stepValue(layerAlias, sliderAlias, 1)
+	} else if wheelState == "Down" {
+		return shared.stepValue(layerAlias, sliderAlias, -1)
+	}
+	if buttonPressed == 0 || previousButtonPressed != 0 {
+		return false
+	}
+	setFocusedControl(layerAlias, sliderAlias, constants.CellTypeSlider)
+	sliderEntry := Sliders.Get(layerAlias, sliderAlias)
+	position := characterEntry.AttributeEntry.CellControlId
+	if !sliderEntry.IsEnabled || position == constants.NullCellId {
+		return true
+	}
+	sliderEntry.ActiveHandle = shared.getNearestHandle(sliderEntry, position)
+	sliderEntry.DragPosition = position
+	shared.setHandleValue(layerAlias, sliderAlias, sliderEntry.ActiveHandle, shared.getValueByPosition(sliderEntry, position))
+	eventStateMemory.stateId = constants.EventStateDragAndDropScrollbar
+	return true
+}
+
+/*
+getSliderState is a method which allows you to obtain the values of a slider as a single string, so that any change
+to them can be detected.
+
+Example:
+
+	state := Slider.getSliderState(sliderEntry)
+*/
+func (shared *sliderType) getSliderState(sliderEntry *types.SliderEntryType) string {
+	if sliderEntry.IsRangeMode {
+		return fmt.Sprintf("%d-%d", sliderEntry.Value, sliderEntry.UpperValue)
+	}
+	return fmt.Sprintf("%d", sliderEntry.Value)
+}
@@ -0,0 +1,125 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+TestSliderKeyboard is a test which verifies that the handle of a slider can be moved with the keyboard, and that its
+value is always snapped to a step and kept within the range of the slider.
+
+Example:
+
+	Expected Inputs:
+	    A slider from 0 to 100 in steps of 5, moved with the arrow keys, page keys, home and end, and then switched to
+	    range mode.
+
+	Expected Outputs:
+	    Each key moves the handle by the expected amount without going past the range, values set programmatically are
+	    snapped to a step, and in range mode space switches handles which can never pass each other.
+*/
+func TestSliderKeyboard(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	sliderInstance := layer1.AddSlider(styleEntry, 2, 2, 21, 0, 100, 5, 50, true, true)
+	sliderInstance.GetFocus()
+	assert.Equalf(test, constants.CellTypeSlider, eventStateMemory.currentlyFocusedControl.controlType, "Focusing the slider did not focus it!")
+	Slider.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 55, sliderInstance.GetValue(), "The right arrow did not increase the value by one step!")
+	Slider.updateKeyboardEvent([]rune("down"))
+	Slider.updateKeyboardEvent([]rune("down"))
+	assert.Equalf(test, 45, sliderInstance.GetValue(), "The down arrow did not decrease the value by one step!")
+	Slider.updateKeyboardEvent([]rune("pgup"))
+	assert.Equalf(test, 55, sliderInstance.GetValue(), "Page up did not move the value by a tenth of the range!")
+	Slider.updateKeyboardEvent([]rune("end"))
+	Slider.updateKeyboardEvent([]rune("up"))
+	assert.Equalf(test, 100, sliderInstance.GetValue(), "The value went past the maximum!")
+	Slider.updateKeyboardEvent([]rune("home"))
+	assert.Equalf(test, 0, sliderInstance.GetValue(), "Home did not move the value to the minimum!")
+	sliderInstance.SetValue(42)
+	assert.Equalf(test, 40, sliderInstance.GetValue(), "A value was not snapped to the nearest step!")
+
+	sliderInstance.SetRangeMode(true)
+	sliderInstance.SetSelectedRange(80, 20)
+	lowerValue, upperValue := sliderInstance.GetSelectedRange()
+	assert.Equalf(test, []int{20, 80}, []int{lowerValue, upperValue}, "A reversed range was not swapped!")
+	Slider.updateKeyboardEvent([]rune(" "))
+	Slider.updateKeyboardEvent([]rune("home"))
+	lowerValue, upperValue = sliderInstance.GetSelectedRange()
+	assert.Equalf(test, []int{20, 20}, []int{lowerValue, upperValue}, "The upper handle passed the lower handle!")
+	Slider.updateKeyboardEvent([]rune(" "))
+	Slider.updateKeyboardEvent([]rune("end"))
+	lowerValue, upperValue = sliderInstance.GetSelectedRange()
+	assert.Equalf(test, []int{20, 20}, []int{lowerValue, upperValue}, "The lower handle passed the upper handle!")
+
+	sliderInstance.SetRange(0, 10, 3)
+	lowerValue, upperValue = sliderInstance.GetSelectedRange()
+	assert.Equalf(test, []int{10, 10}, []int{lowerValue, upperValue}, "The values were not clamped to a new range!")
+	assert.Panicsf(test, func() { sliderInstance.SetRange(10, 10, 1) }, "A range whose minimum is not below its maximum did not panic!")
+	assert.Panicsf(test, func() { layer1.AddSlider(styleEntry, 2, 2, 1, 0, 10, 1, 0, true, true) }, "A slider too short to have a track did not panic!")
+}
+
+/*
+TestSliderMouse is a test which verifies that the handle of a slider can be clicked, dragged, or scrolled with the
+mouse, and that changes are reported through the change handler.
+
+Example:
+
+	Expected Inputs:
+	    A horizontal slider with tick marks and a value readout, clicked and dragged along its track, and a vertical
+	    slider in range mode which is clicked near its upper handle and scrolled with the mouse wheel.
+
+	Expected Outputs:
+	    The tick marks, labels, and readout are drawn next to the track, the nearest handle follows the mouse, and the
+	    change handler is called whenever the value changes.
+*/
+func TestSliderMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	sliderInstance := layer1.AddSlider(styleEntry, 2, 2, 11, 0, 100, 10, 0, true, true)
+	sliderInstance.SetTickMarks(50, true)
+	sliderInstance.SetValueShown(true)
+	sliderInstance.SetValueFormatter(func(value int) string { return fmt.Sprintf("%d%%", value) })
+	numberOfChanges := 0
+	sliderInstance.OnChange(func(control *BaseControlInstanceType) { numberOfChanges++ })
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	assert.Equalf(test, styleEntry.Slider.Handle, layerEntry.CharacterMemory[2][2].Character, "The handle was not drawn at the minimum!")
+	assert.Equalf(test, styleEntry.Slider.TickMark, layerEntry.CharacterMemory[3][7].Character, "A tick mark was not drawn below the middle of the track!")
+	assert.Equalf(test, "50%", string([]rune{layerEntry.CharacterMemory[4][6].Character, layerEntry.CharacterMemory[4][7].Character, layerEntry.CharacterMemory[4][8].Character}), "A tick label was not drawn below its tick mark!")
+	assert.Equalf(test, '0', layerEntry.CharacterMemory[2][14].Character, "The value readout was not drawn after the track!")
+
+	controlStates := getControlStatesForChangeHandlers()
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(7, 2, 1, "")
+	Slider.updateMouseEvent()
+	assert.Equalf(test, 50, sliderInstance.GetValue(), "Clicking the track did not move the handle to it!")
+	assert.Equalf(test, sliderInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Clicking the track did not focus the slider!")
+	SetMouseStatus(9, 2, 1, "")
+	Slider.updateMouseEvent()
+	assert.Equalf(test, 70, sliderInstance.GetValue(), "Dragging the handle did not move it!")
+	SetMouseStatus(9, 2, 0, "")
+	Slider.updateMouseEvent()
+	assert.Equalf(test, constants.EventStateNone, eventStateMemory.stateId, "Releasing the button did not stop dragging the handle!")
+	fireChangeHandlers(controlStates)
+	assert.Equalf(test, 1, numberOfChanges, "The change handler was not called when the value changed!")
+
+	verticalSliderInstance := layer1.AddSlider(styleEntry, 30, 5, 5, 0, 4, 1, 1, false, true)
+	verticalSliderInstance.SetRangeMode(true)
+	verticalSliderInstance.SetSelectedRange(1, 3)
+	UpdateDisplay(false)
+	SetMouseStatus(30, 5, 0, "")
+	SetMouseStatus(30, 5, 1, "")
+	Slider.updateMouseEvent()
+	lowerValue, upperValue := verticalSliderInstance.GetSelectedRange()
+	assert.Equalf(test, []int{1, 4}, []int{lowerValue, upperValue}, "Clicking near the upper handle did not move it!")
+	SetMouseStatus(30, 5, 0, "")
+	Slider.updateMouseEvent()
+	SetMouseStatus(30, 9, 0, "Up")
+	Slider.updateMouseEvent()
+	lowerValue, upperValue = verticalSliderInstance.GetSelectedRange()
+	assert.Equalf(test, []int{1, 4}, []int{lowerValue, upperValue}, "Scrolling moved the upper handle past the maximum!")
+	verticalSliderInstance.Delete()
+	assert.Falsef(test, Sliders.IsExists(layer1.layerAlias, verticalSliderInstance.controlAlias), "The slider was not deleted!")
+}
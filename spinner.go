@@ -0,0 +1,547 @@
+package consolizer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/math"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+SpinnerInstanceType is a structure which represents an instance of a spinner control.
+
+Example:
+
+	var spinnerInstance SpinnerInstanceType
+*/
+type SpinnerInstanceType struct {
+	BaseControlInstanceType
+}
+
+type spinnerType struct{}
+
+/*
+spinnerRepeatType is a structure which allows you to track an arrow of a spinner which is being held down with the
+mouse, so that its value keeps changing until the button is released.
+*/
+type spinnerRepeatType struct {
+	layerAlias     string
+	spinnerAlias   string
+	direction      int
+	nextRepeatTime time.Time
+}
+
+var Spinner spinnerType
+
+var Spinners = memory.NewControlMemoryManager[types.SpinnerEntryType]()
+
+/*
+spinnerRepeat is a variable which stores the spinner arrow currently being held down, if any.
+*/
+var spinnerRepeat spinnerRepeatType
+
+/*
+Delete is a method which removes a spinner instance, along with the text field used to edit its value.
+
+Example:
+
+	spinner.Delete()
+*/
+func (shared *SpinnerInstanceType) Delete() *SpinnerInstanceType {
+	shared.BaseControlInstanceType.Delete()
+	return nil
+}
+
+/*
+AddToTabIndex is a method which adds the spinner to the tab index of its associated layer. When the spinner
+receives focus, the user can type a number or use the arrow keys to change it.
+
+Example:
+
+	spinner.AddToTabIndex()
+*/
+func (shared *SpinnerInstanceType) AddToTabIndex() {
+	if Spinners.IsExists(shared.layerAlias, shared.controlAlias) {
+		spinnerEntry := Spinners.Get(shared.layerAlias, shared.controlAlias)
+		addTabIndex(shared.layerAlias, spinnerEntry.TextFieldAlias, constants.CellTypeTextField)
+	}
+}
+
+/*
+GetValue is a method which allows you to obtain the current value of a spinner. If the spinner instance no longer
+exists, then a value of 0 is always returned. In addition, the following should be noted:
+
+  - If the user has typed something which is not a number, or which falls outside the range of the spinner, the last
+    acceptable value is returned instead.
+
+Example:
+
+	value := spinner.GetValue()
+*/
+func (shared *SpinnerInstanceType) GetValue() float64 {
+	if Spinners.IsExists(shared.layerAlias, shared.controlAlias) {
+		return Spinner.getValue(shared.layerAlias, shared.controlAlias)
+	}
+	return 0
+}
+
+/*
+SetValue is a method which allows you to set the current value of a spinner. If the spinner instance no longer exists,
+then no operation takes place. In addition, the following should be noted:
+
+- The value is rounded to the number of decimal places of the spinner.
+
+- Values outside the range of the spinner are clamped to its minimum or maximum.
+
+Example:
+
+	spinner.SetValue(42)
+*/
+func (shared *SpinnerInstanceType) SetValue(value float64) *SpinnerInstanceType {
+	if Spinners.IsExists(shared.layerAlias, shared.controlAlias) {
+		Spinner.setValue(shared.layerAlias, shared.controlAlias, value)
+	}
+	return shared
+}
+
+/*
+SetRange is a method which allows you to change the smallest and largest values a spinner accepts, along with how
+much the value changes with each step. If the spinner instance no longer exists, then no operation takes place. In
+addition, the following should be noted:
+
+- The current value is clamped to the new range.
+
+  - If the minimum value is greater than the maximum value, or the step is less than or equal to 0, a panic will be
+    generated.
+
+Example:
+
+	spinner.SetRange(0, 1, 0.05)
+*/
+func (shared *SpinnerInstanceType) SetRange(minimumValue float64, maximumValue float64, step float64) *SpinnerInstanceType {
+	if Spinners.IsExists(shared.layerAlias, shared.controlAlias) {
+		spinnerEntry := Spinners.Get(shared.layerAlias, shared.controlAlias)
+		validateSpinnerRange(minimumValue, maximumValue, step, spinnerEntry.DecimalPlaces)
+		value := Spinner.getValue(shared.layerAlias, shared.controlAlias)
+		spinnerEntry.MinimumValue = minimumValue
+		spinnerEntry.MaximumValue = maximumValue
+		spinnerEntry.Step = step
+		Spinner.setValue(shared.layerAlias, shared.controlAlias, value)
+	}
+	return shared
+}
+
+/*
+Add is a method which adds a spinner to a given text layer. A spinner is a text field which only accepts numbers,
+followed by up and down arrows which change its value. Once called, an instance of your control is returned which
+will allow you to read or manipulate the properties for it. In addition, the following should be noted:
+
+- The width includes the two cells used by the arrows, and so must be at least 3.
+
+  - If the number of decimal places is 0, the spinner works with whole numbers only. Otherwise, values are rounded to
+    the number of decimal places given.
+
+  - The value can be changed by typing, by pressing the up and down arrow keys, by scrolling the mouse wheel over the
+    spinner, or by clicking its arrows. Holding an arrow down with the mouse keeps changing the value until the
+    button is released.
+
+  - A value which is typed but is not a number, or falls outside the range of the spinner, is shown in the error
+    colors of the text field until it is corrected. Pressing enter replaces it with the nearest acceptable value.
+
+  - If the minimum value is greater than the maximum value, the step is less than or equal to 0, or the number of
+    decimal places is negative, a panic will be generated.
+
+Example:
+
+	spinnerInstance := Spinner.Add("layer1", "spinner1", style, 5, 5, 10, 0, 100, 1, 50, 0, true)
+*/
+func (shared *spinnerType) Add(layerAlias string, spinnerAlias string, styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, width int, minimumValue float64, maximumValue float64, step float64, defaultValue float64, decimalPlaces int, isEnabled bool) SpinnerInstanceType {
+	validateSpinnerWidth(width)
+	validateSpinnerRange(minimumValue, maximumValue, step, decimalPlaces)
+	spinnerEntry := types.NewSpinnerEntry()
+	spinnerEntry.Alias = spinnerAlias
+	spinnerEntry.StyleEntry = styleEntry
+	spinnerEntry.XLocation = xLocation
+	spinnerEntry.YLocation = yLocation
+	spinnerEntry.Width = width
+	spinnerEntry.Height = 1
+	spinnerEntry.MinimumValue = minimumValue
+	spinnerEntry.MaximumValue = maximumValue
+	spinnerEntry.Step = step
+	spinnerEntry.DecimalPlaces = decimalPlaces
+	spinnerEntry.IsEnabled = isEnabled
+	spinnerEntry.TextFieldAlias = stringformat.GetLastSortedUUID()
+	Spinners.Add(layerAlias, spinnerAlias, &spinnerEntry)
+
+	// Here we subtract 2 from the width to leave room for the up and down arrows.
+	textFieldInstance := TextField.Add(layerAlias, spinnerEntry.TextFieldAlias, styleEntry, xLocation, yLocation, width-2, constants.DefaultSpinnerMaxLength, false, "", isEnabled)
+	if decimalPlaces == 0 {
+		textFieldInstance.SetCharacterFilter(constants.CharacterFilterInteger)
+	} else {
+		textFieldInstance.SetCharacterFilter(constants.CharacterFilterDecimal)
+	}
+	shared.setValue(layerAlias, spinnerAlias, defaultValue)
+	textFieldInstance.SetValidator(func(value string) error {
+		return shared.getValidationError(layerAlias, spinnerAlias, value)
+	})
+
+	var spinnerInstance SpinnerInstanceType
+	spinnerInstance.layerAlias = layerAlias
+	spinnerInstance.controlAlias = spinnerAlias
+	spinnerInstance.controlType = constants.TYPE_SPINNER
+	return spinnerInstance
+}
+
+/*
+Delete is a method which removes a spinner from a text layer, along with the text field used to edit its value. In
+addition, the following should be noted:
+
+- If you attempt to delete a spinner which does not exist, then the request will simply be ignored.
+
+Example:
+
+	Spinner.Delete("layer1", "spinner1")
+*/
+func (shared *spinnerType) Delete(layerAlias string, spinnerAlias string) {
+	if !Spinners.IsExists(layerAlias, spinnerAlias) {
+		return
+	}
+	spinnerEntry := Spinners.Get(layerAlias, spinnerAlias)
+	if TextFields.IsExists(layerAlias, spinnerEntry.TextFieldAlias) {
+		TextField.Delete(layerAlias, spinnerEntry.TextFieldAlias)
+	}
+	Spinners.Remove(layerAlias, spinnerAlias)
+}
+
+/*
+DeleteAll is a method which deletes all spinners on a given text layer, along with the text fields used to edit their
+values.
+
+Example:
+
+	Spinner.DeleteAll("layer1")
+*/
+func (shared *spinnerType) DeleteAll(layerAlias string) {
+	for _, spinnerEntry := range Spinners.GetAllEntries(layerAlias) {
+		if TextFields.IsExists(layerAlias, spinnerEntry.TextFieldAlias) {
+			TextField.Delete(layerAlias, spinnerEntry.TextFieldAlias)
+		}
+	}
+	Spinners.RemoveAll(layerAlias)
+}
+
+/*
+drawOnLayer is a method which draws all spinners on a given text layer. In addition, the following should be noted:
+
+  - Only the arrows are drawn here. The number itself is drawn by the text field of the spinner, which is kept in the
+    same position and state as the spinner so that it follows any changes made to it.
+
+Example:
+
+	Spinner.drawOnLayer(myLayer)
+*/
+func (shared *spinnerType) drawOnLayer(layerEntry types.LayerEntryType) {
+	layerAlias := layerEntry.LayerAlias
+	for _, spinnerEntry := range Spinners.GetAllEntries(layerAlias) {
+		if !TextFields.IsExists(layerAlias, spinnerEntry.TextFieldAlias) {
+			continue
+		}
+		textFieldEntry := TextFields.Get(layerAlias, spinnerEntry.TextFieldAlias)
+		textFieldEntry.XLocation = spinnerEntry.XLocation
+		textFieldEntry.YLocation = spinnerEntry.YLocation
+		textFieldEntry.Width = spinnerEntry.Width - 2
+		textFieldEntry.IsEnabled = spinnerEntry.IsEnabled
+		shared.draw(&layerEntry, spinnerEntry.Alias, spinnerEntry.StyleEntry, spinnerEntry.XLocation+spinnerEntry.Width-2, spinnerEntry.YLocation)
+	}
+}
+
+/*
+draw is a method which draws the up and down arrows of a spinner on a given text layer. The style of the arrows will
+be determined by the scroll bar style of the style entry passed in.
+
+Example:
+
+	Spinner.draw(&myLayer, "spinner1", style, 13, 5)
+*/
+func (shared *spinnerType) draw(layerEntry *types.LayerEntryType, spinnerAlias string, styleEntry types.TuiStyleEntryType, xLocation int, yLocation int) {
+	localStyleEntry := types.NewTuiStyleEntry(&styleEntry)
+	attributeEntry := types.NewAttributeEntry()
+	attributeEntry.ForegroundColor = localStyleEntry.Scrollbar.ForegroundColor
+	attributeEntry.BackgroundColor = localStyleEntry.Scrollbar.BackgroundColor
+	attributeEntry.CellType = constants.CellTypeSpinner
+	attributeEntry.CellControlAlias = spinnerAlias
+	attributeEntry.CellControlId = constants.CellControlIdUpScrollArrow
+	printLayer(layerEntry, attributeEntry, xLocation, yLocation, []rune{localStyleEntry.Scrollbar.UpArrow})
+	attributeEntry.CellControlId = constants.CellControlIdDownScrollArrow
+	printLayer(layerEntry, attributeEntry, xLocation+1, yLocation, []rune{localStyleEntry.Scrollbar.DownArrow})
+}
+
+/*
+getFormattedValue is a method which allows you to obtain a number as it should be shown in a spinner, with exactly
+as many decimal places as the spinner uses.
+
+Example:
+
+	valueAsString := Spinner.getFormattedValue(spinnerEntry, 12.5)
+*/
+func (shared *spinnerType) getFormattedValue(spinnerEntry *types.SpinnerEntryType, value float64) string {
+	return strconv.FormatFloat(value, 'f', spinnerEntry.DecimalPlaces, 64)
+}
+
+/*
+parseValue is a method which allows you to convert the text of a spinner into a number. If the text is not a number,
+or contains a fraction when the spinner only accepts whole numbers, false is returned as the second value.
+
+Example:
+
+	value, isNumber := Spinner.parseValue(spinnerEntry, "12.5")
+*/
+func (shared *spinnerType) parseValue(spinnerEntry *types.SpinnerEntryType, valueAsString string) (float64, bool) {
+	valueAsString = strings.TrimSpace(valueAsString)
+	if spinnerEntry.DecimalPlaces == 0 {
+		value, err := strconv.ParseInt(valueAsString, 10, 64)
+		return float64(value), err == nil
+	}
+	value, err := strconv.ParseFloat(valueAsString, 64)
+	return value, err == nil
+}
+
+/*
+getValidationError is a method which allows you to check whether the text typed into a spinner is an acceptable value
+for it. If it is not, an error describing the problem is returned.
+
+Example:
+
+	err := Spinner.getValidationError("layer1", "spinner1", "250")
+*/
+func (shared *spinnerType) getValidationError(layerAlias string, spinnerAlias string, valueAsString string) error {
+	if !Spinners.IsExists(layerAlias, spinnerAlias) {
+		return nil
+	}
+	spinnerEntry := Spinners.Get(layerAlias, spinnerAlias)
+	value, isNumber := shared.parseValue(spinnerEntry, valueAsString)
+	if !isNumber {
+		if spinnerEntry.DecimalPlaces == 0 {
+			return errors.New("The value must be a whole number.")
+		}
+		return errors.New("The value must be a number.")
+	}
+	if value < spinnerEntry.MinimumValue || value > spinnerEntry.MaximumValue {
+		return fmt.Errorf("The value must be between %s and %s.", shared.getFormattedValue(spinnerEntry, spinnerEntry.MinimumValue), shared.getFormattedValue(spinnerEntry, spinnerEntry.MaximumValue))
+	}
+	return nil
+}
+
+/*
+getValue is a method which allows you to obtain the current value of a spinner. If the text typed into the spinner is
+not an acceptable value, the last acceptable value is returned instead.
+
+Example:
+
+	value := Spinner.getValue("layer1", "spinner1")
+*/
+func (shared *spinnerType) getValue(layerAlias string, spinnerAlias string) float64 {
+	spinnerEntry := Spinners.Get(layerAlias, spinnerAlias)
+	if !TextFields.IsExists(layerAlias, spinnerEntry.TextFieldAlias) {
+		return spinnerEntry.Value
+	}
+	textFieldInstance := TextFieldInstanceType{BaseControlInstanceType{layerAlias: layerAlias, controlAlias: spinnerEntry.TextFieldAlias, controlType: constants.TYPE_TEXTFIELD}}
+	valueAsString := textFieldInstance.GetValue()
+	if shared.getValidationError(layerAlias, spinnerAlias, valueAsString) == nil {
+		spinnerEntry.Value, _ = shared.parseValue(spinnerEntry, valueAsString)
+	}
+	return spinnerEntry.Value
+}
+
+/*
+setValue is a method which allows you to set the value of a spinner, rounding it to the number of decimal places of
+the spinner and clamping it to its range, and then showing it in the text field of the spinner.
+
+Example:
+
+	Spinner.setValue("layer1", "spinner1", 42)
+*/
+func (shared *spinnerType) setValue(layerAlias string, spinnerAlias string, value float64) {
+	spinnerEntry := Spinners.Get(layerAlias, spinnerAlias)
+	value = math.RoundToDecimal(value, spinnerEntry.DecimalPlaces)
+	if value < spinnerEntry.MinimumValue {
+		value = spinnerEntry.MinimumValue
+	}
+	if value > spinnerEntry.MaximumValue {
+		value = spinnerEntry.MaximumValue
+	}
+	spinnerEntry.Value = value
+	if TextFields.IsExists(layerAlias, spinnerEntry.TextFieldAlias) {
+		textFieldInstance := TextFieldInstanceType{BaseControlInstanceType{layerAlias: layerAlias, controlAlias: spinnerEntry.TextFieldAlias, controlType: constants.TYPE_TEXTFIELD}}
+		textFieldInstance.SetValue(shared.getFormattedValue(spinnerEntry, value))
+	}
+}
+
+/*
+stepValue is a method which allows you to increase or decrease the value of a spinner by its step. A direction of 1
+increases the value, while a direction of -1 decreases it. If the spinner is disabled, false is returned and the value
+is left unchanged.
+
+Example:
+
+	isUpdateRequired := Spinner.stepValue("layer1", "spinner1", 1)
+*/
+func (shared *spinnerType) stepValue(layerAlias string, spinnerAlias string, direction int) bool {
+	spinnerEntry := Spinners.Get(layerAlias, spinnerAlias)
+	if !spinnerEntry.IsEnabled {
+		return false
+	}
+	shared.setValue(layerAlias, spinnerAlias, shared.getValue(layerAlias, spinnerAlias)+float64(direction)*spinnerEntry.Step)
+	return true
+}
+
+/*
+getSpinnerOwner is a method which allows you to obtain the alias of the spinner a text field belongs to. If the text
+field does not belong to a spinner, an empty string is returned.
+
+Example:
+
+	spinnerAlias := Spinner.getSpinnerOwner("layer1", textFieldAlias)
+*/
+func (shared *spinnerType) getSpinnerOwner(layerAlias string, textFieldAlias string) string {
+	for _, spinnerEntry := range Spinners.GetAllEntries(layerAlias) {
+		if spinnerEntry.TextFieldAlias == textFieldAlias {
+			return spinnerEntry.Alias
+		}
+	}
+	return ""
+}
+
+/*
+updateKeyboardEvent is a method which updates the state of the focused spinner according to the current keyboard
+event. In addition, the following should be noted:
+
+- Up and down change the value by one step, and are not passed on to the text field of the spinner.
+
+  - Enter replaces whatever has been typed with the nearest acceptable value, and is still passed on to the text
+    field so that its submit handler is called.
+
+Example:
+
+	isUpdate, isConsumed := Spinner.updateKeyboardEvent(keystroke)
+*/
+func (shared *spinnerType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	if focusedControlType != constants.CellTypeTextField {
+		return false, false
+	}
+	spinnerAlias := shared.getSpinnerOwner(focusedLayerAlias, focusedControlAlias)
+	if spinnerAlias == "" {
+		return false, false
+	}
+	switch string(keystroke) {
+	case "up":
+		return shared.stepValue(focusedLayerAlias, spinnerAlias, 1), true
+	case "down":
+		return shared.stepValue(focusedLayerAlias, spinnerAlias, -1), true
+	case "enter":
+		spinnerEntry := Spinners.Get(focusedLayerAlias, spinnerAlias)
+		value := shared.getValue(focusedLayerAlias, spinnerAlias)
+		textFieldInstance := TextFieldInstanceType{BaseControlInstanceType{layerAlias: focusedLayerAlias, controlAlias: spinnerEntry.TextFieldAlias, controlType: constants.TYPE_TEXTFIELD}}
+		// A number outside the range is clamped, while anything else is replaced by the last acceptable value.
+		if typedValue, isNumber := shared.parseValue(spinnerEntry, textFieldInstance.GetValue()); isNumber {
+			value = typedValue
+		}
+		shared.setValue(focusedLayerAlias, spinnerAlias, value)
+		return true, false
+	}
+	return false, false
+}
+
+/*
+updateMouseEvent is a method which updates the state of all spinners according to the current mouse event. In
+addition, the following should be noted:
+
+- Scrolling the mouse wheel over a spinner changes its value by one step.
+
+  - Clicking an arrow changes the value by one step and moves focus to the spinner. If the button is held down, the
+    value keeps changing through the periodic event updater until it is released.
+
+Example:
+
+	isUpdateRequired := Spinner.updateMouseEvent()
+*/
+func (shared *spinnerType) updateMouseEvent() bool {
+	mouseXLocation, mouseYLocation, buttonPressed, wheelState := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	if buttonPressed == 0 {
+		spinnerRepeat = spinnerRepeatType{}
+	}
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	layerAlias := characterEntry.LayerAlias
+	spinnerAlias := ""
+	if characterEntry.AttributeEntry.CellType == constants.CellTypeSpinner && Spinners.IsExists(layerAlias, characterEntry.AttributeEntry.CellControlAlias) {
+		spinnerAlias = characterEntry.AttributeEntry.CellControlAlias
+	} else if characterEntry.AttributeEntry.CellType == constants.CellTypeTextField {
+		spinnerAlias = shared.getSpinnerOwner(layerAlias, characterEntry.AttributeEntry.CellControlAlias)
+	}
+	if spinnerAlias == "" {
+		return false
+	}
+	if wheelState == "Up" {
+		return shared.stepValue(layerAlias, spinnerAlias, 1)
+	} else if wheelState == "Down" {
+		return shared.stepValue(layerAlias, spinnerAlias, -1)
+	}
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeSpinner || buttonPressed == 0 || previousButtonPressed != 0 {
+		return false
+	}
+	spinnerEntry := Spinners.Get(layerAlias, spinnerAlias)
+	setFocusedControl(layerAlias, spinnerEntry.TextFieldAlias, constants.CellTypeTextField)
+	direction := 1
+	if characterEntry.AttributeEntry.CellControlId == constants.CellControlIdDownScrollArrow {
+		direction = -1
+	}
+	if !shared.stepValue(layerAlias, spinnerAlias, direction) {
+		return true
+	}
+	spinnerRepeat = spinnerRepeatType{layerAlias: layerAlias, spinnerAlias: spinnerAlias, direction: direction,
+		nextRepeatTime: time.Now().Add(constants.DefaultSpinnerRepeatDelay * time.Millisecond)}
+	return true
+}
+
+/*
+updatePeriodicEvent is a method which keeps changing the value of a spinner while one of its arrows is held down with
+the mouse. In addition, the following should be noted:
+
+  - The value first repeats after a short delay, and then at a steady rate for as long as the button is held and the
+    mouse remains over the arrow.
+
+Example:
+
+	isUpdateRequired := Spinner.updatePeriodicEvent()
+*/
+func (shared *spinnerType) updatePeriodicEvent() bool {
+	if spinnerRepeat.spinnerAlias == "" || time.Now().Before(spinnerRepeat.nextRepeatTime) {
+		return false
+	}
+	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
+	if buttonPressed == 0 || !Spinners.IsExists(spinnerRepeat.layerAlias, spinnerRepeat.spinnerAlias) {
+		spinnerRepeat = spinnerRepeatType{}
+		return false
+	}
+	arrowCellId := constants.CellControlIdUpScrollArrow
+	if spinnerRepeat.direction < 0 {
+		arrowCellId = constants.CellControlIdDownScrollArrow
+	}
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	if characterEntry.LayerAlias != spinnerRepeat.layerAlias || characterEntry.AttributeEntry.CellType != constants.CellTypeSpinner ||
+		characterEntry.AttributeEntry.CellControlAlias != spinnerRepeat.spinnerAlias || characterEntry.AttributeEntry.CellControlId != arrowCellId {
+		return false
+	}
+	spinnerRepeat.nextRepeatTime = time.Now().Add(constants.DefaultSpinnerRepeatInterval * time.Millisecond)
+	return shared.stepValue(spinnerRepeat.layerAlias, spinnerRepeat.spinnerAlias, spinnerRepeat.direction)
+}
@@ -0,0 +1,107 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+	"time"
+)
+
+/*
+TestSpinnerKeyboard is a test which verifies that the value of a spinner can be typed or changed with the arrow keys,
+and that it is kept within the range of the spinner.
+
+Example:
+
+	Expected Inputs:
+	    An integer spinner and a decimal spinner, changed with the arrow keys, typed into, and set programmatically.
+
+	Expected Outputs:
+	    Each step changes the value by the step of the spinner without going past its range, values are shown with the
+	    right number of decimal places, and an unacceptable typed value is rejected until enter replaces it.
+*/
+func TestSpinnerKeyboard(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	spinnerInstance := layer1.AddSpinner(styleEntry, 2, 2, 8, 0, 10, 2, 5, 0, true)
+	spinnerEntry := Spinners.Get(layer1.layerAlias, spinnerInstance.controlAlias)
+	textFieldInstance := TextFieldInstanceType{BaseControlInstanceType{layerAlias: layer1.layerAlias, controlAlias: spinnerEntry.TextFieldAlias, controlType: constants.TYPE_TEXTFIELD}}
+	spinnerInstance.GetFocus()
+	assert.Equalf(test, spinnerEntry.TextFieldAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Focusing the spinner did not focus its text field!")
+	assert.Equalf(test, "5", textFieldInstance.GetValue(), "The default value was not shown!")
+	Spinner.updateKeyboardEvent([]rune("up"))
+	assert.Equalf(test, float64(7), spinnerInstance.GetValue(), "The up arrow did not increase the value by one step!")
+	Spinner.updateKeyboardEvent([]rune("up"))
+	Spinner.updateKeyboardEvent([]rune("up"))
+	assert.Equalf(test, float64(10), spinnerInstance.GetValue(), "The value went past the maximum!")
+	Spinner.updateKeyboardEvent([]rune("down"))
+	assert.Equalf(test, float64(8), spinnerInstance.GetValue(), "The down arrow did not decrease the value by one step!")
+
+	textFieldInstance.SetValue("")
+	TextField.updateKeyboardEventTextboxWithString("1.5x")
+	assert.Equalf(test, "15", textFieldInstance.GetValue(), "Characters other than digits were accepted by an integer spinner!")
+	assert.Equalf(test, "The value must be between 0 and 10.", textFieldInstance.GetValidationMessage(), "A value outside the range was not reported!")
+	assert.Equalf(test, float64(8), spinnerInstance.GetValue(), "An unacceptable typed value replaced the last acceptable one!")
+	Spinner.updateKeyboardEvent([]rune("enter"))
+	assert.Equalf(test, "10", textFieldInstance.GetValue(), "Enter did not replace the typed value with the nearest acceptable one!")
+	assert.Truef(test, textFieldInstance.IsValid(), "The text field was still invalid after enter was pressed!")
+
+	decimalSpinnerInstance := layer1.AddSpinner(styleEntry, 2, 4, 8, -1, 1, 0.25, 0, 2, true)
+	decimalSpinnerInstance.GetFocus()
+	Spinner.updateKeyboardEvent([]rune("down"))
+	decimalSpinnerEntry := Spinners.Get(layer1.layerAlias, decimalSpinnerInstance.controlAlias)
+	decimalTextFieldInstance := TextFieldInstanceType{BaseControlInstanceType{layerAlias: layer1.layerAlias, controlAlias: decimalSpinnerEntry.TextFieldAlias, controlType: constants.TYPE_TEXTFIELD}}
+	assert.Equalf(test, "-0.25", decimalTextFieldInstance.GetValue(), "A decimal value was not shown with two decimal places!")
+	decimalSpinnerInstance.SetValue(0.333)
+	assert.Equalf(test, 0.33, decimalSpinnerInstance.GetValue(), "A value was not rounded to the decimal places of the spinner!")
+	decimalSpinnerInstance.SetRange(0.5, 1, 0.1)
+	assert.Equalf(test, 0.5, decimalSpinnerInstance.GetValue(), "The value was not clamped to a new range!")
+	assert.Panicsf(test, func() { decimalSpinnerInstance.SetRange(1, 0, 0.1) }, "A range whose minimum is above its maximum did not panic!")
+}
+
+/*
+TestSpinnerMouse is a test which verifies that the value of a spinner can be changed by clicking or holding its
+arrows, or by scrolling the mouse wheel over it.
+
+Example:
+
+	Expected Inputs:
+	    An integer spinner whose arrows are clicked and held, and which is scrolled with the mouse wheel.
+
+	Expected Outputs:
+	    The arrows are drawn after the text field, each click or scroll changes the value by one step, and holding an
+	    arrow keeps changing the value until the button is released.
+*/
+func TestSpinnerMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	spinnerInstance := layer1.AddSpinner(styleEntry, 2, 2, 8, 0, 100, 1, 50, 0, true)
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	assert.Equalf(test, styleEntry.Scrollbar.UpArrow, layerEntry.CharacterMemory[2][8].Character, "The up arrow was not drawn after the text field!")
+	assert.Equalf(test, styleEntry.Scrollbar.DownArrow, layerEntry.CharacterMemory[2][9].Character, "The down arrow was not drawn after the up arrow!")
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(8, 2, 1, "")
+	Spinner.updateMouseEvent()
+	assert.Equalf(test, float64(51), spinnerInstance.GetValue(), "Clicking the up arrow did not increase the value!")
+	spinnerEntry := Spinners.Get(layer1.layerAlias, spinnerInstance.controlAlias)
+	assert.Equalf(test, spinnerEntry.TextFieldAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Clicking an arrow did not focus the spinner!")
+	assert.Falsef(test, Spinner.updatePeriodicEvent(), "The value repeated before the repeat delay had passed!")
+	spinnerRepeat.nextRepeatTime = time.Now()
+	assert.Truef(test, Spinner.updatePeriodicEvent(), "Holding the up arrow did not repeat!")
+	assert.Equalf(test, float64(52), spinnerInstance.GetValue(), "Holding the up arrow did not keep increasing the value!")
+	SetMouseStatus(8, 2, 0, "")
+	Spinner.updateMouseEvent()
+	spinnerRepeat.nextRepeatTime = time.Now()
+	assert.Falsef(test, Spinner.updatePeriodicEvent(), "The value kept repeating after the button was released!")
+
+	SetMouseStatus(9, 2, 1, "")
+	Spinner.updateMouseEvent()
+	assert.Equalf(test, float64(51), spinnerInstance.GetValue(), "Clicking the down arrow did not decrease the value!")
+	SetMouseStatus(4, 2, 0, "")
+	SetMouseStatus(4, 2, 0, "Down")
+	Spinner.updateMouseEvent()
+	assert.Equalf(test, float64(50), spinnerInstance.GetValue(), "Scrolling down over the spinner did not decrease the value!")
+
+	spinnerInstance.Delete()
+	assert.Falsef(test, TextFields.IsExists(layer1.layerAlias, spinnerEntry.TextFieldAlias), "The text field of the spinner was not deleted with it!")
+}
@@ -0,0 +1,623 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/types"
+	"strings"
+	"unicode"
+)
+
+/*
+SyntaxTokenizer is an interface which allows you to provide syntax highlighting for a textbox. In addition, the
+following should be noted:
+
+  - TokenizeLine is called with a single line of text and the state returned for the line before it, and should
+    return the spans of the line to highlight along with the state to pass on to the next line. The first line of a
+    document always receives a state of 0.
+
+  - The state allows constructs which span several lines, such as block comments, to be highlighted correctly. Lines
+    are only tokenized again when their text or the state passed to them changes.
+
+  - The style of the textbox being drawn is provided, so that colors can be taken from its syntax color settings.
+*/
+type SyntaxTokenizer interface {
+	TokenizeLine(line []rune, previousState int, styleEntry types.TuiStyleEntryType) ([]types.SyntaxSpanType, int)
+}
+
+/*
+textboxSyntaxEntryType is a structure which holds the tokenizer assigned to a textbox, along with the results of
+tokenizing each line so that unchanged lines do not need to be tokenized again.
+*/
+type textboxSyntaxEntryType struct {
+	tokenizer       SyntaxTokenizer
+	textboxStyle    types.TextboxStyle
+	lineText        [][]rune
+	lineStartStates []int
+	lineEndStates   []int
+	lineAttributes  [][]*types.AttributeEntryType
+}
+
+/*
+textboxSyntaxMemory is a variable which holds the syntax highlighting state of every textbox which has a tokenizer
+assigned, grouped by layer.
+*/
+var textboxSyntaxMemory = memory.NewControlMemoryManager[textboxSyntaxEntryType]()
+
+const (
+	syntaxLexerStateNone = iota
+	syntaxLexerStateBlockComment
+	syntaxLexerStateMultiLineString
+)
+
+/*
+syntaxLexerType is a structure which represents a simple rule based tokenizer. The built-in tokenizers for each
+supported language are all instances of this structure configured with different rules.
+*/
+type syntaxLexerType struct {
+	keywords                    map[string]bool
+	isKeywordCaseSensitive      bool
+	lineCommentPrefixes         []string
+	isLineCommentSpaceRequired  bool
+	isLineCommentAtStartOnly    bool
+	blockCommentStart           string
+	blockCommentEnd             string
+	stringDelimiters            string
+	multiLineStringDelimiter    rune
+	isStringKeyDetected         bool
+	keySeparators               string
+	isKeySeparatorSpaceRequired bool
+	isListMarkerSkipped         bool
+	isSectionDetected           bool
+	isVariableDetected          bool
+}
+
+/*
+SetSyntaxTokenizer is a method which allows you to assign a syntax tokenizer to a textbox, so that its text is drawn
+with syntax highlighting. If the textbox instance no longer exists, then no operation takes place. In addition, the
+following should be noted:
+
+  - Built-in tokenizers are available for Go, JSON, YAML, shell scripts, and INI files. You can also provide your
+    own by implementing the 'SyntaxTokenizer' interface.
+
+- Passing in nil removes syntax highlighting from the textbox.
+
+- The highlighted text and cursor are always drawn in their usual colors.
+
+Example:
+
+	textbox.SetSyntaxTokenizer(NewJsonTokenizer())
+*/
+func (shared *TextboxInstanceType) SetSyntaxTokenizer(tokenizer SyntaxTokenizer) *TextboxInstanceType {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return shared
+	}
+	deleteTextboxSyntax(shared.layerAlias, shared.controlAlias)
+	if tokenizer != nil {
+		syntaxEntry := textboxSyntaxEntryType{tokenizer: tokenizer}
+		textboxSyntaxMemory.Add(shared.layerAlias, shared.controlAlias, &syntaxEntry)
+	}
+	return shared
+}
+
+/*
+NewGoTokenizer is a constructor which allows you to create a tokenizer that highlights Go source code.
+
+Example:
+
+	textbox.SetSyntaxTokenizer(NewGoTokenizer())
+*/
+func NewGoTokenizer() SyntaxTokenizer {
+	lexer := syntaxLexerType{isKeywordCaseSensitive: true}
+	lexer.keywords = getSyntaxKeywords("break", "case", "chan", "const", "continue", "default", "defer", "else",
+		"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map", "package", "range", "return",
+		"select", "struct", "switch", "type", "var", "true", "false", "nil", "iota")
+	lexer.lineCommentPrefixes = []string{"//"}
+	lexer.blockCommentStart = "/*"
+	lexer.blockCommentEnd = "*/"
+	lexer.stringDelimiters = "\"'"
+	lexer.multiLineStringDelimiter = '`'
+	return &lexer
+}
+
+/*
+NewJsonTokenizer is a constructor which allows you to create a tokenizer that highlights JSON documents. Object
+keys are highlighted differently from string values.
+
+Example:
+
+	textbox.SetSyntaxTokenizer(NewJsonTokenizer())
+*/
+func NewJsonTokenizer() SyntaxTokenizer {
+	lexer := syntaxLexerType{isKeywordCaseSensitive: true}
+	lexer.keywords = getSyntaxKeywords("true", "false", "null")
+	lexer.stringDelimiters = "\""
+	lexer.isStringKeyDetected = true
+	return &lexer
+}
+
+/*
+NewYamlTokenizer is a constructor which allows you to create a tokenizer that highlights YAML documents.
+
+Example:
+
+	textbox.SetSyntaxTokenizer(NewYamlTokenizer())
+*/
+func NewYamlTokenizer() SyntaxTokenizer {
+	lexer := syntaxLexerType{}
+	lexer.keywords = getSyntaxKeywords("true", "false", "null", "yes", "no", "on", "off")
+	lexer.lineCommentPrefixes = []string{"#"}
+	lexer.isLineCommentSpaceRequired = true
+	lexer.stringDelimiters = "\"'"
+	lexer.keySeparators = ":"
+	lexer.isKeySeparatorSpaceRequired = true
+	lexer.isListMarkerSkipped = true
+	return &lexer
+}
+
+/*
+NewShellTokenizer is a constructor which allows you to create a tokenizer that highlights shell scripts, including
+variables such as '$HOME' and '${PATH}'.
+
+Example:
+
+	textbox.SetSyntaxTokenizer(NewShellTokenizer())
+*/
+func NewShellTokenizer() SyntaxTokenizer {
+	lexer := syntaxLexerType{isKeywordCaseSensitive: true}
+	lexer.keywords = getSyntaxKeywords("if", "then", "else", "elif", "fi", "for", "while", "until", "do", "done",
+		"case", "esac", "in", "function", "return", "export", "local", "select", "break", "continue")
+	lexer.lineCommentPrefixes = []string{"#"}
+	lexer.isLineCommentSpaceRequired = true
+	lexer.stringDelimiters = "\"'`"
+	lexer.isVariableDetected = true
+	return &lexer
+}
+
+/*
+NewIniTokenizer is a constructor which allows you to create a tokenizer that highlights INI files, including
+section headers, keys, and comments.
+
+Example:
+
+	textbox.SetSyntaxTokenizer(NewIniTokenizer())
+*/
+func NewIniTokenizer() SyntaxTokenizer {
+	lexer := syntaxLexerType{}
+	lexer.keywords = getSyntaxKeywords("true", "false", "yes", "no", "on", "off")
+	lexer.lineCommentPrefixes = []string{";", "#"}
+	lexer.isLineCommentAtStartOnly = true
+	lexer.stringDelimiters = "\"'"
+	lexer.keySeparators = "="
+	lexer.isSectionDetected = true
+	return &lexer
+}
+
+/*
+TokenizeLine is a method which allows you to obtain the syntax spans of a single line of text, according to the
+rules of the lexer. This satisfies the 'SyntaxTokenizer' interface.
+
+Example:
+
+	syntaxSpans, nextState := lexer.TokenizeLine([]rune("func main() {"), 0, styleEntry)
+*/
+func (shared *syntaxLexerType) TokenizeLine(line []rune, previousState int, styleEntry types.TuiStyleEntryType) ([]types.SyntaxSpanType, int) {
+	var syntaxSpans []types.SyntaxSpanType
+	textboxStyle := styleEntry.Textbox
+	state := previousState
+	currentIndex := 0
+	switch state {
+	case syntaxLexerStateBlockComment:
+		endIndex := getSyntaxIndex(line, shared.blockCommentEnd, 0)
+		if endIndex == -1 {
+			return appendSyntaxSpan(syntaxSpans, 0, len(line), textboxStyle.SyntaxCommentColor), state
+		}
+		currentIndex = endIndex + len([]rune(shared.blockCommentEnd))
+		syntaxSpans = appendSyntaxSpan(syntaxSpans, 0, currentIndex, textboxStyle.SyntaxCommentColor)
+		state = syntaxLexerStateNone
+	case syntaxLexerStateMultiLineString:
+		endIndex := getSyntaxIndex(line, string(shared.multiLineStringDelimiter), 0)
+		if endIndex == -1 {
+			return appendSyntaxSpan(syntaxSpans, 0, len(line), textboxStyle.SyntaxStringColor), state
+		}
+		currentIndex = endIndex + 1
+		syntaxSpans = appendSyntaxSpan(syntaxSpans, 0, currentIndex, textboxStyle.SyntaxStringColor)
+		state = syntaxLexerStateNone
+	default:
+		syntaxSpans, currentIndex = shared.getLinePrefixSpans(line, textboxStyle)
+	}
+	for currentIndex < len(line) {
+		currentCharacter := line[currentIndex]
+		if shared.isLineCommentAt(line, currentIndex) {
+			syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, len(line), textboxStyle.SyntaxCommentColor)
+			break
+		}
+		if shared.blockCommentStart != "" && getSyntaxIndex(line, shared.blockCommentStart, currentIndex) == currentIndex {
+			endIndex := getSyntaxIndex(line, shared.blockCommentEnd, currentIndex+len([]rune(shared.blockCommentStart)))
+			if endIndex == -1 {
+				syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, len(line), textboxStyle.SyntaxCommentColor)
+				state = syntaxLexerStateBlockComment
+				break
+			}
+			endIndex += len([]rune(shared.blockCommentEnd))
+			syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, endIndex, textboxStyle.SyntaxCommentColor)
+			currentIndex = endIndex
+			continue
+		}
+		if shared.multiLineStringDelimiter != 0 && currentCharacter == shared.multiLineStringDelimiter {
+			endIndex := getSyntaxIndex(line, string(shared.multiLineStringDelimiter), currentIndex+1)
+			if endIndex == -1 {
+				syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, len(line), textboxStyle.SyntaxStringColor)
+				state = syntaxLexerStateMultiLineString
+				break
+			}
+			syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, endIndex+1, textboxStyle.SyntaxStringColor)
+			currentIndex = endIndex + 1
+			continue
+		}
+		if strings.ContainsRune(shared.stringDelimiters, currentCharacter) {
+			endIndex := getSyntaxStringEnd(line, currentIndex)
+			stringColor := textboxStyle.SyntaxStringColor
+			if shared.isStringKeyDetected && isSyntaxKeySeparatorNext(line, endIndex, ":") {
+				stringColor = textboxStyle.SyntaxKeyColor
+			}
+			syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, endIndex, stringColor)
+			currentIndex = endIndex
+			continue
+		}
+		if shared.isVariableDetected && currentCharacter == '$' {
+			endIndex := getSyntaxVariableEnd(line, currentIndex)
+			if endIndex > currentIndex+1 {
+				syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, endIndex, textboxStyle.SyntaxVariableColor)
+				currentIndex = endIndex
+				continue
+			}
+		}
+		isWordStart := currentIndex == 0 || !isWordCharacter(line[currentIndex-1])
+		if isWordStart && (unicode.IsDigit(currentCharacter) ||
+			(currentCharacter == '-' && currentIndex+1 < len(line) && unicode.IsDigit(line[currentIndex+1]))) {
+			endIndex := currentIndex + 1
+			for endIndex < len(line) && (isWordCharacter(line[endIndex]) || line[endIndex] == '.') {
+				endIndex++
+			}
+			syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, endIndex, textboxStyle.SyntaxNumberColor)
+			currentIndex = endIndex
+			continue
+		}
+		if isWordCharacter(currentCharacter) {
+			endIndex := currentIndex + 1
+			for endIndex < len(line) && isWordCharacter(line[endIndex]) {
+				endIndex++
+			}
+			word := string(line[currentIndex:endIndex])
+			if !shared.isKeywordCaseSensitive {
+				word = strings.ToLower(word)
+			}
+			if isWordStart && shared.keywords[word] {
+				syntaxSpans = appendSyntaxSpan(syntaxSpans, currentIndex, endIndex, textboxStyle.SyntaxKeywordColor)
+			}
+			currentIndex = endIndex
+			continue
+		}
+		currentIndex++
+	}
+	return syntaxSpans, state
+}
+
+/*
+getLinePrefixSpans is a method which allows you to obtain the spans for constructs which can only appear at the
+start of a line, such as INI section headers and YAML or INI keys. In addition, the following should be noted:
+
+- The index returned is where tokenizing of the rest of the line should continue from.
+
+Example:
+
+	syntaxSpans, currentIndex := lexer.getLinePrefixSpans(line, textboxStyle)
+*/
+func (shared *syntaxLexerType) getLinePrefixSpans(line []rune, textboxStyle types.TextboxStyle) ([]types.SyntaxSpanType, int) {
+	var syntaxSpans []types.SyntaxSpanType
+	startIndex := 0
+	for startIndex < len(line) && unicode.IsSpace(line[startIndex]) {
+		startIndex++
+	}
+	if startIndex == len(line) || shared.isLineCommentAt(line, startIndex) {
+		return syntaxSpans, startIndex
+	}
+	if shared.isSectionDetected && line[startIndex] == '[' {
+		endIndex := getSyntaxIndex(line, "]", startIndex)
+		if endIndex != -1 {
+			return appendSyntaxSpan(syntaxSpans, startIndex, endIndex+1, textboxStyle.SyntaxKeywordColor), endIndex + 1
+		}
+	}
+	if shared.keySeparators == "" {
+		return syntaxSpans, startIndex
+	}
+	if shared.isListMarkerSkipped {
+		for startIndex+1 < len(line) && line[startIndex] == '-' && unicode.IsSpace(line[startIndex+1]) {
+			startIndex += 2
+			for startIndex < len(line) && unicode.IsSpace(line[startIndex]) {
+				startIndex++
+			}
+		}
+	}
+	if startIndex == len(line) || strings.ContainsRune(shared.stringDelimiters, line[startIndex]) {
+		return syntaxSpans, startIndex
+	}
+	for separatorIndex := startIndex; separatorIndex < len(line); separatorIndex++ {
+		if !strings.ContainsRune(shared.keySeparators, line[separatorIndex]) {
+			continue
+		}
+		if shared.isKeySeparatorSpaceRequired && separatorIndex+1 < len(line) && !unicode.IsSpace(line[separatorIndex+1]) {
+			continue
+		}
+		keyEndIndex := separatorIndex
+		for keyEndIndex > startIndex && unicode.IsSpace(line[keyEndIndex-1]) {
+			keyEndIndex--
+		}
+		syntaxSpans = appendSyntaxSpan(syntaxSpans, startIndex, keyEndIndex, textboxStyle.SyntaxKeyColor)
+		return syntaxSpans, separatorIndex + 1
+	}
+	return syntaxSpans, startIndex
+}
+
+/*
+isLineCommentAt is a method which allows you to detect if a line comment begins at a given position of a line.
+
+Example:
+
+	isComment := lexer.isLineCommentAt(line, 4)
+*/
+func (shared *syntaxLexerType) isLineCommentAt(line []rune, currentIndex int) bool {
+	for _, currentPrefix := range shared.lineCommentPrefixes {
+		if getSyntaxIndex(line, currentPrefix, currentIndex) != currentIndex {
+			continue
+		}
+		if shared.isLineCommentAtStartOnly && strings.TrimSpace(string(line[:currentIndex])) != "" {
+			continue
+		}
+		if shared.isLineCommentSpaceRequired && currentIndex > 0 && !unicode.IsSpace(line[currentIndex-1]) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+/*
+getSyntaxKeywords is a method which allows you to build a keyword lookup table from a list of words.
+
+Example:
+
+	keywords := getSyntaxKeywords("if", "else")
+*/
+func getSyntaxKeywords(keywords ...string) map[string]bool {
+	keywordMap := make(map[string]bool)
+	for _, currentKeyword := range keywords {
+		keywordMap[currentKeyword] = true
+	}
+	return keywordMap
+}
+
+/*
+getSyntaxIndex is a method which allows you to find the first position at or after a given index where some text
+occurs in a line. If the text is not found, -1 is returned.
+
+Example:
+
+	index := getSyntaxIndex(line, "*\/", 0)
+*/
+func getSyntaxIndex(line []rune, textToFind string, startIndex int) int {
+	runesToFind := []rune(textToFind)
+	if len(runesToFind) == 0 {
+		return -1
+	}
+	for currentIndex := startIndex; currentIndex+len(runesToFind) <= len(line); currentIndex++ {
+		isMatch := true
+		for offset, currentRune := range runesToFind {
+			if line[currentIndex+offset] != currentRune {
+				isMatch = false
+				break
+			}
+		}
+		if isMatch {
+			return currentIndex
+		}
+	}
+	return -1
+}
+
+/*
+getSyntaxStringEnd is a method which allows you to find where a quoted string ends. In addition, the following
+should be noted:
+
+- The index returned is just past the closing quote, or the end of the line if the string is not closed.
+
+- Quotes escaped with a backslash do not close the string.
+
+Example:
+
+	endIndex := getSyntaxStringEnd(line, 5)
+*/
+func getSyntaxStringEnd(line []rune, startIndex int) int {
+	delimiter := line[startIndex]
+	for currentIndex := startIndex + 1; currentIndex < len(line); currentIndex++ {
+		if line[currentIndex] == '\\' && delimiter != '\'' {
+			currentIndex++
+			continue
+		}
+		if line[currentIndex] == delimiter {
+			return currentIndex + 1
+		}
+	}
+	return len(line)
+}
+
+/*
+getSyntaxVariableEnd is a method which allows you to find where a shell variable reference such as '$HOME', '${PATH}',
+or '$1' ends. The index returned is just past the end of the variable.
+
+Example:
+
+	endIndex := getSyntaxVariableEnd(line, 0)
+*/
+func getSyntaxVariableEnd(line []rune, startIndex int) int {
+	currentIndex := startIndex + 1
+	if currentIndex >= len(line) {
+		return currentIndex
+	}
+	if line[currentIndex] == '{' {
+		endIndex := getSyntaxIndex(line, "}", currentIndex)
+		if endIndex == -1 {
+			return len(line)
+		}
+		return endIndex + 1
+	}
+	if strings.ContainsRune("@#?$!*-", line[currentIndex]) || unicode.IsDigit(line[currentIndex]) {
+		return currentIndex + 1
+	}
+	for currentIndex < len(line) && isWordCharacter(line[currentIndex]) {
+		currentIndex++
+	}
+	return currentIndex
+}
+
+/*
+isSyntaxKeySeparatorNext is a method which allows you to detect if the next character after a given position,
+ignoring whitespace, is one of the separators provided.
+
+Example:
+
+	isKey := isSyntaxKeySeparatorNext(line, 7, ":")
+*/
+func isSyntaxKeySeparatorNext(line []rune, startIndex int, separators string) bool {
+	for currentIndex := startIndex; currentIndex < len(line); currentIndex++ {
+		if !unicode.IsSpace(line[currentIndex]) {
+			return strings.ContainsRune(separators, line[currentIndex])
+		}
+	}
+	return false
+}
+
+/*
+appendSyntaxSpan is a method which allows you to add a span which recolors the foreground of part of a line. Empty
+spans are ignored.
+
+Example:
+
+	syntaxSpans = appendSyntaxSpan(syntaxSpans, 0, 4, styleEntry.Textbox.SyntaxKeywordColor)
+*/
+func appendSyntaxSpan(syntaxSpans []types.SyntaxSpanType, startIndex int, endIndex int, foregroundColor constants.ColorType) []types.SyntaxSpanType {
+	if endIndex <= startIndex {
+		return syntaxSpans
+	}
+	var attributeEntry types.AttributeEntryType
+	attributeEntry.ForegroundColor = foregroundColor
+	return append(syntaxSpans, types.NewSyntaxSpan(startIndex, endIndex-startIndex, attributeEntry))
+}
+
+/*
+getTextboxSyntaxAttributes is a method which allows you to obtain the syntax attributes of each character in a
+textbox, tokenizing any lines that changed since the last time it was drawn. In addition, the following should be
+noted:
+
+- Only lines up to and including the last line index provided are brought up to date.
+
+  - The result holds one row per line, and each row holds one entry per character. Characters without a syntax
+    attribute have a nil entry.
+
+- If the textbox has no tokenizer assigned, nil is returned.
+
+Example:
+
+	syntaxAttributes := getTextboxSyntaxAttributes("layer1", "textbox1", textboxEntry, 20)
+*/
+func getTextboxSyntaxAttributes(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType, lastLineIndex int) [][]*types.AttributeEntryType {
+	if !textboxSyntaxMemory.IsExists(layerAlias, textboxAlias) {
+		return nil
+	}
+	syntaxEntry := textboxSyntaxMemory.Get(layerAlias, textboxAlias)
+	if syntaxEntry.textboxStyle != textboxEntry.StyleEntry.Textbox {
+		// Colors are baked into the cached spans, so a new style requires everything to be tokenized again.
+		syntaxEntry.textboxStyle = textboxEntry.StyleEntry.Textbox
+		syntaxEntry.lineText = nil
+	}
+	if len(textboxEntry.TextData) < len(syntaxEntry.lineText) {
+		syntaxEntry.lineText = syntaxEntry.lineText[:len(textboxEntry.TextData)]
+	}
+	lineCount := len(syntaxEntry.lineText)
+	syntaxEntry.lineStartStates = syntaxEntry.lineStartStates[:lineCount]
+	syntaxEntry.lineEndStates = syntaxEntry.lineEndStates[:lineCount]
+	syntaxEntry.lineAttributes = syntaxEntry.lineAttributes[:lineCount]
+	state := 0
+	for lineIndex := 0; lineIndex <= lastLineIndex && lineIndex < len(textboxEntry.TextData); lineIndex++ {
+		currentLine := textboxEntry.TextData[lineIndex]
+		if lineIndex < len(syntaxEntry.lineText) && syntaxEntry.lineStartStates[lineIndex] == state &&
+			string(syntaxEntry.lineText[lineIndex]) == string(currentLine) {
+			state = syntaxEntry.lineEndStates[lineIndex]
+			continue
+		}
+		syntaxSpans, nextState := syntaxEntry.tokenizer.TokenizeLine(currentLine, state, textboxEntry.StyleEntry)
+		lineAttributes := make([]*types.AttributeEntryType, len(currentLine))
+		for spanIndex := range syntaxSpans {
+			for characterIndex := syntaxSpans[spanIndex].StartIndex; characterIndex < syntaxSpans[spanIndex].StartIndex+syntaxSpans[spanIndex].Length; characterIndex++ {
+				if characterIndex >= 0 && characterIndex < len(lineAttributes) {
+					lineAttributes[characterIndex] = &syntaxSpans[spanIndex].AttributeEntry
+				}
+			}
+		}
+		if lineIndex < len(syntaxEntry.lineText) {
+			syntaxEntry.lineText[lineIndex] = append([]rune{}, currentLine...)
+			syntaxEntry.lineStartStates[lineIndex] = state
+			syntaxEntry.lineEndStates[lineIndex] = nextState
+			syntaxEntry.lineAttributes[lineIndex] = lineAttributes
+		} else {
+			syntaxEntry.lineText = append(syntaxEntry.lineText, append([]rune{}, currentLine...))
+			syntaxEntry.lineStartStates = append(syntaxEntry.lineStartStates, state)
+			syntaxEntry.lineEndStates = append(syntaxEntry.lineEndStates, nextState)
+			syntaxEntry.lineAttributes = append(syntaxEntry.lineAttributes, lineAttributes)
+		}
+		state = nextState
+	}
+	return syntaxEntry.lineAttributes
+}
+
+/*
+getSyntaxAttributeEntry is a method which allows you to apply a syntax attribute on top of the attribute a
+character would normally be drawn with. In addition, the following should be noted:
+
+- Colors left at their zero value in the syntax attribute are not applied.
+
+- Text styles such as bold or italic are added to those already present.
+
+Example:
+
+	attributeEntry = getSyntaxAttributeEntry(attributeEntry, syntaxAttributeEntry)
+*/
+func getSyntaxAttributeEntry(attributeEntry types.AttributeEntryType, syntaxAttributeEntry types.AttributeEntryType) types.AttributeEntryType {
+	if syntaxAttributeEntry.ForegroundColor != 0 {
+		attributeEntry.ForegroundColor = syntaxAttributeEntry.ForegroundColor
+	}
+	if syntaxAttributeEntry.BackgroundColor != 0 {
+		attributeEntry.BackgroundColor = syntaxAttributeEntry.BackgroundColor
+	}
+	attributeEntry.IsBold = attributeEntry.IsBold || syntaxAttributeEntry.IsBold
+	attributeEntry.IsItalic = attributeEntry.IsItalic || syntaxAttributeEntry.IsItalic
+	attributeEntry.IsUnderlined = attributeEntry.IsUnderlined || syntaxAttributeEntry.IsUnderlined
+	attributeEntry.IsReversed = attributeEntry.IsReversed || syntaxAttributeEntry.IsReversed
+	attributeEntry.IsBlinking = attributeEntry.IsBlinking || syntaxAttributeEntry.IsBlinking
+	return attributeEntry
+}
+
+/*
+deleteTextboxSyntax is a method which allows you to remove the syntax highlighting state of a textbox. If the
+textbox has no tokenizer assigned, then no operation will be performed.
+
+Example:
+
+	deleteTextboxSyntax("layer1", "textbox1")
+*/
+func deleteTextboxSyntax(layerAlias string, textboxAlias string) {
+	if textboxSyntaxMemory.IsExists(layerAlias, textboxAlias) {
+		textboxSyntaxMemory.Remove(layerAlias, textboxAlias)
+	}
+}
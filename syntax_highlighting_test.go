@@ -0,0 +1,150 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+TestSyntaxTokenizers is a test which verifies that each built-in tokenizer recognizes the constructs of its
+language.
+
+Example:
+
+	Expected Inputs:
+	    Single lines of Go, JSON, YAML, shell, and INI text, along with a Go block comment spanning several lines.
+
+	Expected Outputs:
+	    Keywords, strings, numbers, comments, keys, and variables are reported with their matching syntax colors,
+	    and the block comment state is carried from one line to the next.
+*/
+func TestSyntaxTokenizers(test *testing.T) {
+	styleEntry := types.NewTuiStyleEntry()
+	textboxStyle := styleEntry.Textbox
+
+	goColors, state := getSyntaxTestColors(NewGoTokenizer(), "func x() { return \"a\" } // done", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxKeywordColor, goColors[0], "The Go keyword 'func' was not highlighted!")
+	assert.Equalf(test, constants.ColorType(0), goColors[5], "A Go identifier was highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxKeywordColor, goColors[11], "The Go keyword 'return' was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxStringColor, goColors[18], "A Go string was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxCommentColor, goColors[24], "A Go line comment was not highlighted!")
+	assert.Equalf(test, syntaxLexerStateNone, state, "A Go line without open comments changed the lexer state!")
+	goColors, state = getSyntaxTestColors(NewGoTokenizer(), "x := 1 /* start", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxNumberColor, goColors[5], "A Go number was not highlighted!")
+	assert.Equalf(test, syntaxLexerStateBlockComment, state, "An unterminated Go block comment did not carry over!")
+	goColors, state = getSyntaxTestColors(NewGoTokenizer(), "end */ if", state, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxCommentColor, goColors[0], "The end of a Go block comment was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxKeywordColor, goColors[7], "A Go keyword after a block comment was not highlighted!")
+	assert.Equalf(test, syntaxLexerStateNone, state, "A terminated Go block comment did not reset the lexer state!")
+
+	jsonColors, _ := getSyntaxTestColors(NewJsonTokenizer(), "{\"name\": \"value\", \"on\": true}", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxKeyColor, jsonColors[1], "A JSON key was not highlighted as a key!")
+	assert.Equalf(test, textboxStyle.SyntaxStringColor, jsonColors[9], "A JSON string value was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxKeywordColor, jsonColors[24], "A JSON literal was not highlighted!")
+
+	yamlColors, _ := getSyntaxTestColors(NewYamlTokenizer(), "- port: 8080 # http", 0, styleEntry)
+	assert.Equalf(test, constants.ColorType(0), yamlColors[0], "A YAML list marker was highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxKeyColor, yamlColors[2], "A YAML key was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxNumberColor, yamlColors[8], "A YAML number was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxCommentColor, yamlColors[13], "A YAML comment was not highlighted!")
+
+	shellColors, _ := getSyntaxTestColors(NewShellTokenizer(), "if [ \"$HOME\" ]; then echo ${PATH}#x; fi", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxKeywordColor, shellColors[0], "A shell keyword was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxStringColor, shellColors[6], "A shell string was not highlighted!")
+	assert.Equalf(test, textboxStyle.SyntaxVariableColor, shellColors[27], "A shell variable was not highlighted!")
+	assert.Equalf(test, constants.ColorType(0), shellColors[34], "A '#' inside a shell word was treated as a comment!")
+
+	iniColors, _ := getSyntaxTestColors(NewIniTokenizer(), "[server]", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxKeywordColor, iniColors[0], "An INI section was not highlighted!")
+	iniColors, _ = getSyntaxTestColors(NewIniTokenizer(), "host = local;host", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxKeyColor, iniColors[0], "An INI key was not highlighted!")
+	assert.Equalf(test, constants.ColorType(0), iniColors[12], "A ';' after an INI value was treated as a comment!")
+	iniColors, _ = getSyntaxTestColors(NewIniTokenizer(), "  ; note", 0, styleEntry)
+	assert.Equalf(test, textboxStyle.SyntaxCommentColor, iniColors[2], "An INI comment was not highlighted!")
+}
+
+/*
+TestTextboxSyntaxHighlighting is a test which verifies that a textbox draws its text using the colors provided by
+its tokenizer and only tokenizes lines again when they change.
+
+Example:
+
+	Expected Inputs:
+	    A textbox containing Go code with a Go tokenizer assigned, which is then edited and has its tokenizer removed.
+
+	Expected Outputs:
+	    Keywords are drawn in the keyword color, editing a line opens a block comment on the lines that follow, only
+	    the changed lines are tokenized again, and removing the tokenizer restores the plain colors.
+*/
+func TestTextboxSyntaxHighlighting(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textboxInstance := layer1.AddTextbox(styleEntry, 2, 2, 20, 4, false)
+	textboxInstance.SetText("func main() {\n\treturn\n}")
+	countingTokenizer := &syntaxTestCountingTokenizerType{tokenizer: NewGoTokenizer()}
+	textboxInstance.SetSyntaxTokenizer(countingTokenizer)
+	layerEntry := Layers.Get(layer1.layerAlias)
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, styleEntry.Textbox.SyntaxKeywordColor, layerEntry.CharacterMemory[3][2].AttributeEntry.ForegroundColor, "A keyword was not drawn in the keyword color!")
+	assert.Equalf(test, styleEntry.Textbox.ForegroundColor, layerEntry.CharacterMemory[3][7].AttributeEntry.ForegroundColor, "Plain text was not drawn in the foreground color!")
+	assert.Equalf(test, 4, countingTokenizer.numberOfLines, "Each line was not tokenized exactly once!")
+
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, 4, countingTokenizer.numberOfLines, "Unchanged lines were tokenized again!")
+
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textboxInstance.controlAlias)
+	textboxEntry.TextData[1] = []rune("/* main() {")
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, 7, countingTokenizer.numberOfLines, "Lines following an opened block comment were not tokenized again!")
+	assert.Equalf(test, styleEntry.Textbox.SyntaxCommentColor, layerEntry.CharacterMemory[4][3].AttributeEntry.ForegroundColor, "A line inside a block comment was not drawn in the comment color!")
+
+	textboxInstance.SetSyntaxTokenizer(nil)
+	assert.Falsef(test, textboxSyntaxMemory.IsExists(layer1.layerAlias, textboxInstance.controlAlias), "Removing the tokenizer did not remove its state!")
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, styleEntry.Textbox.ForegroundColor, layerEntry.CharacterMemory[4][3].AttributeEntry.ForegroundColor, "Text was still highlighted after the tokenizer was removed!")
+	textboxInstance.SetSyntaxTokenizer(NewGoTokenizer())
+	textbox.Delete(layer1.layerAlias, textboxInstance.controlAlias)
+	assert.Falsef(test, textboxSyntaxMemory.IsExists(layer1.layerAlias, textboxInstance.controlAlias), "Deleting the textbox did not remove its syntax state!")
+}
+
+/*
+syntaxTestCountingTokenizerType is a structure which wraps a tokenizer and counts how many lines it was asked to
+tokenize.
+*/
+type syntaxTestCountingTokenizerType struct {
+	tokenizer     SyntaxTokenizer
+	numberOfLines int
+}
+
+/*
+TokenizeLine is a method which allows you to tokenize a line with the wrapped tokenizer while counting the call.
+
+Example:
+
+	syntaxSpans, nextState := countingTokenizer.TokenizeLine(line, 0, styleEntry)
+*/
+func (shared *syntaxTestCountingTokenizerType) TokenizeLine(line []rune, previousState int, styleEntry types.TuiStyleEntryType) ([]types.SyntaxSpanType, int) {
+	shared.numberOfLines++
+	return shared.tokenizer.TokenizeLine(line, previousState, styleEntry)
+}
+
+/*
+getSyntaxTestColors is a method which allows you to tokenize a line and obtain the foreground color assigned to each
+of its characters. Characters without a syntax span are reported with a zero color.
+
+Example:
+
+	colors, nextState := getSyntaxTestColors(NewGoTokenizer(), "func main() {", 0, styleEntry)
+*/
+func getSyntaxTestColors(tokenizer SyntaxTokenizer, line string, previousState int, styleEntry types.TuiStyleEntryType) ([]constants.ColorType, int) {
+	lineRunes := []rune(line)
+	colors := make([]constants.ColorType, len(lineRunes))
+	syntaxSpans, nextState := tokenizer.TokenizeLine(lineRunes, previousState, styleEntry)
+	for _, currentSpan := range syntaxSpans {
+		for characterIndex := currentSpan.StartIndex; characterIndex < currentSpan.StartIndex+currentSpan.Length; characterIndex++ {
+			colors[characterIndex] = currentSpan.AttributeEntry.ForegroundColor
+		}
+	}
+	return colors, nextState
+}
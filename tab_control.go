@@ -0,0 +1,758 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+	"strconv"
+)
+
+/*
+TabControlInstanceType is a structure which represents an instance of a tab control.
+
+Example:
+
+	var tabControlInstance TabControlInstanceType
+*/
+type TabControlInstanceType struct {
+	BaseControlInstanceType
+}
+
+type tabControlType struct{}
+
+/*
+TabCloseHandlerType is a type which represents a callback that is called when the close button of a tab is clicked.
+Returning false keeps the tab open, which allows you to ask the user to save their work first.
+*/
+type TabCloseHandlerType func(tabIndex int) bool
+
+/*
+tabCloseHandlerEntryType is a structure which holds the close handler assigned to a tab control.
+*/
+type tabCloseHandlerEntryType struct {
+	handler TabCloseHandlerType
+}
+
+/*
+tabLayoutType is a structure which represents where a tab is drawn in the tab strip. The start is the column of the
+edge to the left of the tab, and the end is the column of the edge to its right.
+*/
+type tabLayoutType struct {
+	tabIndex int
+	start    int
+	end      int
+}
+
+/*
+tabControlCloseButtonLocation is the control location stored in the cell holding the close button of a tab, so that
+mouse clicks on it can be told apart from clicks on the rest of the tab.
+*/
+const tabControlCloseButtonLocation = 1
+
+var TabControl tabControlType
+
+var TabControls = memory.NewControlMemoryManager[types.TabControlEntryType]()
+
+/*
+tabCloseHandlers is a variable which holds the close handler of every tab control which has one assigned, grouped by
+layer.
+*/
+var tabCloseHandlers = memory.NewControlMemoryManager[tabCloseHandlerEntryType]()
+
+/*
+Delete is a method which removes a tab control instance, along with the layers of all of its tabs.
+
+Example:
+
+	tabControl.Delete()
+*/
+func (shared *TabControlInstanceType) Delete() *TabControlInstanceType {
+	shared.BaseControlInstanceType.Delete()
+	return nil
+}
+
+/*
+AddToTabIndex is a method which adds the tab control to the tab index of its associated layer. When the tab control
+receives focus, the user can switch between its tabs with the left and right arrow keys.
+
+Example:
+
+	tabControl.AddToTabIndex()
+*/
+func (shared *TabControlInstanceType) AddToTabIndex() {
+	addTabIndex(shared.layerAlias, shared.controlAlias, constants.CellTypeTabControl)
+}
+
+/*
+AddTab is a method which allows you to add a new tab to a tab control. The layer which holds the page of the tab is
+returned, so that controls can be added to it. If the tab control instance no longer exists, nil is returned. In
+addition, the following should be noted:
+
+  - The layer is a child of the layer the tab control was added to, and fills the area inside the border of the tab
+    control. It is only visible while its tab is selected.
+
+- The first tab added to a tab control is selected automatically.
+
+  - If isClosable is true, a close button is drawn on the tab. Clicking it removes the tab, unless a close handler
+    assigned with SetCloseHandler returns false.
+
+Example:
+
+	generalPage := tabControl.AddTab("General", false)
+	generalPage.AddCheckbox("Enabled", style, 1, 1, true, true)
+*/
+func (shared *TabControlInstanceType) AddTab(label string, isClosable bool) *LayerInstanceType {
+	if !TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		return nil
+	}
+	tabControlEntry := TabControls.Get(shared.layerAlias, shared.controlAlias)
+	tabLayerAlias := getUUID()
+	layer.Add(tabLayerAlias, tabControlEntry.XLocation+1, tabControlEntry.YLocation+3, tabControlEntry.Width-2, tabControlEntry.Height-4, 0, shared.layerAlias)
+	tabControlEntry.Tabs = append(tabControlEntry.Tabs, types.TabEntryType{Label: label, LayerAlias: tabLayerAlias, IsClosable: isClosable})
+	selectedTab := tabControlEntry.SelectedTab
+	if selectedTab == -1 {
+		selectedTab = 0
+	}
+	TabControl.selectTab(shared.layerAlias, shared.controlAlias, selectedTab)
+	return &LayerInstanceType{layerAlias: tabLayerAlias, parentAlias: shared.layerAlias}
+}
+
+/*
+RemoveTab is a method which allows you to remove a tab from a tab control, along with its layer and every control on
+it. If the tab control instance no longer exists, then no operation takes place. In addition, the following should
+be noted:
+
+- If the selected tab is removed, the tab after it is selected instead, or the tab before it if it was the last.
+
+- If the tab does not exist, a panic will be generated.
+
+Example:
+
+	tabControl.RemoveTab(2)
+*/
+func (shared *TabControlInstanceType) RemoveTab(tabIndex int) *TabControlInstanceType {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		validateTabIndex(TabControls.Get(shared.layerAlias, shared.controlAlias), tabIndex)
+		TabControl.removeTab(shared.layerAlias, shared.controlAlias, tabIndex)
+	}
+	return shared
+}
+
+/*
+SelectTab is a method which allows you to select a tab of a tab control, showing its page and hiding all others. If
+the tab control instance no longer exists, then no operation takes place. In addition, the following should be
+noted:
+
+- If a control on a page being hidden has focus, focus is moved to the tab control itself.
+
+- If the tab does not exist, a panic will be generated.
+
+Example:
+
+	tabControl.SelectTab(1)
+*/
+func (shared *TabControlInstanceType) SelectTab(tabIndex int) *TabControlInstanceType {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		validateTabIndex(TabControls.Get(shared.layerAlias, shared.controlAlias), tabIndex)
+		TabControl.selectTab(shared.layerAlias, shared.controlAlias, tabIndex)
+	}
+	return shared
+}
+
+/*
+GetSelectedTab is a method which allows you to obtain the index of the selected tab. If the tab control has no tabs,
+or the tab control instance no longer exists, -1 is returned.
+
+Example:
+
+	tabIndex := tabControl.GetSelectedTab()
+*/
+func (shared *TabControlInstanceType) GetSelectedTab() int {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		return TabControls.Get(shared.layerAlias, shared.controlAlias).SelectedTab
+	}
+	return -1
+}
+
+/*
+GetTabCount is a method which allows you to obtain the number of tabs a tab control has. If the tab control instance
+no longer exists, 0 is returned.
+
+Example:
+
+	numberOfTabs := tabControl.GetTabCount()
+*/
+func (shared *TabControlInstanceType) GetTabCount() int {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		return len(TabControls.Get(shared.layerAlias, shared.controlAlias).Tabs)
+	}
+	return 0
+}
+
+/*
+GetTabLayer is a method which allows you to obtain the layer holding the page of a tab. If the tab control instance
+no longer exists, nil is returned. In addition, the following should be noted:
+
+- If the tab does not exist, a panic will be generated.
+
+Example:
+
+	generalPage := tabControl.GetTabLayer(0)
+*/
+func (shared *TabControlInstanceType) GetTabLayer(tabIndex int) *LayerInstanceType {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		tabControlEntry := TabControls.Get(shared.layerAlias, shared.controlAlias)
+		validateTabIndex(tabControlEntry, tabIndex)
+		return &LayerInstanceType{layerAlias: tabControlEntry.Tabs[tabIndex].LayerAlias, parentAlias: shared.layerAlias}
+	}
+	return nil
+}
+
+/*
+SetTabLabel is a method which allows you to change the label of a tab. If the tab control instance no longer exists,
+then no operation takes place. In addition, the following should be noted:
+
+- If the tab does not exist, a panic will be generated.
+
+Example:
+
+	tabControl.SetTabLabel(0, "Settings")
+*/
+func (shared *TabControlInstanceType) SetTabLabel(tabIndex int, label string) *TabControlInstanceType {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		tabControlEntry := TabControls.Get(shared.layerAlias, shared.controlAlias)
+		validateTabIndex(tabControlEntry, tabIndex)
+		tabControlEntry.Tabs[tabIndex].Label = label
+		TabControl.scrollToSelectedTab(tabControlEntry)
+	}
+	return shared
+}
+
+/*
+SetCloseHandler is a method which allows you to assign a callback which is called when the close button of a tab is
+clicked. If the callback returns false, the tab is kept open. Passing nil removes any close handler, so that tabs
+are always closed when asked. If the tab control instance no longer exists, then no operation takes place.
+
+Example:
+
+	tabControl.SetCloseHandler(func(tabIndex int) bool {
+		return !isDocumentModified[tabIndex]
+	})
+*/
+func (shared *TabControlInstanceType) SetCloseHandler(handler TabCloseHandlerType) *TabControlInstanceType {
+	if TabControls.IsExists(shared.layerAlias, shared.controlAlias) {
+		deleteTabCloseHandler(shared.layerAlias, shared.controlAlias)
+		if handler != nil {
+			tabCloseHandlers.Add(shared.layerAlias, shared.controlAlias, &tabCloseHandlerEntryType{handler: handler})
+		}
+	}
+	return shared
+}
+
+/*
+Add is a method which allows you to add a tab control to a given text layer. Once called, an instance of your control
+is returned which will allow you to read or manipulate the properties for it. In addition, the following should be
+noted:
+
+  - The tab strip takes up the first two rows of the tab control, and a border is drawn around the pages below it.
+    Tabs are added with AddTab, each of which returns the layer holding its page.
+
+  - Tabs can be selected by clicking them. While the tab control or any control on one of its pages has focus,
+    ctrl+tab selects the next tab and ctrl+shift+tab selects the previous one. When the tab control itself has focus,
+    the left and right arrow keys do the same.
+
+  - If the width is less than 4, or the height is less than 5, there is no room for a page inside the border and a
+    panic will be generated.
+
+Example:
+
+	tabControlInstance := TabControl.Add("layer1", "tabControl1", style, 0, 0, 60, 20)
+*/
+func (shared *tabControlType) Add(layerAlias string, tabControlAlias string, styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) TabControlInstanceType {
+	validateTabControlSize(width, height)
+	tabControlEntry := types.NewTabControlEntry()
+	tabControlEntry.Alias = tabControlAlias
+	tabControlEntry.StyleEntry = styleEntry
+	tabControlEntry.XLocation = xLocation
+	tabControlEntry.YLocation = yLocation
+	tabControlEntry.Width = width
+	tabControlEntry.Height = height
+	TabControls.Add(layerAlias, tabControlAlias, &tabControlEntry)
+	var tabControlInstance TabControlInstanceType
+	tabControlInstance.layerAlias = layerAlias
+	tabControlInstance.controlAlias = tabControlAlias
+	tabControlInstance.controlType = constants.TYPE_TABCONTROL
+	return tabControlInstance
+}
+
+/*
+Delete is a method which removes a tab control from a text layer, along with the layers of all of its tabs. In
+addition, the following should be noted:
+
+- If you attempt to delete a tab control which does not exist, then the request will simply be ignored.
+
+Example:
+
+	TabControl.Delete("layer1", "tabControl1")
+*/
+func (shared *tabControlType) Delete(layerAlias string, tabControlAlias string) {
+	if TabControls.IsExists(layerAlias, tabControlAlias) {
+		shared.deleteTabLayers(TabControls.Get(layerAlias, tabControlAlias))
+		TabControls.Remove(layerAlias, tabControlAlias)
+	}
+	deleteTabCloseHandler(layerAlias, tabControlAlias)
+}
+
+/*
+DeleteAll is a method which deletes all tab controls on a given text layer, along with the layers of all of their
+tabs.
+
+Example:
+
+	TabControl.DeleteAll("layer1")
+*/
+func (shared *tabControlType) DeleteAll(layerAlias string) {
+	for _, tabControlEntry := range TabControls.GetAllEntries(layerAlias) {
+		shared.deleteTabLayers(tabControlEntry)
+	}
+	TabControls.RemoveAll(layerAlias)
+	tabCloseHandlers.RemoveAll(layerAlias)
+}
+
+/*
+deleteTabLayers is a method which allows you to delete the layers of every tab of a tab control.
+
+Example:
+
+	TabControl.deleteTabLayers(tabControlEntry)
+*/
+func (shared *tabControlType) deleteTabLayers(tabControlEntry *types.TabControlEntryType) {
+	for _, tab := range tabControlEntry.Tabs {
+		if Layers.IsExists(tab.LayerAlias) {
+			layer.Delete(tab.LayerAlias)
+		}
+	}
+}
+
+/*
+deleteTabCloseHandler is a method which allows you to remove the close handler assigned to a tab control, if any.
+
+Example:
+
+	deleteTabCloseHandler("layer1", "tabControl1")
+*/
+func deleteTabCloseHandler(layerAlias string, tabControlAlias string) {
+	if tabCloseHandlers.IsExists(layerAlias, tabControlAlias) {
+		tabCloseHandlers.Remove(layerAlias, tabControlAlias)
+	}
+}
+
+/*
+drawOnLayer is a method which draws all tab controls on a given text layer.
+
+Example:
+
+	TabControl.drawOnLayer(myLayer)
+*/
+func (shared *tabControlType) drawOnLayer(layerEntry types.LayerEntryType) {
+	layerAlias := layerEntry.LayerAlias
+	for _, tabControlEntry := range TabControls.GetAllEntries(layerAlias) {
+		shared.draw(&layerEntry, tabControlEntry)
+	}
+}
+
+/*
+draw is a method which draws a tab control on a given text layer. In addition, the following should be noted:
+
+  - The edges of the tabs are joined to the border around the pages with the connector characters of the frame
+    style, and the border is left open below the selected tab so that it appears in front of the others.
+
+  - Each cell of a tab stores the index of the tab in its control ID. The cell holding a close button also stores
+    tabControlCloseButtonLocation in its control location.
+
+Example:
+
+	TabControl.draw(&myLayer, tabControlEntry)
+*/
+func (shared *tabControlType) draw(layerEntry *types.LayerEntryType, tabControlEntry *types.TabControlEntryType) {
+	localStyleEntry := types.NewTuiStyleEntry(&tabControlEntry.StyleEntry)
+	frameStyle := localStyleEntry.Frame
+	attributeEntry := types.NewAttributeEntry()
+	attributeEntry.ForegroundColor = localStyleEntry.TabControl.ForegroundColor
+	attributeEntry.BackgroundColor = localStyleEntry.TabControl.BackgroundColor
+	attributeEntry.CellType = constants.CellTypeTabControl
+	attributeEntry.CellControlAlias = tabControlEntry.Alias
+	attributeEntry.CellControlId = constants.NullCellId
+	attributeEntry.CellControlLocation = constants.NullCellControlLocation
+	width := tabControlEntry.Width
+	tabLayouts := shared.getTabLayouts(tabControlEntry)
+	selectedLayout := tabLayoutType{tabIndex: -1, start: -1, end: -1}
+	lastEdge := -1
+	for _, tabLayout := range tabLayouts {
+		if tabLayout.tabIndex == tabControlEntry.SelectedTab {
+			selectedLayout = tabLayout
+		}
+		lastEdge = tabLayout.end
+	}
+	topRow := stringformat.GetFilledRuneArray(width, ' ')
+	labelRow := stringformat.GetFilledRuneArray(width, ' ')
+	borderRow := stringformat.GetFilledRuneArray(width, frameStyle.HorizontalLine)
+	for _, tabLayout := range tabLayouts {
+		topRow[tabLayout.start] = frameStyle.DownSideTConnector
+		labelRow[tabLayout.start] = frameStyle.VerticalLine
+		borderRow[tabLayout.start] = frameStyle.UpSideTConnector
+		for currentColumn := tabLayout.start + 1; currentColumn < tabLayout.end; currentColumn++ {
+			topRow[currentColumn] = frameStyle.HorizontalLine
+		}
+	}
+	if lastEdge != -1 {
+		topRow[0] = frameStyle.UpperLeftCorner
+		topRow[lastEdge] = frameStyle.UpperRightCorner
+		labelRow[lastEdge] = frameStyle.VerticalLine
+		borderRow[lastEdge] = frameStyle.UpSideTConnector
+		borderRow[0] = frameStyle.RightSideTConnector
+	}
+	if selectedLayout.tabIndex != -1 {
+		for currentColumn := selectedLayout.start + 1; currentColumn < selectedLayout.end; currentColumn++ {
+			borderRow[currentColumn] = ' '
+		}
+		borderRow[selectedLayout.start] = frameStyle.LowerRightCorner
+		borderRow[selectedLayout.end] = frameStyle.LowerLeftCorner
+		if selectedLayout.start == 0 {
+			borderRow[0] = frameStyle.VerticalLine
+		}
+	}
+	switch {
+	case lastEdge != width-1:
+		borderRow[width-1] = frameStyle.UpperRightCorner
+	case selectedLayout.end == width-1:
+		borderRow[width-1] = frameStyle.VerticalLine
+	default:
+		borderRow[width-1] = frameStyle.LeftSideTConnector
+	}
+	if lastEdge == -1 {
+		borderRow[0] = frameStyle.UpperLeftCorner
+	}
+	xLocation := tabControlEntry.XLocation
+	yLocation := tabControlEntry.YLocation
+	printLayer(layerEntry, attributeEntry, xLocation, yLocation, topRow)
+	printLayer(layerEntry, attributeEntry, xLocation, yLocation+1, labelRow)
+	printLayer(layerEntry, attributeEntry, xLocation, yLocation+2, borderRow)
+	for _, tabLayout := range tabLayouts {
+		shared.drawTabLabel(layerEntry, localStyleEntry, tabControlEntry, tabLayout)
+	}
+	sideRow := stringformat.GetFilledRuneArray(width, ' ')
+	sideRow[0] = frameStyle.VerticalLine
+	sideRow[width-1] = frameStyle.VerticalLine
+	for currentRow := 3; currentRow < tabControlEntry.Height-1; currentRow++ {
+		printLayer(layerEntry, attributeEntry, xLocation, yLocation+currentRow, sideRow)
+	}
+	bottomRow := stringformat.GetFilledRuneArray(width, frameStyle.HorizontalLine)
+	bottomRow[0] = frameStyle.LowerLeftCorner
+	bottomRow[width-1] = frameStyle.LowerRightCorner
+	printLayer(layerEntry, attributeEntry, xLocation, yLocation+tabControlEntry.Height-1, bottomRow)
+}
+
+/*
+drawTabLabel is a method which draws the label of a single tab, and its close button if it has one. The selected tab
+is drawn in the selected colors of the tab control style.
+
+Example:
+
+	TabControl.drawTabLabel(&myLayer, styleEntry, tabControlEntry, tabLayout)
+*/
+func (shared *tabControlType) drawTabLabel(layerEntry *types.LayerEntryType, styleEntry types.TuiStyleEntryType, tabControlEntry *types.TabControlEntryType, tabLayout tabLayoutType) {
+	attributeEntry := types.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.TabControl.ForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.TabControl.BackgroundColor
+	if tabLayout.tabIndex == tabControlEntry.SelectedTab {
+		attributeEntry.ForegroundColor = styleEntry.TabControl.SelectedForegroundColor
+		attributeEntry.BackgroundColor = styleEntry.TabControl.SelectedBackgroundColor
+	}
+	attributeEntry.CellType = constants.CellTypeTabControl
+	attributeEntry.CellControlAlias = tabControlEntry.Alias
+	attributeEntry.CellControlId = tabLayout.tabIndex
+	tabText := shared.getTabText(styleEntry, tabControlEntry.Tabs[tabLayout.tabIndex])
+	for currentIndex, character := range tabText {
+		attributeEntry.CellControlLocation = constants.NullCellControlLocation
+		if tabControlEntry.Tabs[tabLayout.tabIndex].IsClosable && currentIndex == len(tabText)-2 {
+			attributeEntry.CellControlLocation = tabControlCloseButtonLocation
+		}
+		printLayer(layerEntry, attributeEntry, tabControlEntry.XLocation+tabLayout.start+1+currentIndex, tabControlEntry.YLocation+1, []rune{character})
+	}
+}
+
+/*
+getTabText is a method which allows you to obtain the text drawn between the edges of a tab, made up of its label
+padded by a space on each side, followed by its close button if it has one.
+
+Example:
+
+	tabText := TabControl.getTabText(styleEntry, tab)
+*/
+func (shared *tabControlType) getTabText(styleEntry types.TuiStyleEntryType, tab types.TabEntryType) []rune {
+	tabText := append([]rune{' '}, stringformat.GetRunesFromString(tab.Label)...)
+	if tab.IsClosable {
+		tabText = append(tabText, ' ', styleEntry.TabControl.CloseButton)
+	}
+	return append(tabText, ' ')
+}
+
+/*
+getTabLayouts is a method which allows you to obtain where each tab that fits in the tab strip is drawn, starting from
+the first visible tab. Tabs which do not fit entirely are not drawn.
+
+Example:
+
+	tabLayouts := TabControl.getTabLayouts(tabControlEntry)
+*/
+func (shared *tabControlType) getTabLayouts(tabControlEntry *types.TabControlEntryType) []tabLayoutType {
+	var tabLayouts []tabLayoutType
+	currentColumn := 0
+	for tabIndex := tabControlEntry.FirstVisibleTab; tabIndex < len(tabControlEntry.Tabs); tabIndex++ {
+		tabEnd := currentColumn + len(shared.getTabText(tabControlEntry.StyleEntry, tabControlEntry.Tabs[tabIndex])) + 1
+		if tabEnd > tabControlEntry.Width-1 {
+			break
+		}
+		tabLayouts = append(tabLayouts, tabLayoutType{tabIndex: tabIndex, start: currentColumn, end: tabEnd})
+		currentColumn = tabEnd
+	}
+	return tabLayouts
+}
+
+/*
+scrollToSelectedTab is a method which allows you to scroll the tab strip of a tab control just far enough that its
+selected tab can be seen.
+
+Example:
+
+	TabControl.scrollToSelectedTab(tabControlEntry)
+*/
+func (shared *tabControlType) scrollToSelectedTab(tabControlEntry *types.TabControlEntryType) {
+	if tabControlEntry.SelectedTab < tabControlEntry.FirstVisibleTab {
+		tabControlEntry.FirstVisibleTab = tabControlEntry.SelectedTab
+	}
+	for tabControlEntry.FirstVisibleTab < tabControlEntry.SelectedTab {
+		tabLayouts := shared.getTabLayouts(tabControlEntry)
+		if len(tabLayouts) > 0 && tabLayouts[len(tabLayouts)-1].tabIndex >= tabControlEntry.SelectedTab {
+			break
+		}
+		tabControlEntry.FirstVisibleTab++
+	}
+	if tabControlEntry.FirstVisibleTab < 0 {
+		tabControlEntry.FirstVisibleTab = 0
+	}
+}
+
+/*
+selectTab is a method which allows you to select a tab of a tab control, showing the layer of its page and hiding
+the layers of all others. In addition, the following should be noted:
+
+- If a control on a page being hidden has focus, focus is moved to the tab control itself.
+
+- Passing -1 hides every page, which is used once the last tab has been removed.
+
+Example:
+
+	TabControl.selectTab("layer1", "tabControl1", 1)
+*/
+func (shared *tabControlType) selectTab(layerAlias string, tabControlAlias string, tabIndex int) {
+	tabControlEntry := TabControls.Get(layerAlias, tabControlAlias)
+	tabControlEntry.SelectedTab = tabIndex
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	for currentIndex, tab := range tabControlEntry.Tabs {
+		if !Layers.IsExists(tab.LayerAlias) {
+			continue
+		}
+		setLayerIsVisible(tab.LayerAlias, currentIndex == tabIndex)
+		if currentIndex != tabIndex && isLayerWithinLayer(focusedLayerAlias, tab.LayerAlias) {
+			setFocusedControl(layerAlias, tabControlAlias, constants.CellTypeTabControl)
+		}
+	}
+	shared.scrollToSelectedTab(tabControlEntry)
+}
+
+/*
+selectAdjacentTab is a method which allows you to select the tab after, or before, the one currently selected. The
+selection wraps around from the last tab to the first, and from the first tab to the last.
+
+Example:
+
+	TabControl.selectAdjacentTab("layer1", "tabControl1", true)
+*/
+func (shared *tabControlType) selectAdjacentTab(layerAlias string, tabControlAlias string, isForward bool) {
+	tabControlEntry := TabControls.Get(layerAlias, tabControlAlias)
+	numberOfTabs := len(tabControlEntry.Tabs)
+	if numberOfTabs == 0 {
+		return
+	}
+	tabIndex := tabControlEntry.SelectedTab + 1
+	if !isForward {
+		tabIndex = tabControlEntry.SelectedTab - 1 + numberOfTabs
+	}
+	shared.selectTab(layerAlias, tabControlAlias, tabIndex%numberOfTabs)
+}
+
+/*
+removeTab is a method which allows you to remove a tab from a tab control, along with its layer. If a control on the
+page being removed has focus, focus is moved to the tab control itself.
+
+Example:
+
+	TabControl.removeTab("layer1", "tabControl1", 2)
+*/
+func (shared *tabControlType) removeTab(layerAlias string, tabControlAlias string, tabIndex int) {
+	tabControlEntry := TabControls.Get(layerAlias, tabControlAlias)
+	tabLayerAlias := tabControlEntry.Tabs[tabIndex].LayerAlias
+	if isLayerWithinLayer(eventStateMemory.currentlyFocusedControl.layerAlias, tabLayerAlias) {
+		setFocusedControl(layerAlias, tabControlAlias, constants.CellTypeTabControl)
+	}
+	if Layers.IsExists(tabLayerAlias) {
+		layer.Delete(tabLayerAlias)
+	}
+	tabControlEntry.Tabs = append(tabControlEntry.Tabs[:tabIndex], tabControlEntry.Tabs[tabIndex+1:]...)
+	selectedTab := tabControlEntry.SelectedTab
+	if selectedTab > tabIndex || selectedTab >= len(tabControlEntry.Tabs) {
+		selectedTab--
+	}
+	if tabControlEntry.FirstVisibleTab > 0 && tabControlEntry.FirstVisibleTab >= tabIndex {
+		tabControlEntry.FirstVisibleTab--
+	}
+	shared.selectTab(layerAlias, tabControlAlias, selectedTab)
+}
+
+/*
+closeTab is a method which allows you to close a tab in response to its close button being clicked. If a close
+handler is assigned to the tab control and returns false, the tab is kept open.
+
+Example:
+
+	TabControl.closeTab("layer1", "tabControl1", 2)
+*/
+func (shared *tabControlType) closeTab(layerAlias string, tabControlAlias string, tabIndex int) {
+	if tabCloseHandlers.IsExists(layerAlias, tabControlAlias) && !tabCloseHandlers.Get(layerAlias, tabControlAlias).handler(tabIndex) {
+		return
+	}
+	// The close handler may have removed tabs itself, so the tab is checked again before it is removed.
+	if TabControls.IsExists(layerAlias, tabControlAlias) && tabIndex < len(TabControls.Get(layerAlias, tabControlAlias).Tabs) {
+		shared.removeTab(layerAlias, tabControlAlias, tabIndex)
+	}
+}
+
+/*
+getFocusedTabControl is a method which allows you to find the tab control that keyboard shortcuts for switching tabs
+should apply to. This is the focused tab control itself, or the innermost tab control with a page holding the
+focused control. If there is no such tab control, false is returned.
+
+Example:
+
+	layerAlias, tabControlAlias, isFound := TabControl.getFocusedTabControl()
+*/
+func (shared *tabControlType) getFocusedTabControl() (string, string, bool) {
+	focusedControl := eventStateMemory.currentlyFocusedControl
+	if focusedControl.controlType == constants.CellTypeTabControl && TabControls.IsExists(focusedControl.layerAlias, focusedControl.controlAlias) {
+		return focusedControl.layerAlias, focusedControl.controlAlias, true
+	}
+	for currentLayerAlias := focusedControl.layerAlias; currentLayerAlias != "" && Layers.IsExists(currentLayerAlias); {
+		parentAlias := Layers.Get(currentLayerAlias).ParentAlias
+		for _, tabControlEntry := range TabControls.GetAllEntries(parentAlias) {
+			for _, tab := range tabControlEntry.Tabs {
+				if tab.LayerAlias == currentLayerAlias {
+					return parentAlias, tabControlEntry.Alias, true
+				}
+			}
+		}
+		currentLayerAlias = parentAlias
+	}
+	return "", "", false
+}
+
+/*
+getTabControlState is a method which allows you to obtain a string describing which tab of a tab control is
+selected, so that changes to it can be detected.
+
+Example:
+
+	state := TabControl.getTabControlState(tabControlEntry)
+*/
+func (shared *tabControlType) getTabControlState(tabControlEntry *types.TabControlEntryType) string {
+	return strconv.Itoa(tabControlEntry.SelectedTab)
+}
+
+/*
+updateKeyboardEvent is a method which updates the selected tab of a tab control according to the current keyboard
+event. In addition, the following should be noted:
+
+  - Ctrl+tab and ctrl+shift+tab select the next and previous tab, while the tab control or any control on one of its
+    pages has focus. Some terminals do not report these keys differently from tab, in which case only the arrow keys
+    can be used.
+
+- Left and right select the previous and next tab, while the tab control itself has focus.
+
+Example:
+
+	isUpdate, isConsumed := TabControl.updateKeyboardEvent(keystroke)
+*/
+func (shared *tabControlType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	layerAlias, tabControlAlias, isFound := shared.getFocusedTabControl()
+	if !isFound || !TabControls.Get(layerAlias, tabControlAlias).IsEnabled {
+		return false, false
+	}
+	isTabControlFocused := eventStateMemory.currentlyFocusedControl.controlType == constants.CellTypeTabControl
+	switch string(keystroke) {
+	case "ctrl+tab":
+		shared.selectAdjacentTab(layerAlias, tabControlAlias, true)
+	case "shift+ctrl+tab":
+		shared.selectAdjacentTab(layerAlias, tabControlAlias, false)
+	case "right":
+		if !isTabControlFocused {
+			return false, false
+		}
+		shared.selectAdjacentTab(layerAlias, tabControlAlias, true)
+	case "left":
+		if !isTabControlFocused {
+			return false, false
+		}
+		shared.selectAdjacentTab(layerAlias, tabControlAlias, false)
+	default:
+		return false, false
+	}
+	return true, true
+}
+
+/*
+updateMouseEvent is a method which updates the state of all tab controls according to the current mouse event. In
+addition, the following should be noted:
+
+- Clicking a tab selects it, and clicking the close button of a tab closes it.
+
+Example:
+
+	isUpdateRequired := TabControl.updateMouseEvent()
+*/
+func (shared *tabControlType) updateMouseEvent() bool {
+	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	if buttonPressed == 0 || previousButtonPressed != 0 {
+		return false
+	}
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	layerAlias := characterEntry.LayerAlias
+	tabControlAlias := characterEntry.AttributeEntry.CellControlAlias
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeTabControl || !TabControls.IsExists(layerAlias, tabControlAlias) {
+		return false
+	}
+	setFocusedControl(layerAlias, tabControlAlias, constants.CellTypeTabControl)
+	tabControlEntry := TabControls.Get(layerAlias, tabControlAlias)
+	tabIndex := characterEntry.AttributeEntry.CellControlId
+	if !tabControlEntry.IsEnabled || tabIndex < 0 || tabIndex >= len(tabControlEntry.Tabs) {
+		return true
+	}
+	if characterEntry.AttributeEntry.CellControlLocation == tabControlCloseButtonLocation && tabControlEntry.Tabs[tabIndex].IsClosable {
+		shared.closeTab(layerAlias, tabControlAlias, tabIndex)
+		return true
+	}
+	shared.selectTab(layerAlias, tabControlAlias, tabIndex)
+	return true
+}
@@ -0,0 +1,131 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+TestTabControlKeyboard is a test which verifies that the tabs of a tab control can be switched with the keyboard, and
+that focus and the tab index never land on a control whose page is hidden.
+
+Example:
+
+	Expected Inputs:
+	    A tab control with three pages, switched with ctrl+tab, ctrl+shift+tab, and the arrow keys, while focus is on
+	    the tab control and on a control inside one of its pages.
+
+	Expected Outputs:
+	    The selection cycles through the tabs in both directions, only the selected page is visible, focus moves off a
+	    page when it is hidden, and the tab index skips controls on hidden pages.
+*/
+func TestTabControlKeyboard(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	ClearTabIndex()
+	tabControlInstance := layer1.AddTabControl(styleEntry, 0, 0, 30, 10)
+	assert.Equalf(test, -1, tabControlInstance.GetSelectedTab(), "A tab was selected before any were added!")
+	firstPage := tabControlInstance.AddTab("One", false)
+	secondPage := tabControlInstance.AddTab("Two", true)
+	tabControlInstance.AddTab("Three", false)
+	assert.Equalf(test, 0, tabControlInstance.GetSelectedTab(), "The first tab added was not selected!")
+	assert.Truef(test, Layers.Get(firstPage.layerAlias).IsVisible, "The page of the selected tab was hidden!")
+	assert.Falsef(test, Layers.Get(secondPage.layerAlias).IsVisible, "The page of an unselected tab was shown!")
+	assert.Equalf(test, layer1.layerAlias, Layers.Get(firstPage.layerAlias).ParentAlias, "The page was not a child of the layer holding the tab control!")
+
+	firstButton := firstPage.AddButton("First", styleEntry, 0, 0, 8, 3, true)
+	firstButton.AddToTabIndex()
+	secondButton := secondPage.AddButton("Second", styleEntry, 0, 0, 8, 3, true)
+	secondButton.AddToTabIndex()
+	firstButton.GetFocus()
+	TabControl.updateKeyboardEvent([]rune("ctrl+tab"))
+	assert.Equalf(test, 1, tabControlInstance.GetSelectedTab(), "Ctrl+tab from a control on a page did not select the next tab!")
+	assert.Truef(test, Layers.Get(secondPage.layerAlias).IsVisible, "The page of the newly selected tab was not shown!")
+	assert.Falsef(test, Layers.Get(firstPage.layerAlias).IsVisible, "The page of the previously selected tab was not hidden!")
+	assert.Equalf(test, constants.CellTypeTabControl, eventStateMemory.currentlyFocusedControl.controlType, "Focus stayed on a control whose page was hidden!")
+	for attempt := 0; attempt < 4; attempt++ {
+		nextTabIndex()
+		assert.NotEqualf(test, firstButton.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "The tab index focused a control whose page was hidden!")
+	}
+	assert.Equalf(test, secondButton.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "The tab index did not focus the control on the shown page!")
+
+	tabControlInstance.GetFocus()
+	TabControl.updateKeyboardEvent([]rune("shift+ctrl+tab"))
+	TabControl.updateKeyboardEvent([]rune("shift+ctrl+tab"))
+	assert.Equalf(test, 2, tabControlInstance.GetSelectedTab(), "Ctrl+shift+tab did not wrap around to the last tab!")
+	TabControl.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 0, tabControlInstance.GetSelectedTab(), "Right did not wrap around to the first tab!")
+	secondButton.GetFocus()
+	isUpdated, _ := TabControl.updateKeyboardEvent([]rune("right"))
+	assert.Falsef(test, isUpdated, "Right switched tabs while a control on a page had focus!")
+
+	tabControlInstance.RemoveTab(0)
+	assert.Falsef(test, Layers.IsExists(firstPage.layerAlias), "The page of a removed tab was not deleted!")
+	assert.Equalf(test, 0, tabControlInstance.GetSelectedTab(), "The tab after a removed selected tab was not selected!")
+	assert.Truef(test, Layers.Get(secondPage.layerAlias).IsVisible, "The page of the tab selected after a removal was not shown!")
+	tabControlInstance.Delete()
+	assert.Falsef(test, Layers.IsExists(secondPage.layerAlias), "The pages of a deleted tab control were not deleted!")
+	assert.Panicsf(test, func() { layer1.AddTabControl(styleEntry, 0, 0, 3, 10) }, "A tab control too narrow for a page did not panic!")
+}
+
+/*
+TestTabControlMouse is a test which verifies that a tab control draws its tab strip, and that its tabs can be selected
+and closed with the mouse.
+
+Example:
+
+	Expected Inputs:
+	    A tab control with three tabs, one of which is closable, whose labels and close button are clicked, with a close
+	    handler which refuses the first request to close.
+
+	Expected Outputs:
+	    The tab strip is joined to the border of the page with connector characters, clicking a label selects its tab,
+	    the close handler can keep a tab open, and clicking the close button otherwise removes the tab.
+*/
+func TestTabControlMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	tabControlInstance := layer1.AddTabControl(styleEntry, 0, 0, 30, 10)
+	firstPage := tabControlInstance.AddTab("One", false)
+	firstPage.AddLabel("Hello", styleEntry, 0, 0, 5)
+	tabControlInstance.AddTab("Two", true)
+	tabControlInstance.AddTab("Three", false)
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	frameStyle := styleEntry.Frame
+	assert.Equalf(test, []rune{frameStyle.UpperLeftCorner, frameStyle.DownSideTConnector, frameStyle.DownSideTConnector, frameStyle.UpperRightCorner},
+		[]rune{layerEntry.CharacterMemory[0][0].Character, layerEntry.CharacterMemory[0][6].Character, layerEntry.CharacterMemory[0][14].Character, layerEntry.CharacterMemory[0][22].Character},
+		"The tops of the tabs were not drawn!")
+	assert.Equalf(test, 'O', layerEntry.CharacterMemory[1][2].Character, "The label of a tab was not drawn!")
+	assert.Equalf(test, styleEntry.TabControl.CloseButton, layerEntry.CharacterMemory[1][12].Character, "The close button of a closable tab was not drawn!")
+	assert.Equalf(test, []rune{frameStyle.VerticalLine, ' ', frameStyle.LowerLeftCorner, frameStyle.UpSideTConnector, frameStyle.UpperRightCorner},
+		[]rune{layerEntry.CharacterMemory[2][0].Character, layerEntry.CharacterMemory[2][3].Character, layerEntry.CharacterMemory[2][6].Character, layerEntry.CharacterMemory[2][14].Character, layerEntry.CharacterMemory[2][29].Character},
+		"The border was not left open below the selected tab!")
+	assert.Equalf(test, 'H', layerEntry.CharacterMemory[3][1].Character, "The page of the selected tab was not drawn inside the border!")
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(17, 1, 1, "")
+	TabControl.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, 2, tabControlInstance.GetSelectedTab(), "Clicking the label of a tab did not select it!")
+	assert.Equalf(test, tabControlInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Clicking the tab control did not focus it!")
+	assert.NotEqualf(test, 'H', layerEntry.CharacterMemory[3][1].Character, "The page of an unselected tab was still drawn!")
+	assert.Equalf(test, []rune{frameStyle.RightSideTConnector, frameStyle.LowerRightCorner, frameStyle.LowerLeftCorner},
+		[]rune{layerEntry.CharacterMemory[2][0].Character, layerEntry.CharacterMemory[2][14].Character, layerEntry.CharacterMemory[2][22].Character},
+		"The border was not opened below the newly selected tab!")
+
+	closeRequests := 0
+	tabControlInstance.SetCloseHandler(func(tabIndex int) bool {
+		closeRequests++
+		return closeRequests > 1
+	})
+	SetMouseStatus(17, 1, 0, "")
+	SetMouseStatus(12, 1, 1, "")
+	TabControl.updateMouseEvent()
+	assert.Equalf(test, 3, tabControlInstance.GetTabCount(), "A tab was closed when its close handler refused!")
+	SetMouseStatus(12, 1, 0, "")
+	SetMouseStatus(12, 1, 1, "")
+	TabControl.updateMouseEvent()
+	assert.Equalf(test, 2, tabControlInstance.GetTabCount(), "Clicking the close button did not close the tab!")
+	assert.Equalf(test, 1, tabControlInstance.GetSelectedTab(), "The selected tab did not follow the removal of a tab before it!")
+	assert.Equalf(test, 2, closeRequests, "The close handler was not given every request to close!")
+}
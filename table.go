@@ -0,0 +1,1094 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+TableInstanceType is a structure which represents an instance of a table control.
+
+Example:
+
+	var tableInstance TableInstanceType
+*/
+type TableInstanceType struct {
+	BaseControlInstanceType
+}
+
+type tableType struct{}
+
+/*
+TableDataSource is an interface which allows you to supply the rows of a table. In addition, the following should be
+noted:
+
+  - Values are only requested for the rows currently being drawn, so a data source can serve a very large number of
+    rows without holding them all in memory.
+
+  - If the rows of a data source change, call RefreshData on the table so that it can sort them again and keep its
+    selection within range.
+*/
+type TableDataSource interface {
+	GetRowCount() int
+	GetCellValue(rowIndex int, columnIndex int) string
+}
+
+/*
+SortableTableDataSource is an interface which allows a data source to sort its own rows. In addition, the following
+should be noted:
+
+  - If a data source does not implement this interface, the table sorts it by reading every value of the column being
+    sorted. Large data sources which can sort more efficiently, such as those backed by a database, should implement
+    it instead.
+
+  - Since the table cannot know where a row has been moved to, the selection of a table is cleared when its data
+    source sorts itself.
+*/
+type SortableTableDataSource interface {
+	TableDataSource
+	SortRows(columnIndex int, isDescending bool)
+}
+
+/*
+tableDataEntryType is a structure which holds the data source assigned to a table, along with the order its rows are
+displayed in once sorted. A nil row order means the rows are displayed in the order of the data source.
+*/
+type tableDataEntryType struct {
+	dataSource TableDataSource
+	rowOrder   []int
+}
+
+/*
+stringTableDataSourceType is a structure which represents a data source holding all of its rows in memory.
+*/
+type stringTableDataSourceType struct {
+	rows [][]string
+}
+
+/*
+tableHeaderRowId is the control ID stored in the cells of a table header, so that mouse clicks on it can be told
+apart from clicks on a row.
+*/
+const tableHeaderRowId = -2
+
+var Table tableType
+
+var Tables = memory.NewControlMemoryManager[types.TableEntryType]()
+
+/*
+tableDataMemory is a variable which holds the data source of every table, grouped by layer.
+*/
+var tableDataMemory = memory.NewControlMemoryManager[tableDataEntryType]()
+
+/*
+NewStringTableDataSource is a method which allows you to create a table data source from rows which are already held
+in memory. In addition, the following should be noted:
+
+- Rows with fewer values than the table has columns are shown with the remaining cells left empty.
+
+Example:
+
+	dataSource := NewStringTableDataSource([][]string{{"Alice", "42"}, {"Bob", "37"}})
+*/
+func NewStringTableDataSource(rows [][]string) TableDataSource {
+	return &stringTableDataSourceType{rows: rows}
+}
+
+/*
+GetRowCount is a method which returns the number of rows held by the data source.
+
+Example:
+
+	rowCount := dataSource.GetRowCount()
+*/
+func (shared *stringTableDataSourceType) GetRowCount() int {
+	return len(shared.rows)
+}
+
+/*
+GetCellValue is a method which returns the value of a single cell held by the data source. If the cell does not
+exist, an empty string is returned.
+
+Example:
+
+	value := dataSource.GetCellValue(0, 1)
+*/
+func (shared *stringTableDataSourceType) GetCellValue(rowIndex int, columnIndex int) string {
+	if rowIndex < 0 || rowIndex >= len(shared.rows) || columnIndex < 0 || columnIndex >= len(shared.rows[rowIndex]) {
+		return ""
+	}
+	return shared.rows[rowIndex][columnIndex]
+}
+
+/*
+Delete is a method which removes a table instance, along with its scroll bars.
+
+Example:
+
+	table.Delete()
+*/
+func (shared *TableInstanceType) Delete() *TableInstanceType {
+	shared.BaseControlInstanceType.Delete()
+	return nil
+}
+
+/*
+AddToTabIndex is a method which adds the table to the tab index of its associated layer. When the table receives
+focus, the user can move between its rows with the arrow keys.
+
+Example:
+
+	table.AddToTabIndex()
+*/
+func (shared *TableInstanceType) AddToTabIndex() {
+	addTabIndex(shared.layerAlias, shared.controlAlias, constants.CellTypeTable)
+}
+
+/*
+SetDataSource is a method which allows you to replace the data source of a table. If the table instance no longer
+exists, then no operation takes place. In addition, the following should be noted:
+
+- The selection is cleared and the table is scrolled back to its first row.
+
+- If the table is sorted, the rows of the new data source are sorted the same way.
+
+Example:
+
+	table.SetDataSource(NewStringTableDataSource(rows))
+*/
+func (shared *TableInstanceType) SetDataSource(dataSource TableDataSource) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		tableDataMemory.Get(shared.layerAlias, shared.controlAlias).dataSource = dataSource
+		tableEntry.SelectedRow = -1
+		tableEntry.ViewportRow = 0
+		Table.sortRows(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+RefreshData is a method which allows you to tell a table that the rows of its data source have changed. If the table
+instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- If the table is sorted, its rows are sorted again. The selected row is kept if it still exists.
+
+Example:
+
+	table.RefreshData()
+*/
+func (shared *TableInstanceType) RefreshData() *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		Table.sortRows(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+SetColumns is a method which allows you to replace the columns of a table. If the table instance no longer exists,
+then no operation takes place. In addition, the following should be noted:
+
+- If the table was sorted by a column which no longer exists, the sort is cleared.
+
+  - If no columns are given, or any column has a width of less than 1 or an unknown alignment, a panic will be
+    generated.
+
+Example:
+
+	table.SetColumns([]types.TableColumnEntryType{{Header: "Name", Width: 20, Alignment: constants.AlignmentLeft}})
+*/
+func (shared *TableInstanceType) SetColumns(columns []types.TableColumnEntryType) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		validateTableColumns(columns)
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		tableEntry.Columns = append([]types.TableColumnEntryType{}, columns...)
+		if tableEntry.SortColumn >= len(tableEntry.Columns) {
+			tableEntry.SortColumn = -1
+		}
+		Table.sortRows(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+SetColumnWidth is a method which allows you to change the width of a single column of a table. If the table instance
+no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- If the column does not exist, or the width is less than 1, a panic will be generated.
+
+Example:
+
+	table.SetColumnWidth(0, 30)
+*/
+func (shared *TableInstanceType) SetColumnWidth(columnIndex int, width int) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		validateTableColumnIndex(tableEntry, columnIndex)
+		column := tableEntry.Columns[columnIndex]
+		column.Width = width
+		validateTableColumns([]types.TableColumnEntryType{column})
+		tableEntry.Columns[columnIndex] = column
+		Table.setViewport(shared.layerAlias, shared.controlAlias, tableEntry.ViewportRow, tableEntry.ViewportColumn)
+	}
+	return shared
+}
+
+/*
+SetColumnAlignment is a method which allows you to change how the values of a single column of a table are aligned.
+If the table instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+  - The alignment should be one of constants.AlignmentLeft, constants.AlignmentRight, or constants.AlignmentCenter.
+    If the column does not exist, or the alignment is unknown, a panic will be generated.
+
+Example:
+
+	table.SetColumnAlignment(1, constants.AlignmentRight)
+*/
+func (shared *TableInstanceType) SetColumnAlignment(columnIndex int, alignment int) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		validateTableColumnIndex(tableEntry, columnIndex)
+		column := tableEntry.Columns[columnIndex]
+		column.Alignment = alignment
+		validateTableColumns([]types.TableColumnEntryType{column})
+		tableEntry.Columns[columnIndex] = column
+	}
+	return shared
+}
+
+/*
+SortByColumn is a method which allows you to sort the rows of a table by the values of one of its columns. If the
+table instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+  - Values which are all numbers are compared as numbers, while any other values are compared as text without regard
+    to case. Rows with equal values keep their original order.
+
+  - If the data source implements SortableTableDataSource, it is asked to sort itself instead.
+
+- If the column does not exist, a panic will be generated.
+
+Example:
+
+	table.SortByColumn(1, true)
+*/
+func (shared *TableInstanceType) SortByColumn(columnIndex int, isDescending bool) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		validateTableColumnIndex(tableEntry, columnIndex)
+		tableEntry.SortColumn = columnIndex
+		tableEntry.IsSortDescending = isDescending
+		Table.sortRows(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+ClearSort is a method which allows you to show the rows of a table in the order of its data source again. If the
+table instance no longer exists, then no operation takes place.
+
+Example:
+
+	table.ClearSort()
+*/
+func (shared *TableInstanceType) ClearSort() *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		Tables.Get(shared.layerAlias, shared.controlAlias).SortColumn = -1
+		Table.sortRows(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+GetSortColumn is a method which allows you to obtain the column a table is sorted by, and whether it is sorted in
+descending order. If the table is not sorted, or the table instance no longer exists, a column of -1 is returned.
+
+Example:
+
+	columnIndex, isDescending := table.GetSortColumn()
+*/
+func (shared *TableInstanceType) GetSortColumn() (int, bool) {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		return tableEntry.SortColumn, tableEntry.IsSortDescending
+	}
+	return -1, false
+}
+
+/*
+SetFrozenRows is a method which allows you to keep a number of rows at the top of a table in place, just below its
+header, while the rest of its rows are scrolled. If the table instance no longer exists, then no operation takes
+place. In addition, the following should be noted:
+
+- The header of a table is always kept in place, whether or not any rows are frozen.
+
+  - Frozen rows are the first rows in display order, so they follow the sort of the table. If the number of rows
+    would leave no room for the rest of the table to scroll, a panic will be generated.
+
+Example:
+
+	table.SetFrozenRows(1)
+*/
+func (shared *TableInstanceType) SetFrozenRows(numberOfRows int) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		validateTableFrozenRows(tableEntry, numberOfRows)
+		tableEntry.FrozenRowCount = numberOfRows
+		Table.setViewport(shared.layerAlias, shared.controlAlias, tableEntry.ViewportRow, tableEntry.ViewportColumn)
+	}
+	return shared
+}
+
+/*
+GetSelectedRow is a method which allows you to obtain the row of the data source which is currently selected. If no
+row is selected, or the table instance no longer exists, -1 is returned.
+
+Example:
+
+	rowIndex := table.GetSelectedRow()
+*/
+func (shared *TableInstanceType) GetSelectedRow() int {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		return Table.getSelectedSourceRow(shared.layerAlias, shared.controlAlias)
+	}
+	return -1
+}
+
+/*
+SetSelectedRow is a method which allows you to select a row of a table by its index in the data source, scrolling the
+table so that it can be seen. If the table instance no longer exists, then no operation takes place. In addition,
+the following should be noted:
+
+- Passing -1, or a row which does not exist, clears the selection.
+
+Example:
+
+	table.SetSelectedRow(25)
+*/
+func (shared *TableInstanceType) SetSelectedRow(rowIndex int) *TableInstanceType {
+	if Tables.IsExists(shared.layerAlias, shared.controlAlias) {
+		tableEntry := Tables.Get(shared.layerAlias, shared.controlAlias)
+		tableEntry.SelectedRow = Table.getDisplayRow(tableDataMemory.Get(shared.layerAlias, shared.controlAlias), rowIndex)
+		Table.scrollToSelectedRow(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+Add is a method which allows you to add a table to a given text layer. Once called, an instance of your control is
+returned which will allow you to read or manipulate the properties for it. In addition, the following should be
+noted:
+
+  - The first row of the table holds the column headers, which stay in place while the table is scrolled. Clicking a
+    header sorts the table by that column, and clicking it again reverses the sort.
+
+  - A vertical scroll bar is drawn to the right of the table when its rows do not fit, and a horizontal scroll bar is
+    drawn below it when its columns do not fit.
+
+  - Up, down, page up, page down, home, and end move the selection between rows, left and right scroll the table by
+    one column, and enter calls the submit handler of the table.
+
+  - If no columns are given, any column is invalid, or the table is narrower or shorter than its scroll bars can be
+    drawn at, a panic will be generated.
+
+Example:
+
+	tableInstance := Table.Add("layer1", "table1", style, columns, NewStringTableDataSource(rows), 2, 2, 40, 10)
+*/
+func (shared *tableType) Add(layerAlias string, tableAlias string, styleEntry types.TuiStyleEntryType, columns []types.TableColumnEntryType, dataSource TableDataSource, xLocation int, yLocation int, width int, height int) TableInstanceType {
+	validateTableSize(width, height)
+	validateTableColumns(columns)
+	tableEntry := types.NewTableEntry()
+	tableEntry.Alias = tableAlias
+	tableEntry.StyleEntry = styleEntry
+	tableEntry.XLocation = xLocation
+	tableEntry.YLocation = yLocation
+	tableEntry.Width = width
+	tableEntry.Height = height
+	tableEntry.Columns = append([]types.TableColumnEntryType{}, columns...)
+	tableEntry.VerticalScrollbarAlias = stringformat.GetLastSortedUUID()
+	tableEntry.HorizontalScrollbarAlias = stringformat.GetLastSortedUUID()
+	Tables.Add(layerAlias, tableAlias, &tableEntry)
+	tableDataMemory.Add(layerAlias, tableAlias, &tableDataEntryType{dataSource: dataSource})
+
+	scrollbar.Add(layerAlias, tableEntry.VerticalScrollbarAlias, styleEntry, xLocation+width, yLocation, height, 0, 0, 1, false)
+	scrollbar.Add(layerAlias, tableEntry.HorizontalScrollbarAlias, styleEntry, xLocation, yLocation+height, width, 0, 0, 1, true)
+	for _, scrollbarAlias := range []string{tableEntry.VerticalScrollbarAlias, tableEntry.HorizontalScrollbarAlias} {
+		scrollbarEntry := ScrollBars.Get(layerAlias, scrollbarAlias)
+		scrollbarEntry.ParentControlAlias = tableAlias
+		scrollbarEntry.ParentControlType = constants.CellTypeTable
+	}
+	shared.updateScrollbars(layerAlias, tableAlias)
+
+	var tableInstance TableInstanceType
+	tableInstance.layerAlias = layerAlias
+	tableInstance.controlAlias = tableAlias
+	tableInstance.controlType = constants.TYPE_TABLE
+	return tableInstance
+}
+
+/*
+Delete is a method which removes a table from a text layer, along with its scroll bars. In addition, the following
+should be noted:
+
+- If you attempt to delete a table which does not exist, then the request will simply be ignored.
+
+Example:
+
+	Table.Delete("layer1", "table1")
+*/
+func (shared *tableType) Delete(layerAlias string, tableAlias string) {
+	if Tables.IsExists(layerAlias, tableAlias) {
+		shared.deleteScrollbars(layerAlias, Tables.Get(layerAlias, tableAlias))
+		Tables.Remove(layerAlias, tableAlias)
+	}
+	if tableDataMemory.IsExists(layerAlias, tableAlias) {
+		tableDataMemory.Remove(layerAlias, tableAlias)
+	}
+}
+
+/*
+DeleteAll is a method which deletes all tables on a given text layer, along with their scroll bars.
+
+Example:
+
+	Table.DeleteAll("layer1")
+*/
+func (shared *tableType) DeleteAll(layerAlias string) {
+	for _, tableEntry := range Tables.GetAllEntries(layerAlias) {
+		shared.deleteScrollbars(layerAlias, tableEntry)
+	}
+	Tables.RemoveAll(layerAlias)
+	tableDataMemory.RemoveAll(layerAlias)
+}
+
+/*
+deleteScrollbars is a method which allows you to remove both scroll bars belonging to a table.
+
+Example:
+
+	Table.deleteScrollbars("layer1", tableEntry)
+*/
+func (shared *tableType) deleteScrollbars(layerAlias string, tableEntry *types.TableEntryType) {
+	for _, scrollbarAlias := range []string{tableEntry.VerticalScrollbarAlias, tableEntry.HorizontalScrollbarAlias} {
+		if ScrollBars.IsExists(layerAlias, scrollbarAlias) {
+			ScrollBars.Remove(layerAlias, scrollbarAlias)
+		}
+	}
+}
+
+/*
+drawOnLayer is a method which draws all tables on a given text layer.
+
+Example:
+
+	Table.drawOnLayer(myLayer)
+*/
+func (shared *tableType) drawOnLayer(layerEntry types.LayerEntryType) {
+	layerAlias := layerEntry.LayerAlias
+	for _, tableEntry := range Tables.GetAllEntries(layerAlias) {
+		shared.draw(&layerEntry, tableEntry, tableDataMemory.Get(layerAlias, tableEntry.Alias))
+	}
+}
+
+/*
+draw is a method which draws a table on a given text layer, along with its scroll bars. In addition, the following
+should be noted:
+
+  - Each cell stores the row it belongs to in its control ID, and the column it belongs to in its control location, so
+    that mouse clicks can tell which row or header was clicked. Header cells use a control ID of tableHeaderRowId, and
+    column separators use a control location of constants.NullCellControlLocation.
+
+Example:
+
+	Table.draw(&myLayer, tableEntry, dataEntry)
+*/
+func (shared *tableType) draw(layerEntry *types.LayerEntryType, tableEntry *types.TableEntryType, dataEntry *tableDataEntryType) {
+	localStyleEntry := types.NewTuiStyleEntry(&tableEntry.StyleEntry)
+	rowCount := shared.getRowCount(dataEntry)
+	attributeEntry := types.NewAttributeEntry()
+	attributeEntry.CellType = constants.CellTypeTable
+	attributeEntry.CellControlAlias = tableEntry.Alias
+	for currentRow := 0; currentRow < tableEntry.Height; currentRow++ {
+		var lineText []rune
+		var lineColumns []int
+		attributeEntry.ForegroundColor = localStyleEntry.Selector.ForegroundColor
+		attributeEntry.BackgroundColor = localStyleEntry.Selector.BackgroundColor
+		attributeEntry.CellControlId = constants.NullCellId
+		if currentRow == 0 {
+			attributeEntry.ForegroundColor = localStyleEntry.Table.HeaderForegroundColor
+			attributeEntry.BackgroundColor = localStyleEntry.Table.HeaderBackgroundColor
+			attributeEntry.CellControlId = tableHeaderRowId
+			lineText, lineColumns = shared.getLineText(localStyleEntry, tableEntry, func(columnIndex int) []rune {
+				return shared.getHeaderText(localStyleEntry, tableEntry, columnIndex)
+			})
+		} else if displayRow := shared.getDisplayRowOnScreen(tableEntry, currentRow); displayRow < rowCount {
+			if displayRow == tableEntry.SelectedRow {
+				attributeEntry.ForegroundColor = localStyleEntry.Selector.HighlightForegroundColor
+				attributeEntry.BackgroundColor = localStyleEntry.Selector.HighlightBackgroundColor
+			}
+			attributeEntry.CellControlId = displayRow
+			sourceRow := shared.getSourceRow(dataEntry, displayRow)
+			lineText, lineColumns = shared.getLineText(localStyleEntry, tableEntry, func(columnIndex int) []rune {
+				return stringformat.GetRunesFromString(dataEntry.dataSource.GetCellValue(sourceRow, columnIndex))
+			})
+		}
+		for currentColumn := 0; currentColumn < tableEntry.Width; currentColumn++ {
+			character := ' '
+			attributeEntry.CellControlLocation = constants.NullCellControlLocation
+			if lineIndex := tableEntry.ViewportColumn + currentColumn; lineIndex < len(lineText) {
+				character = lineText[lineIndex]
+				attributeEntry.CellControlLocation = lineColumns[lineIndex]
+			}
+			printLayer(layerEntry, attributeEntry, tableEntry.XLocation+currentColumn, tableEntry.YLocation+currentRow, []rune{character})
+		}
+	}
+	scrollbar.drawOnLayerByAlias(layerEntry, tableEntry.VerticalScrollbarAlias)
+	scrollbar.drawOnLayerByAlias(layerEntry, tableEntry.HorizontalScrollbarAlias)
+}
+
+/*
+getLineText is a method which allows you to obtain the full text of a table line, with every column padded to its
+width and separated from the next. The column each character belongs to is returned alongside it, with separators
+marked as constants.NullCellControlLocation.
+
+Example:
+
+	lineText, lineColumns := Table.getLineText(styleEntry, tableEntry, getCellText)
+*/
+func (shared *tableType) getLineText(styleEntry types.TuiStyleEntryType, tableEntry *types.TableEntryType, getCellText func(columnIndex int) []rune) ([]rune, []int) {
+	var lineText []rune
+	var lineColumns []int
+	for columnIndex, column := range tableEntry.Columns {
+		if columnIndex > 0 {
+			lineText = append(lineText, styleEntry.Frame.VerticalLine)
+			lineColumns = append(lineColumns, constants.NullCellControlLocation)
+		}
+		cellText := stringformat.GetFormattedRuneArray(getCellText(columnIndex), column.Width, column.Alignment)
+		for currentIndex := 0; currentIndex < column.Width; currentIndex++ {
+			// Wide characters can leave a formatted cell with fewer runes than cells, so the rest is padded.
+			character := ' '
+			if currentIndex < len(cellText) {
+				character = cellText[currentIndex]
+			}
+			lineText = append(lineText, character)
+			lineColumns = append(lineColumns, columnIndex)
+		}
+	}
+	return lineText, lineColumns
+}
+
+/*
+getHeaderText is a method which allows you to obtain the header text of a table column. If the table is sorted by
+the column, the last cell of the header holds a glyph showing the direction of the sort.
+
+Example:
+
+	headerText := Table.getHeaderText(styleEntry, tableEntry, 0)
+*/
+func (shared *tableType) getHeaderText(styleEntry types.TuiStyleEntryType, tableEntry *types.TableEntryType, columnIndex int) []rune {
+	column := tableEntry.Columns[columnIndex]
+	headerText := stringformat.GetRunesFromString(column.Header)
+	if columnIndex != tableEntry.SortColumn {
+		return headerText
+	}
+	sortGlyph := styleEntry.Table.SortAscendingGlyph
+	if tableEntry.IsSortDescending {
+		sortGlyph = styleEntry.Table.SortDescendingGlyph
+	}
+	headerText = stringformat.GetFormattedRuneArray(headerText, column.Width-1, column.Alignment)
+	return append(headerText, sortGlyph)
+}
+
+/*
+getTotalWidth is a method which allows you to obtain the number of cells needed to draw every column of a table,
+including the separators between them.
+
+Example:
+
+	totalWidth := Table.getTotalWidth(tableEntry)
+*/
+func (shared *tableType) getTotalWidth(tableEntry *types.TableEntryType) int {
+	totalWidth := len(tableEntry.Columns) - 1
+	for _, column := range tableEntry.Columns {
+		totalWidth += column.Width
+	}
+	return totalWidth
+}
+
+/*
+getColumnStart is a method which allows you to obtain the position of the first cell of a column, counted from the
+left edge of the first column.
+
+Example:
+
+	columnStart := Table.getColumnStart(tableEntry, 2)
+*/
+func (shared *tableType) getColumnStart(tableEntry *types.TableEntryType, columnIndex int) int {
+	columnStart := columnIndex
+	for _, column := range tableEntry.Columns[:columnIndex] {
+		columnStart += column.Width
+	}
+	return columnStart
+}
+
+/*
+getRowCount is a method which allows you to obtain the number of rows in the data source of a table. A table without
+a data source has no rows.
+
+Example:
+
+	rowCount := Table.getRowCount(dataEntry)
+*/
+func (shared *tableType) getRowCount(dataEntry *tableDataEntryType) int {
+	if dataEntry.dataSource == nil {
+		return 0
+	}
+	return dataEntry.dataSource.GetRowCount()
+}
+
+/*
+getSourceRow is a method which allows you to convert a row as it is displayed into its row in the data source. If the
+row order is out of date because the number of rows has changed, the rows are treated as unsorted until the table is
+refreshed.
+
+Example:
+
+	sourceRow := Table.getSourceRow(dataEntry, 5)
+*/
+func (shared *tableType) getSourceRow(dataEntry *tableDataEntryType, displayRow int) int {
+	if displayRow >= 0 && displayRow < len(dataEntry.rowOrder) && len(dataEntry.rowOrder) == shared.getRowCount(dataEntry) {
+		return dataEntry.rowOrder[displayRow]
+	}
+	return displayRow
+}
+
+/*
+getDisplayRow is a method which allows you to convert a row of the data source into the row it is displayed at. If
+the row does not exist, -1 is returned.
+
+Example:
+
+	displayRow := Table.getDisplayRow(dataEntry, 12)
+*/
+func (shared *tableType) getDisplayRow(dataEntry *tableDataEntryType, sourceRow int) int {
+	rowCount := shared.getRowCount(dataEntry)
+	if sourceRow < 0 || sourceRow >= rowCount {
+		return -1
+	}
+	if len(dataEntry.rowOrder) != rowCount {
+		return sourceRow
+	}
+	for displayRow, orderedRow := range dataEntry.rowOrder {
+		if orderedRow == sourceRow {
+			return displayRow
+		}
+	}
+	return -1
+}
+
+/*
+getDisplayRowOnScreen is a method which allows you to obtain the displayed row drawn on a given line of a table,
+where line 0 is the header. Frozen rows are drawn first, followed by the scrolled rows.
+
+Example:
+
+	displayRow := Table.getDisplayRowOnScreen(tableEntry, 3)
+*/
+func (shared *tableType) getDisplayRowOnScreen(tableEntry *types.TableEntryType, lineIndex int) int {
+	if lineIndex-1 < tableEntry.FrozenRowCount {
+		return lineIndex - 1
+	}
+	return lineIndex - 1 + tableEntry.ViewportRow
+}
+
+/*
+getSelectedSourceRow is a method which allows you to obtain the row of the data source which is selected in a table,
+or -1 if no row is selected.
+
+Example:
+
+	sourceRow := Table.getSelectedSourceRow("layer1", "table1")
+*/
+func (shared *tableType) getSelectedSourceRow(layerAlias string, tableAlias string) int {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	dataEntry := tableDataMemory.Get(layerAlias, tableAlias)
+	if tableEntry.SelectedRow < 0 || tableEntry.SelectedRow >= shared.getRowCount(dataEntry) {
+		return -1
+	}
+	return shared.getSourceRow(dataEntry, tableEntry.SelectedRow)
+}
+
+/*
+getTableState is a method which allows you to obtain a string describing the selection of a table, so that changes
+to it can be detected.
+
+Example:
+
+	state := Table.getTableState("layer1", "table1")
+*/
+func (shared *tableType) getTableState(layerAlias string, tableAlias string) string {
+	return strconv.Itoa(shared.getSelectedSourceRow(layerAlias, tableAlias))
+}
+
+/*
+sortRows is a method which allows you to bring the row order of a table in line with its sort column and its data
+source. In addition, the following should be noted:
+
+  - The selected row is kept selected after sorting, unless the data source sorts itself, in which case the selection
+    is cleared.
+
+- The viewport is kept within range and the scroll bars are updated to match.
+
+Example:
+
+	Table.sortRows("layer1", "table1")
+*/
+func (shared *tableType) sortRows(layerAlias string, tableAlias string) {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	dataEntry := tableDataMemory.Get(layerAlias, tableAlias)
+	selectedSourceRow := shared.getSelectedSourceRow(layerAlias, tableAlias)
+	rowCount := shared.getRowCount(dataEntry)
+	dataEntry.rowOrder = nil
+	if tableEntry.SortColumn != -1 && dataEntry.dataSource != nil {
+		if sortableDataSource, isSortable := dataEntry.dataSource.(SortableTableDataSource); isSortable {
+			sortableDataSource.SortRows(tableEntry.SortColumn, tableEntry.IsSortDescending)
+			selectedSourceRow = -1
+		} else {
+			values := make([]string, rowCount)
+			dataEntry.rowOrder = make([]int, rowCount)
+			for rowIndex := range dataEntry.rowOrder {
+				dataEntry.rowOrder[rowIndex] = rowIndex
+				values[rowIndex] = dataEntry.dataSource.GetCellValue(rowIndex, tableEntry.SortColumn)
+			}
+			sort.SliceStable(dataEntry.rowOrder, func(firstIndex int, secondIndex int) bool {
+				comparison := compareTableValues(values[dataEntry.rowOrder[firstIndex]], values[dataEntry.rowOrder[secondIndex]])
+				if tableEntry.IsSortDescending {
+					return comparison > 0
+				}
+				return comparison < 0
+			})
+		}
+	}
+	tableEntry.SelectedRow = shared.getDisplayRow(dataEntry, selectedSourceRow)
+	shared.setViewport(layerAlias, tableAlias, tableEntry.ViewportRow, tableEntry.ViewportColumn)
+	shared.scrollToSelectedRow(layerAlias, tableAlias)
+}
+
+/*
+compareTableValues is a method which allows you to compare two table values for sorting. If both values are numbers
+they are compared as numbers, otherwise they are compared as text without regard to case. A negative result means the
+first value comes first.
+
+Example:
+
+	comparison := compareTableValues("10", "9")
+*/
+func compareTableValues(firstValue string, secondValue string) int {
+	firstNumber, firstError := strconv.ParseFloat(strings.TrimSpace(firstValue), 64)
+	secondNumber, secondError := strconv.ParseFloat(strings.TrimSpace(secondValue), 64)
+	if firstError == nil && secondError == nil {
+		if firstNumber < secondNumber {
+			return -1
+		} else if firstNumber > secondNumber {
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(firstValue), strings.ToLower(secondValue))
+}
+
+/*
+setViewport is a method which allows you to scroll a table to a given row and column. Both are kept within the range
+that still fills the table, and the scroll bars are updated to match.
+
+Example:
+
+	Table.setViewport("layer1", "table1", 10, 0)
+*/
+func (shared *tableType) setViewport(layerAlias string, tableAlias string, viewportRow int, viewportColumn int) {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	maximumViewportRow, maximumViewportColumn := shared.getMaximumViewport(layerAlias, tableAlias)
+	tableEntry.ViewportRow = getClampedTableIndex(viewportRow, maximumViewportRow)
+	tableEntry.ViewportColumn = getClampedTableIndex(viewportColumn, maximumViewportColumn)
+	shared.updateScrollbars(layerAlias, tableAlias)
+}
+
+/*
+getMaximumViewport is a method which allows you to obtain how far a table can be scrolled down and across. A result
+of 0 or less means every row or column already fits.
+
+Example:
+
+	maximumViewportRow, maximumViewportColumn := Table.getMaximumViewport("layer1", "table1")
+*/
+func (shared *tableType) getMaximumViewport(layerAlias string, tableAlias string) (int, int) {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	rowCount := shared.getRowCount(tableDataMemory.Get(layerAlias, tableAlias))
+	// One line of the table is always taken up by its header.
+	return rowCount - (tableEntry.Height - 1), shared.getTotalWidth(tableEntry) - tableEntry.Width
+}
+
+/*
+getClampedTableIndex is a method which allows you to keep a row or column index between 0 and a given maximum. If the
+maximum is less than 0, then 0 is returned.
+
+Example:
+
+	viewportRow := getClampedTableIndex(viewportRow, maximumViewportRow)
+*/
+func getClampedTableIndex(index int, maximumIndex int) int {
+	if index > maximumIndex {
+		index = maximumIndex
+	}
+	if index < 0 {
+		index = 0
+	}
+	return index
+}
+
+/*
+updateScrollbars is a method which allows you to resize the scroll bars of a table to match its rows and columns,
+and move their handles to match the viewport. Each scroll bar is hidden when everything in its direction fits.
+
+Example:
+
+	Table.updateScrollbars("layer1", "table1")
+*/
+func (shared *tableType) updateScrollbars(layerAlias string, tableAlias string) {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	maximumViewportRow, maximumViewportColumn := shared.getMaximumViewport(layerAlias, tableAlias)
+	scrollValues := map[string][]int{
+		tableEntry.VerticalScrollbarAlias:   {maximumViewportRow, tableEntry.ViewportRow},
+		tableEntry.HorizontalScrollbarAlias: {maximumViewportColumn, tableEntry.ViewportColumn},
+	}
+	for scrollbarAlias, values := range scrollValues {
+		if !ScrollBars.IsExists(layerAlias, scrollbarAlias) {
+			continue
+		}
+		scrollbarEntry := ScrollBars.Get(layerAlias, scrollbarAlias)
+		scrollbarEntry.IsEnabled = values[0] > 0
+		scrollbarEntry.IsVisible = values[0] > 0
+		scrollbarEntry.MaxScrollValue = getClampedTableIndex(values[0], values[0])
+		scrollbarEntry.ScrollValue = values[1]
+		scrollbar.computeHandlePositionByScrollValue(layerAlias, scrollbarAlias)
+	}
+}
+
+/*
+scrollToSelectedRow is a method which allows you to scroll a table just far enough that its selected row can be
+seen. Frozen rows are always visible, so selecting one never scrolls the table.
+
+Example:
+
+	Table.scrollToSelectedRow("layer1", "table1")
+*/
+func (shared *tableType) scrollToSelectedRow(layerAlias string, tableAlias string) {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	if tableEntry.SelectedRow < tableEntry.FrozenRowCount {
+		return
+	}
+	scrolledRow := tableEntry.SelectedRow - tableEntry.FrozenRowCount
+	numberOfScrolledLines := tableEntry.Height - 1 - tableEntry.FrozenRowCount
+	if scrolledRow < tableEntry.ViewportRow {
+		shared.setViewport(layerAlias, tableAlias, scrolledRow, tableEntry.ViewportColumn)
+	} else if scrolledRow >= tableEntry.ViewportRow+numberOfScrolledLines {
+		shared.setViewport(layerAlias, tableAlias, scrolledRow-numberOfScrolledLines+1, tableEntry.ViewportColumn)
+	}
+}
+
+/*
+moveSelection is a method which allows you to select another row of a table, keeping it within the rows available and
+scrolling the table if needed.
+
+Example:
+
+	Table.moveSelection("layer1", "table1", 5)
+*/
+func (shared *tableType) moveSelection(layerAlias string, tableAlias string, displayRow int) {
+	rowCount := shared.getRowCount(tableDataMemory.Get(layerAlias, tableAlias))
+	if rowCount == 0 {
+		return
+	}
+	Tables.Get(layerAlias, tableAlias).SelectedRow = getClampedTableIndex(displayRow, rowCount-1)
+	shared.scrollToSelectedRow(layerAlias, tableAlias)
+}
+
+/*
+scrollByColumn is a method which allows you to scroll a table across so that the column after, or before, the one
+currently at its left edge is brought to its left edge.
+
+Example:
+
+	Table.scrollByColumn("layer1", "table1", true)
+*/
+func (shared *tableType) scrollByColumn(layerAlias string, tableAlias string, isForward bool) {
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	viewportColumn := 0
+	for columnIndex := range tableEntry.Columns {
+		columnStart := shared.getColumnStart(tableEntry, columnIndex)
+		if isForward && columnStart > tableEntry.ViewportColumn {
+			viewportColumn = columnStart
+			break
+		}
+		if !isForward && columnStart < tableEntry.ViewportColumn {
+			viewportColumn = columnStart
+		}
+	}
+	if isForward && viewportColumn == 0 {
+		viewportColumn = shared.getTotalWidth(tableEntry)
+	}
+	shared.setViewport(layerAlias, tableAlias, tableEntry.ViewportRow, viewportColumn)
+}
+
+/*
+updateViewportFromFocusedScrollbar is a method which allows you to scroll a table to match one of its scroll bars,
+when that scroll bar is the control currently in focus. If the viewport was changed, true is returned.
+
+Example:
+
+	isUpdateRequired := Table.updateViewportFromFocusedScrollbar()
+*/
+func (shared *tableType) updateViewportFromFocusedScrollbar() bool {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	if eventStateMemory.currentlyFocusedControl.controlType != constants.CellTypeScrollbar || !ScrollBars.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false
+	}
+	scrollbarEntry := ScrollBars.Get(focusedLayerAlias, focusedControlAlias)
+	for _, tableEntry := range Tables.GetAllEntries(focusedLayerAlias) {
+		if tableEntry.VerticalScrollbarAlias == focusedControlAlias && tableEntry.ViewportRow != scrollbarEntry.ScrollValue {
+			tableEntry.ViewportRow = scrollbarEntry.ScrollValue
+			return true
+		}
+		if tableEntry.HorizontalScrollbarAlias == focusedControlAlias && tableEntry.ViewportColumn != scrollbarEntry.ScrollValue {
+			tableEntry.ViewportColumn = scrollbarEntry.ScrollValue
+			return true
+		}
+	}
+	return false
+}
+
+/*
+updateKeyboardEvent is a method which updates the state of the focused table according to the current keyboard
+event. In addition, the following should be noted:
+
+  - Up, down, page up, page down, home, and end move the selection between rows. If no row is selected, the first
+    key pressed selects the first row.
+
+- Left and right scroll the table across by one column.
+
+- Enter calls the submit handler of the table, if a row is selected.
+
+Example:
+
+	isUpdate, isConsumed := Table.updateKeyboardEvent(keystroke)
+*/
+func (shared *tableType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	// A focused scroll bar handles the keystroke itself, so we only need to move its table to match.
+	if focusedControlType == constants.CellTypeScrollbar {
+		return shared.updateViewportFromFocusedScrollbar(), false
+	}
+	if focusedControlType != constants.CellTypeTable || !Tables.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false, false
+	}
+	tableEntry := Tables.Get(focusedLayerAlias, focusedControlAlias)
+	if !tableEntry.IsEnabled {
+		return false, false
+	}
+	selectedRow := tableEntry.SelectedRow
+	numberOfScrolledLines := tableEntry.Height - 1 - tableEntry.FrozenRowCount
+	switch string(keystroke) {
+	case "up":
+		shared.moveSelection(focusedLayerAlias, focusedControlAlias, selectedRow-1)
+	case "down":
+		shared.moveSelection(focusedLayerAlias, focusedControlAlias, selectedRow+1)
+	case "pgup":
+		shared.moveSelection(focusedLayerAlias, focusedControlAlias, selectedRow-numberOfScrolledLines)
+	case "pgdn":
+		shared.moveSelection(focusedLayerAlias, focusedControlAlias, selectedRow+numberOfScrolledLines)
+	case "home":
+		shared.moveSelection(focusedLayerAlias, focusedControlAlias, 0)
+	case "end":
+		shared.moveSelection(focusedLayerAlias, focusedControlAlias, shared.getRowCount(tableDataMemory.Get(focusedLayerAlias, focusedControlAlias))-1)
+	case "left":
+		shared.scrollByColumn(focusedLayerAlias, focusedControlAlias, false)
+	case "right":
+		shared.scrollByColumn(focusedLayerAlias, focusedControlAlias, true)
+	case "enter":
+		if selectedRow != -1 {
+			fireSubmitHandler(focusedLayerAlias, focusedControlAlias)
+		}
+	default:
+		return false, false
+	}
+	return true, true
+}
+
+/*
+updateMouseEvent is a method which updates the state of all tables according to the current mouse event. In
+addition, the following should be noted:
+
+  - Clicking a header sorts the table by its column in ascending order. Clicking the header the table is already
+    sorted by reverses the sort.
+
+- Clicking a row selects it.
+
+  - Scrolling the mouse wheel over a table scrolls it by one row, and dragging either of its scroll bars scrolls it to
+    match.
+
+Example:
+
+	isUpdateRequired := Table.updateMouseEvent()
+*/
+func (shared *tableType) updateMouseEvent() bool {
+	mouseXLocation, mouseYLocation, buttonPressed, wheelState := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	layerAlias := characterEntry.LayerAlias
+	// If a scroll bar is being clicked or dragged, then move the table it belongs to.
+	if buttonPressed != 0 && (eventStateMemory.stateId == constants.EventStateDragAndDropScrollbar ||
+		characterEntry.AttributeEntry.CellType == constants.CellTypeScrollbar) {
+		return shared.updateViewportFromFocusedScrollbar()
+	}
+	tableAlias := characterEntry.AttributeEntry.CellControlAlias
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeTable || !Tables.IsExists(layerAlias, tableAlias) {
+		return false
+	}
+	tableEntry := Tables.Get(layerAlias, tableAlias)
+	if wheelState == "Up" {
+		shared.setViewport(layerAlias, tableAlias, tableEntry.ViewportRow-1, tableEntry.ViewportColumn)
+		return true
+	} else if wheelState == "Down" {
+		shared.setViewport(layerAlias, tableAlias, tableEntry.ViewportRow+1, tableEntry.ViewportColumn)
+		return true
+	}
+	if buttonPressed == 0 || previousButtonPressed != 0 {
+		return false
+	}
+	setFocusedControl(layerAlias, tableAlias, constants.CellTypeTable)
+	if !tableEntry.IsEnabled {
+		return true
+	}
+	columnIndex := characterEntry.AttributeEntry.CellControlLocation
+	if characterEntry.AttributeEntry.CellControlId == tableHeaderRowId {
+		if columnIndex >= 0 && columnIndex < len(tableEntry.Columns) {
+			tableEntry.IsSortDescending = tableEntry.SortColumn == columnIndex && !tableEntry.IsSortDescending
+			tableEntry.SortColumn = columnIndex
+			shared.sortRows(layerAlias, tableAlias)
+		}
+		return true
+	}
+	displayRow := characterEntry.AttributeEntry.CellControlId
+	if displayRow >= 0 && displayRow < shared.getRowCount(tableDataMemory.Get(layerAlias, tableAlias)) {
+		tableEntry.SelectedRow = displayRow
+	}
+	return true
+}
@@ -0,0 +1,195 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+testVirtualTableDataSourceType is a structure which represents a data source that generates its rows on demand and
+sorts itself, used to test tables with a very large number of rows.
+*/
+type testVirtualTableDataSourceType struct {
+	rowCount       int
+	isDescending   bool
+	numberOfSorts  int
+	lastSortColumn int
+}
+
+/*
+GetRowCount is a method which returns the number of rows the test data source generates.
+*/
+func (shared *testVirtualTableDataSourceType) GetRowCount() int {
+	return shared.rowCount
+}
+
+/*
+GetCellValue is a method which generates the value of a cell from its row and column, taking the current sort
+direction into account.
+*/
+func (shared *testVirtualTableDataSourceType) GetCellValue(rowIndex int, columnIndex int) string {
+	if shared.isDescending {
+		rowIndex = shared.rowCount - rowIndex - 1
+	}
+	return fmt.Sprintf("%d", rowIndex*10+columnIndex)
+}
+
+/*
+SortRows is a method which records that the test data source was asked to sort itself.
+*/
+func (shared *testVirtualTableDataSourceType) SortRows(columnIndex int, isDescending bool) {
+	shared.numberOfSorts++
+	shared.lastSortColumn = columnIndex
+	shared.isDescending = isDescending
+}
+
+/*
+getTestTableColumnsAndRows is a method which returns the columns and rows shared by the table tests.
+
+Example:
+
+	columns, rows := getTestTableColumnsAndRows()
+*/
+func getTestTableColumnsAndRows() ([]types.TableColumnEntryType, [][]string) {
+	columns := []types.TableColumnEntryType{
+		{Header: "Name", Width: 8, Alignment: constants.AlignmentLeft},
+		{Header: "Age", Width: 5, Alignment: constants.AlignmentRight},
+		{Header: "City", Width: 10, Alignment: constants.AlignmentCenter},
+	}
+	rows := [][]string{
+		{"Carol", "35", "Paris"},
+		{"alice", "9", "Rome"},
+		{"Bob", "100", "Oslo"},
+		{"dave", "35", "Lima"},
+		{"Eve", "42", "Kyiv"},
+		{"Frank", "7", "Cork"},
+	}
+	return columns, rows
+}
+
+/*
+TestTableKeyboard is a test which verifies that the rows of a table can be selected and scrolled with the keyboard,
+and that sorting keeps the selected row selected.
+
+Example:
+
+	Expected Inputs:
+	    A table with more rows and columns than fit, navigated with the arrow keys, home, end, and enter, and sorted by
+	    a numeric column and a text column.
+
+	Expected Outputs:
+	    The selection moves between rows and the table scrolls to keep it visible, numbers are sorted by value and text
+	    without regard to case, the selected row follows its data through a sort, and enter calls the submit handler.
+*/
+func TestTableKeyboard(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	columns, rows := getTestTableColumnsAndRows()
+	tableInstance := layer1.AddTable(styleEntry, columns, NewStringTableDataSource(rows), 2, 2, 20, 5)
+	isSubmitted := false
+	tableInstance.OnSubmit(func(control *BaseControlInstanceType) { isSubmitted = true })
+	tableInstance.GetFocus()
+	assert.Equalf(test, constants.CellTypeTable, eventStateMemory.currentlyFocusedControl.controlType, "Focusing the table did not focus it!")
+	assert.Equalf(test, -1, tableInstance.GetSelectedRow(), "A row was selected before any key was pressed!")
+	Table.updateKeyboardEvent([]rune("enter"))
+	assert.Falsef(test, isSubmitted, "Enter called the submit handler with no row selected!")
+	Table.updateKeyboardEvent([]rune("down"))
+	assert.Equalf(test, 0, tableInstance.GetSelectedRow(), "The first key pressed did not select the first row!")
+	Table.updateKeyboardEvent([]rune("end"))
+	tableEntry := Tables.Get(layer1.layerAlias, tableInstance.controlAlias)
+	assert.Equalf(test, 5, tableInstance.GetSelectedRow(), "End did not select the last row!")
+	assert.Equalf(test, 2, tableEntry.ViewportRow, "The table did not scroll to the selected row!")
+
+	tableInstance.SortByColumn(1, false)
+	assert.Equalf(test, 5, tableInstance.GetSelectedRow(), "The selected row did not follow its data through a sort!")
+	assert.Equalf(test, 0, tableEntry.SelectedRow, "Numbers were not sorted by value!")
+	assert.Equalf(test, 0, tableEntry.ViewportRow, "The table did not scroll to the selected row after sorting!")
+	tableInstance.SortByColumn(0, false)
+	Table.updateKeyboardEvent([]rune("home"))
+	assert.Equalf(test, 1, tableInstance.GetSelectedRow(), "Text was not sorted without regard to case!")
+	columnIndex, isDescending := tableInstance.GetSortColumn()
+	assert.Equalf(test, []interface{}{0, false}, []interface{}{columnIndex, isDescending}, "The sort column was not reported!")
+
+	Table.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 5, tableEntry.ViewportColumn, "Right did not scroll across as far as the columns allow!")
+	Table.updateKeyboardEvent([]rune("left"))
+	assert.Equalf(test, 0, tableEntry.ViewportColumn, "Left did not scroll back to the previous column!")
+	Table.updateKeyboardEvent([]rune("enter"))
+	assert.Truef(test, isSubmitted, "Enter did not call the submit handler!")
+
+	tableInstance.ClearSort()
+	tableInstance.SetSelectedRow(3)
+	assert.Equalf(test, 3, tableEntry.SelectedRow, "Clearing the sort did not restore the order of the data source!")
+	assert.Panicsf(test, func() { tableInstance.SetFrozenRows(4) }, "Freezing every row did not panic!")
+}
+
+/*
+TestTableMouse is a test which verifies that a table draws its header, columns, and rows, and that it can be sorted,
+selected, and scrolled with the mouse.
+
+Example:
+
+	Expected Inputs:
+	    A table whose headers are clicked to sort it, whose rows are clicked and scrolled with the mouse wheel, which
+	    has a row frozen, and whose data source is replaced with a virtual one holding a million rows.
+
+	Expected Outputs:
+	    Headers, separators, and aligned values are drawn, clicking a header sorts by it and clicking it again reverses
+	    the sort, clicking a row selects it, frozen rows stay in place while scrolling, and a data source which sorts
+	    itself is asked to do so.
+*/
+func TestTableMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	columns, rows := getTestTableColumnsAndRows()
+	tableInstance := layer1.AddTable(styleEntry, columns, NewStringTableDataSource(rows), 2, 2, 20, 5)
+	numberOfChanges := 0
+	tableInstance.OnChange(func(control *BaseControlInstanceType) { numberOfChanges++ })
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	assert.Equalf(test, 'N', layerEntry.CharacterMemory[2][2].Character, "The header was not drawn!")
+	assert.Equalf(test, styleEntry.Frame.VerticalLine, layerEntry.CharacterMemory[3][10].Character, "The column separator was not drawn!")
+	assert.Equalf(test, "35", string([]rune{layerEntry.CharacterMemory[3][14].Character, layerEntry.CharacterMemory[3][15].Character}), "A right aligned value was not drawn at the right of its column!")
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(12, 2, 1, "")
+	Table.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, styleEntry.Table.SortAscendingGlyph, layerEntry.CharacterMemory[2][15].Character, "Clicking a header did not sort by its column!")
+	assert.Equalf(test, 'F', layerEntry.CharacterMemory[3][2].Character, "The rows were not drawn in sorted order!")
+	SetMouseStatus(12, 2, 0, "")
+	SetMouseStatus(12, 2, 1, "")
+	Table.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, styleEntry.Table.SortDescendingGlyph, layerEntry.CharacterMemory[2][15].Character, "Clicking the sorted header did not reverse the sort!")
+	assert.Equalf(test, 'B', layerEntry.CharacterMemory[3][2].Character, "The rows were not drawn in reverse order!")
+
+	controlStates := getControlStatesForChangeHandlers()
+	SetMouseStatus(12, 2, 0, "")
+	SetMouseStatus(4, 4, 1, "")
+	Table.updateMouseEvent()
+	assert.Equalf(test, 4, tableInstance.GetSelectedRow(), "Clicking a row did not select it!")
+	assert.Equalf(test, tableInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Clicking the table did not focus it!")
+	fireChangeHandlers(controlStates)
+	assert.Equalf(test, 1, numberOfChanges, "The change handler was not called when a row was selected!")
+	SetMouseStatus(4, 4, 0, "")
+	SetMouseStatus(4, 5, 0, "Down")
+	Table.updateMouseEvent()
+	tableInstance.SetFrozenRows(1)
+	UpdateDisplay(false)
+	assert.Equalf(test, 'B', layerEntry.CharacterMemory[3][2].Character, "The frozen row did not stay in place while scrolling!")
+	assert.Equalf(test, 'C', layerEntry.CharacterMemory[4][2].Character, "The rows below the frozen row were not scrolled!")
+
+	dataSource := &testVirtualTableDataSourceType{rowCount: 1000000}
+	tableInstance.SetDataSource(dataSource)
+	assert.Equalf(test, 1, dataSource.numberOfSorts, "A data source which sorts itself was not asked to sort its rows!")
+	assert.Equalf(test, 1, dataSource.lastSortColumn, "A data source which sorts itself was not given the sort column!")
+	UpdateDisplay(false)
+	assert.Equalf(test, '9', layerEntry.CharacterMemory[3][2].Character, "The rows of the virtual data source were not drawn!")
+	tableEntry := Tables.Get(layer1.layerAlias, tableInstance.controlAlias)
+	assert.Equalf(test, 1000000-4, ScrollBars.Get(layer1.layerAlias, tableEntry.VerticalScrollbarAlias).MaxScrollValue, "The scroll bar was not sized for every row!")
+	tableInstance.Delete()
+	assert.Falsef(test, Tables.IsExists(layer1.layerAlias, tableInstance.controlAlias), "The table was not deleted!")
+	assert.Falsef(test, ScrollBars.IsExists(layer1.layerAlias, tableEntry.HorizontalScrollbarAlias), "The scroll bars of the table were not deleted!")
+}
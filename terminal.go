@@ -34,6 +34,9 @@ type defaultValueType struct {
 	isDebugEnabled       bool
 	displayUpdate        sync.Mutex
 	updateDisplayChannel chan bool
+	// Track whether events are being dispatched by a background goroutine rather than by the caller
+	isEventUpdaterRunning bool
+	resizeHandler         TerminalResizeHandlerType
 }
 
 /*
@@ -69,25 +72,83 @@ Example:
 	InitializeTerminal(80, 25)
 */
 func InitializeTerminal(width int, height int) {
+	if commonResource.isDebugEnabled {
+		initializeTerminalSession(nil, width, height, true)
+		return
+	}
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	if err := screen.Init(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	setupCloseHandler(screen)
+	initializeTerminalSession(screen, width, height, true)
+	commonResource.isEventUpdaterRunning = true
+	go setupEventUpdater(commonResource.updateDisplayChannel)
+}
+
+/*
+InitializeTerminalWithSimulationScreen is a method which allows you to initialize consolizer on a headless tcell
+simulation screen instead of a real terminal. This is useful for end-to-end testing, since key and mouse events can be
+injected into the returned screen and the cells tcell actually received can be read back. In addition, the following
+should be noted:
+
+  - If you pass in a zero or negative value for either width or height a panic will be generated to fail as fast as
+    possible.
+
+  - No background event updaters are started. Instead, call UpdateEventQueues once for every event you inject so that
+    it is dispatched to your controls in a predictable order, and call UpdatePeriodicEvents whenever periodic events
+    such as tooltips should be updated.
+
+  - Calling InitializeTerminal afterwards will detach the simulation screen.
+
+Example:
+
+	screen := InitializeTerminalWithSimulationScreen(80, 25)
+	screen.InjectKey(tcell.KeyRune, 'a', tcell.ModNone)
+	UpdateEventQueues()
+*/
+func InitializeTerminalWithSimulationScreen(width int, height int) tcell.SimulationScreen {
+	validateTerminalWidthAndHeight(width, height)
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		safeSttyPanic(fmt.Sprintf("The simulation screen could not be initialized: %v", err))
+	}
+	screen.SetSize(width, height)
+	initializeTerminalSession(screen, width, height, false)
+	return screen
+}
+
+/*
+initializeTerminalSession is a method which allows you to perform the setup shared by every terminal backend. In
+addition, the following should be noted:
+
+- Passing in a nil screen configures a virtual terminal with no display, as used when debug is enabled.
+
+- Any event updaters left over from a previous session are stopped before new ones are started.
+
+- The periodic event updater is only started if requested, since it would otherwise update controls at the same time
+as a test driving the session by hand.
+
+Example:
+
+	initializeTerminalSession(screen, 80, 25, true)
+*/
+func initializeTerminalSession(screen tcell.Screen, width int, height int, isPeriodicEventUpdaterStarted bool) {
+	stopEventUpdaters()
 	InitializeTimerMemory()
 	// Set the mouse location off screen so it won't trigger events at 0,0 which the user never moved to.
 	SetMouseStatus(-1, -1, 0, "")
 	var detectedWidth int
 	var detectedHeight int
-	if !commonResource.isDebugEnabled {
-		screen, err := tcell.NewScreen()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err)
-			os.Exit(1)
-		}
-		if err := screen.Init(); err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err)
-			os.Exit(1)
-		}
-		commonResource.screen = screen
+	commonResource.screen = screen
+	if screen != nil {
 		commonResource.screen.EnableMouse()
-		commonResource.updateDisplayChannel = make(chan bool)
-		setupCloseHandler()
+		commonResource.screen.EnablePaste()
 		detectedWidth, detectedHeight = GetTerminalSize()
 	}
 	if width == 0 {
@@ -103,8 +164,30 @@ func InitializeTerminal(width int, height int) {
 	commonResource.debugDirectory = "/tmp/"
 	validateTerminalWidthAndHeight(commonResource.terminalWidth, commonResource.terminalHeight)
 	DeleteAllLayers()
-	go setupEventUpdater()
-	go setupPeriodicEventUpdater()
+	resetRenderCache()
+	commonResource.updateDisplayChannel = make(chan bool)
+	if isPeriodicEventUpdaterStarted {
+		go setupPeriodicEventUpdater(commonResource.updateDisplayChannel)
+	}
+}
+
+/*
+stopEventUpdaters is a method which allows you to stop all background event updaters belonging to the current
+terminal session. In addition, the following should be noted:
+
+- If no session is running, then no operation will be performed.
+
+Example:
+
+	stopEventUpdaters()
+*/
+func stopEventUpdaters() {
+	if commonResource.updateDisplayChannel == nil {
+		return
+	}
+	close(commonResource.updateDisplayChannel)
+	commonResource.updateDisplayChannel = nil
+	commonResource.isEventUpdaterRunning = false
 }
 
 /*
@@ -112,12 +195,17 @@ setupPeriodicEventUpdater is a method which is a background method that updates
 
 Example:
 
-	go setupPeriodicEventUpdater()
+	go setupPeriodicEventUpdater(stopChannel)
 */
-func setupPeriodicEventUpdater() {
+func setupPeriodicEventUpdater(stopChannel chan bool) {
 	for {
-		UpdatePeriodicEvents()
-		time.Sleep(10 * time.Millisecond)
+		select {
+		case <-stopChannel:
+			return
+		default:
+			UpdatePeriodicEvents()
+			time.Sleep(10 * time.Millisecond)
+		}
 	}
 }
 
@@ -127,12 +215,12 @@ When an event is detected, it is recorded and monitoring continues.
 
 Example:
 
-	go setupEventUpdater()
+	go setupEventUpdater(stopChannel)
 */
-func setupEventUpdater() {
+func setupEventUpdater(stopChannel chan bool) {
 	for {
 		select {
-		case <-commonResource.updateDisplayChannel:
+		case <-stopChannel:
 			return
 		default:
 			UpdateEventQueues()
@@ -147,14 +235,14 @@ or if the process is killed.
 
 Example:
 
-	setupCloseHandler()
+	setupCloseHandler(screen)
 */
-func setupCloseHandler() {
+func setupCloseHandler(screen tcell.Screen) {
 	channel := make(chan os.Signal)
 	signal.Notify(channel, syscall.SIGTERM, syscall.SIGKILL, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGHUP)
 	go func() {
 		<-channel
-		commonResource.screen.Fini()
+		screen.Fini()
 		os.Exit(1)
 	}()
 
@@ -170,7 +258,7 @@ Example:
 	RestoreTerminalSettings()
 */
 func RestoreTerminalSettings() {
-	commonResource.updateDisplayChannel <- true
+	stopEventUpdaters()
 	DeleteAllLayers()
 	if commonResource.screen == nil {
 		return
@@ -207,6 +295,59 @@ func getOsType() int {
 	return 0
 }
 
+/*
+TerminalResizeHandlerType is a type which represents a callback that is invoked after the terminal has been resized.
+The new width and height of the terminal in characters are passed in.
+*/
+type TerminalResizeHandlerType func(width int, height int)
+
+/*
+OnTerminalResize is a method which allows you to register a handler that is called whenever the terminal window is
+resized. This is useful for re-laying out screens which are not fully handled by layer anchoring. In addition, the
+following should be noted:
+
+- The handler is called after the terminal size has been updated and all anchored layers have been adjusted.
+
+- The display is refreshed automatically after the handler returns.
+
+- Registering a new handler replaces any handler registered previously. Passing in nil removes it.
+
+Example:
+
+	OnTerminalResize(func(width int, height int) { statusBar.MoveLayerByAbsoluteValue(0, height-1) })
+*/
+func OnTerminalResize(handler TerminalResizeHandlerType) {
+	commonResource.resizeHandler = handler
+}
+
+/*
+resizeTerminal is a method which allows you to update the terminal session after the terminal window has changed
+size. In addition, the following should be noted:
+
+- The screen layer is reallocated to the new dimensions.
+
+- All layers are repositioned and resized according to their anchor styles.
+
+- If an invalid size is provided, then no operation will be performed.
+
+Example:
+
+	resizeTerminal(120, 40)
+*/
+func resizeTerminal(width int, height int) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	commonResource.terminalWidth = width
+	commonResource.terminalHeight = height
+	commonResource.screenLayer = types.NewLayerEntry("", "", width, height)
+	applyLayerAnchors("")
+	updateModalBackdrops()
+	if commonResource.resizeHandler != nil {
+		commonResource.resizeHandler(width, height)
+	}
+}
+
 /*
 GetTerminalSize is a method which allows you to obtain width and height of the current terminal characters.
 
@@ -507,8 +648,10 @@ func printLayer(layerEntry *types.LayerEntryType, attributeEntry types.Attribute
 			originalBackgroundColor := characterMemory[cursorYLocation][cursorXLocation].AttributeEntry.BackgroundColor
 			characterMemory[cursorYLocation][cursorXLocation].AttributeEntry = types.NewAttributeEntry(&attributeEntry)
 			characterMemory[cursorYLocation][cursorXLocation].Character = currentCharacter
+			layerEntry.MarkDirtyRegion(cursorXLocation, cursorYLocation, 1, 1)
 			if stringformat.IsRuneCharacterWide(currentCharacter) {
 				cursorXLocation++
+				layerEntry.MarkDirtyRegion(cursorXLocation, cursorYLocation, 1, 1)
 				if cursorXLocation >= layerWidth {
 					return cursorXLocation - xLocation
 				}
@@ -577,6 +720,7 @@ func scrollCharacterMemory(layerEntry *types.LayerEntryType) [][]types.Character
 	}
 	characterMemory = append(characterMemory, characterObjectArray)
 	layerEntry.CharacterMemory = characterMemory
+	layerEntry.MarkFullyDirty()
 	return characterMemory
 }
 
@@ -663,6 +807,13 @@ In addition, the following should be noted:
 
 - Layers with the same z-order priority will appear in random display order.
 
+  - Only regions which have changed since the last update are recomposited and sent to the terminal. Changes are
+    detected from the dirty regions recorded when printing or drawing to a layer, the areas drawn by controls, and
+    layers being moved, resized, reordered, hidden or deleted.
+
+  - If isRefreshForced is true, the entire screen is recomposited and the terminal is fully resynchronized. This is
+    useful if you have modified the character memory of a layer directly.
+
 Example:
 
 	UpdateDisplay(false)
@@ -673,11 +824,17 @@ func UpdateDisplay(isRefreshForced bool) {
 		commonResource.displayUpdate.Unlock()
 	}()
 	sortedLayerAliasSlice := layer.GetSortedLayerMemoryAliasSlice()
-	baseLayerEntry := types.NewLayerEntry("", "", commonResource.terminalWidth, commonResource.terminalHeight)
-	baseLayerEntry = renderLayers(&baseLayerEntry, sortedLayerAliasSlice)
-	Tooltip.renderAll(baseLayerEntry)
-	DrawLayerToScreen(&baseLayerEntry, isRefreshForced)
-	commonResource.screenLayer = baseLayerEntry
+	dirtyRegions := updateRenderCache(sortedLayerAliasSlice, isRefreshForced)
+	baseLayerEntry := &renderCacheMemory.composedLayerEntry
+	for _, currentRegion := range dirtyRegions {
+		clearLayerRegion(baseLayerEntry, currentRegion)
+		renderLayersInRegion(baseLayerEntry, sortedLayerAliasSlice, currentRegion, 0, 0)
+	}
+	baseLayerEntry.ClearDirtyRegion()
+	Tooltip.renderAll(*baseLayerEntry)
+	renderCacheMemory.tooltipRegion = baseLayerEntry.GetDirtyRegion()
+	drawLayerRegionsToScreen(baseLayerEntry, append(dirtyRegions, renderCacheMemory.tooltipRegion), isRefreshForced)
+	commonResource.screenLayer = *baseLayerEntry
 }
 
 /*
@@ -742,17 +899,23 @@ Example:
 */
 func renderControls(currentLayerEntry types.LayerEntryType) {
 	Button.drawOnLayer(currentLayerEntry)
+	Spinner.drawOnLayer(currentLayerEntry) // Spinners must come before text fields, so that their text fields follow them.
 	TextField.drawOnLayer(currentLayerEntry)
 	Checkbox.drawOnLayer(currentLayerEntry)
 	radioButton.drawOnLayer(currentLayerEntry)
 	ProgressBar.drawOnLayer(currentLayerEntry)
 	Label.drawOnLayer(currentLayerEntry)
 	scrollbar.drawOnLayer(currentLayerEntry)
+	Slider.drawOnLayer(currentLayerEntry)
+	TreeView.drawOnLayer(currentLayerEntry)
+	Table.drawOnLayer(currentLayerEntry)
+	TabControl.drawOnLayer(currentLayerEntry)
+	contextMenu.drawOnLayer(currentLayerEntry)
 
 	textbox.drawOnLayer(currentLayerEntry)
 	Tooltip.drawHotspotZonesOnLayer(currentLayerEntry)
 	viewport.drawOnLayer(currentLayerEntry)
-	FileMenu.drawOnLayer(currentLayerEntry) // File menu must appear before selector or selectors won't render when menu is open.
+	FileMenu.drawOnLayer(currentLayerEntry)
 	Dropdown.drawOnLayer(currentLayerEntry) // Dropdowns must come before selectors, or it won't show on top.
 	Selector.drawSelectorsOnLayer(currentLayerEntry)
 
@@ -825,6 +988,26 @@ Example:
 	overlayLayers(&srcLayer, &targetLayer, false)
 */
 func overlayLayers(sourceLayerEntry *types.LayerEntryType, targetLayerEntry *types.LayerEntryType, isOpaque bool) {
+	targetRegion := types.DirtyRegionEntryType{Width: targetLayerEntry.Width, Height: targetLayerEntry.Height}
+	overlayLayersInRegion(sourceLayerEntry, targetLayerEntry, isOpaque, targetRegion)
+}
+
+/*
+overlayLayersInRegion is a method which allows you to overlay one text layer on top of another text layer, while only
+modifying cells which fall inside the specified region of the target. In addition, the following should be noted:
+
+- The region is specified in the coordinates of the target text layer.
+
+  - Cells are blended exactly as they are by overlayLayers, so rendering a screen one region at a time produces the same
+    result as rendering it all at once.
+
+- The area of the target which was modified is recorded as dirty.
+
+Example:
+
+	overlayLayersInRegion(&srcLayer, &targetLayer, false, region)
+*/
+func overlayLayersInRegion(sourceLayerEntry *types.LayerEntryType, targetLayerEntry *types.LayerEntryType, isOpaque bool, targetRegion types.DirtyRegionEntryType) {
 	// 1. Simplified Clipping Logic (Integer Math)
 	sourceStartX := 0
 	if sourceLayerEntry.ScreenXLocation < 0 {
@@ -858,10 +1041,21 @@ func overlayLayers(sourceLayerEntry *types.LayerEntryType, targetLayerEntry *typ
 		heightToCopy = targetLayerEntry.Height - targetStartY
 	}
 
+	// Restrict the overlapping area to the region being rendered.
+	overlappingRegion := types.DirtyRegionEntryType{XLocation: targetStartX, YLocation: targetStartY, Width: widthToCopy, Height: heightToCopy}
+	overlappingRegion = overlappingRegion.GetIntersection(targetRegion)
+	sourceStartX += overlappingRegion.XLocation - targetStartX
+	sourceStartY += overlappingRegion.YLocation - targetStartY
+	targetStartX = overlappingRegion.XLocation
+	targetStartY = overlappingRegion.YLocation
+	widthToCopy = overlappingRegion.Width
+	heightToCopy = overlappingRegion.Height
+
 	// If there's no overlapping area, we have nothing to do.
 	if widthToCopy <= 0 || heightToCopy <= 0 {
 		return
 	}
+	targetLayerEntry.MarkDirtyRegion(targetStartX, targetStartY, widthToCopy, heightToCopy)
 
 	// 2. Cache Lookups
 	sourceCharacterMemory := sourceLayerEntry.CharacterMemory
@@ -959,34 +1153,15 @@ following should be noted:
 
 - If debug is enabled, this method does nothing since the terminal is virtual.
 
+- When running on a simulation screen, the cells are drawn to it just like a real terminal.
+
 Example:
 
 	DrawLayerToScreen(layerEntry, false)
 */
 func DrawLayerToScreen(layerEntry *types.LayerEntryType, isForcedRefreshRequired bool) {
-	if !commonResource.isDebugEnabled {
-		width := layerEntry.Width
-		height := layerEntry.Height
-		for currentRow := 0; currentRow < height; currentRow++ {
-			for currentCharacter := 0; currentCharacter < width; currentCharacter++ {
-				style := tcell.StyleDefault
-				attributeEntry := layerEntry.CharacterMemory[currentRow][currentCharacter].AttributeEntry
-				style = style.Foreground(tcell.Color(attributeEntry.ForegroundColor))
-				style = style.Background(tcell.Color(attributeEntry.BackgroundColor))
-				style = style.Blink(attributeEntry.IsBlinking)
-				style = style.Bold(attributeEntry.IsBold)
-				style = style.Reverse(attributeEntry.IsReversed)
-				style = style.Underline(attributeEntry.IsUnderlined)
-				var character = layerEntry.CharacterMemory[currentRow][currentCharacter].Character
-				r2 := []rune("")
-				commonResource.screen.SetContent(currentCharacter, currentRow, character, r2, style)
-			}
-		}
-		if isForcedRefreshRequired {
-			commonResource.screen.Sync()
-		}
-		commonResource.screen.Show()
-	}
+	layerRegion := types.DirtyRegionEntryType{Width: layerEntry.Width, Height: layerEntry.Height}
+	drawLayerRegionsToScreen(layerEntry, []types.DirtyRegionEntryType{layerRegion}, isForcedRefreshRequired)
 }
 
 /*
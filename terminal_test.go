@@ -2,6 +2,7 @@ package consolizer
 
 import (
 	"fmt"
+	"github.com/gdamore/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/supercom32/consolizer/constants"
 	"github.com/supercom32/consolizer/recast"
@@ -394,3 +395,87 @@ func TestNewAssetList(test *testing.T) {
 	expectedValue = recast.GetArrayOfInterfaces(0)
 	assert.Equalf(test, expectedValue, obtainedValue, "The number of file entries does not what was expected!")
 }
+
+func TestTerminalSimulationScreenKeyboardEvents(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(20, 5)
+	styleEntry := types.NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 20, 5, 1, nil)
+	textField := layer1.AddTextField(styleEntry, 1, 1, 10, 10, false, "", true)
+	UpdateDisplay(false)
+	screen.InjectMouse(2, 1, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	for _, currentCharacter := range "hello" {
+		screen.InjectKey(tcell.KeyRune, currentCharacter, tcell.ModNone)
+		UpdateEventQueues()
+	}
+	screen.InjectKey(tcell.KeyBackspace2, 0, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Equalf(test, "hell", textField.GetValue(), "The text field did not receive the injected keystrokes!")
+	UpdateDisplay(false)
+	assert.Equalf(test, " hell", GetSimulationScreenRow(screen, 1)[:5], "The simulation screen did not receive the rendered text field!")
+}
+
+func TestTerminalSimulationScreenMouseEvents(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(20, 5)
+	styleEntry := types.NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 20, 5, 1, nil)
+	button := layer1.AddButton("OK", styleEntry, 2, 1, 8, 3, true)
+	UpdateDisplay(false)
+	screen.InjectMouse(5, 2, tcell.Button1, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Truef(test, button.IsStatePressed(), "The button was not pressed by the injected mouse click!")
+	screen.InjectMouse(5, 2, tcell.ButtonNone, tcell.ModNone)
+	UpdateEventQueues()
+	assert.Truef(test, button.IsPressed(), "The button did not register the injected mouse release!")
+	assert.Containsf(test, GetSimulationScreenRow(screen, 2), "OK", "The simulation screen did not receive the rendered button!")
+}
+
+func TestTerminalResizeEvent(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(20, 5)
+	backgroundLayer := AddLayer(0, 0, 20, 5, 1, nil)
+	backgroundLayer.SetAnchor(constants.AnchorAll)
+	backgroundLayer.FillLayer(".")
+	statusLayer := AddLayer(0, 4, 20, 1, 2, nil)
+	statusLayer.SetAnchor(constants.AnchorLeft | constants.AnchorRight | constants.AnchorBottom)
+	statusLayer.FillLayer("=")
+	badgeLayer := AddLayer(16, 0, 3, 1, 3, nil)
+	badgeLayer.SetAnchor(constants.AnchorTop | constants.AnchorRight)
+	badgeLayer.FillLayer("B")
+	fixedLayer := AddLayer(1, 1, 2, 1, 4, nil)
+	fixedLayer.FillLayer("F")
+	resizedWidth := 0
+	resizedHeight := 0
+	OnTerminalResize(func(width int, height int) {
+		resizedWidth = width
+		resizedHeight = height
+		backgroundLayer.FillLayer(".")
+		statusLayer.FillLayer("=")
+	})
+	defer OnTerminalResize(nil)
+	UpdateDisplay(false)
+
+	screen.SetSize(30, 8)
+	screen.PostEvent(tcell.NewEventResize(30, 8))
+	UpdateEventQueues()
+	assert.Equalf(test, []int{30, 8}, []int{commonResource.terminalWidth, commonResource.terminalHeight}, "The terminal size was not updated after a resize event.")
+	assert.Equalf(test, []int{30, 8}, []int{resizedWidth, resizedHeight}, "The resize handler was not called with the new terminal size.")
+	assert.Equalf(test, []int{30, 8}, []int{commonResource.screenLayer.Width, commonResource.screenLayer.Height}, "The screen layer was not reallocated.")
+	statusLayerEntry := Layers.Get(statusLayer.layerAlias)
+	assert.Equalf(test, []int{0, 7, 30, 1}, []int{statusLayerEntry.ScreenXLocation, statusLayerEntry.ScreenYLocation, statusLayerEntry.Width, statusLayerEntry.Height}, "The layer anchored to the bottom was not stretched and moved.")
+	badgeLayerEntry := Layers.Get(badgeLayer.layerAlias)
+	assert.Equalf(test, []int{26, 0, 3, 1}, []int{badgeLayerEntry.ScreenXLocation, badgeLayerEntry.ScreenYLocation, badgeLayerEntry.Width, badgeLayerEntry.Height}, "The layer anchored to the right was not moved.")
+	fixedLayerEntry := Layers.Get(fixedLayer.layerAlias)
+	assert.Equalf(test, []int{1, 1, 2, 1}, []int{fixedLayerEntry.ScreenXLocation, fixedLayerEntry.ScreenYLocation, fixedLayerEntry.Width, fixedLayerEntry.Height}, "A layer without anchors should not have changed.")
+	assert.Equalf(test, "..........................BBB.", GetSimulationScreenRow(screen, 0), "The layer anchored to the right was not drawn at its new location.")
+	assert.Equalf(test, ".FF...........................", GetSimulationScreenRow(screen, 1), "The stretched background layer was not drawn across the new width.")
+	assert.Equalf(test, "==============================", GetSimulationScreenRow(screen, 7), "The stretched status layer was not drawn across the new width.")
+
+	screen.SetSize(10, 3)
+	screen.PostEvent(tcell.NewEventResize(10, 3))
+	UpdateEventQueues()
+	screen.SetSize(20, 5)
+	screen.PostEvent(tcell.NewEventResize(20, 5))
+	UpdateEventQueues()
+	assert.Equalf(test, []int{0, 4, 20, 1}, []int{statusLayerEntry.ScreenXLocation, statusLayerEntry.ScreenYLocation, statusLayerEntry.Width, statusLayerEntry.Height}, "The anchored layer did not return to its original geometry.")
+	assert.Equalf(test, []int{16, 0}, []int{badgeLayerEntry.ScreenXLocation, badgeLayerEntry.ScreenYLocation}, "The anchored layer did not return to its original location.")
+}
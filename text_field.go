@@ -1,7 +1,6 @@
 package consolizer
 
 import (
-	"github.com/atotto/clipboard"
 	"github.com/supercom32/consolizer/memory"
 	"github.com/supercom32/consolizer/stringformat"
 	"strings"
@@ -143,7 +142,9 @@ Example:
 */
 func (shared *textFieldType) Delete(layerAlias string, textFieldAlias string) {
 	validatorTextField(layerAlias, textFieldAlias)
+	deleteTextFieldSuggestions(layerAlias, textFieldAlias)
 	TextFields.Remove(layerAlias, textFieldAlias)
+	deleteTextFieldValidator(layerAlias, textFieldAlias)
 }
 
 /*
@@ -162,7 +163,12 @@ Example:
 	TextField.DeleteAll("Layer1")
 */
 func (shared *textFieldType) DeleteAll(layerAlias string) {
+	for _, textFieldEntry := range TextFields.GetAllEntries(layerAlias) {
+		deleteTextFieldSuggestions(layerAlias, textFieldEntry.Alias)
+	}
 	TextFields.RemoveAll(layerAlias)
+	textFieldValidators.RemoveAll(layerAlias)
+	textFieldSuggestions.RemoveAll(layerAlias)
 }
 
 /*
@@ -201,6 +207,8 @@ In addition, the following should be noted:
 
 - Highlighted text is drawn with inverted colors if active.
 
+- If the value of the text field is invalid, it is drawn with the error colors of its style.
+
 Example:
 
 	TextField.drawInputString(&layerEntry, style, "Text1", 0, 0, 20, 0, runes)
@@ -216,6 +224,10 @@ func (shared *textFieldType) drawInputString(layerEntry *types.LayerEntryType, s
 	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
 	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
 	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	if textFieldEntry.ValidationMessage != "" {
+		attributeEntry.ForegroundColor = styleEntry.TextField.ErrorForegroundColor
+		attributeEntry.BackgroundColor = styleEntry.TextField.ErrorBackgroundColor
+	}
 	fillArea(layerEntry, attributeEntry, " ", xLocation, yLocation, width, 1, 0)
 	// Here we loop over each character to draw since we need to accommodate for unique
 	// cell IDs (if required for mouse location detection).
@@ -244,6 +256,9 @@ func (shared *textFieldType) drawInputString(layerEntry *types.LayerEntryType, s
 		} else if isHighlighted {
 			attributeEntry.ForegroundColor = styleEntry.TextField.HighlightForegroundColor
 			attributeEntry.BackgroundColor = styleEntry.TextField.HighlightBackgroundColor
+		} else if textFieldEntry.ValidationMessage != "" {
+			attributeEntry.ForegroundColor = styleEntry.TextField.ErrorForegroundColor
+			attributeEntry.BackgroundColor = styleEntry.TextField.ErrorBackgroundColor
 		} else {
 			attributeEntry.ForegroundColor = styleEntry.TextField.ForegroundColor
 			attributeEntry.BackgroundColor = styleEntry.TextField.BackgroundColor
@@ -418,6 +433,9 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 	if !textFieldEntry.IsEnabled {
 		return false, false
 	}
+	if shared.updateSuggestionKeyboardEvent(layerAlias, textFieldAlias, textFieldEntry, keystrokeAsString) {
+		return true, true
+	}
 
 	// Windows Quirk: On Linux, the Shift key is only reported as "pressed" when used with non-character keys
 	// (e.g., Shift+Delete). For regular character input like capital letters or symbols (e.g., Shift+A or Shift+;),
@@ -438,7 +456,34 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 		textFieldEntry.IsHighlightModeToggled = false
 	}
 
+	// Take a snapshot before editing, so that the edit can be undone later. Typing which continues the
+	// transaction already open is not recorded separately.
+	editType, isNewTransaction := shared.getKeystrokeEditType(textFieldEntry, keystroke)
+	previousValue := string(textFieldEntry.CurrentValue)
+	var snapshot types.TextFieldSnapshotType
+	isSnapshotTaken := false
+	if editType == constants.EditTypeNone {
+		textFieldEntry.EditHistory.EndTransaction()
+	} else if isNewTransaction || !textFieldEntry.EditHistory.IsContinuingTransaction(editType) {
+		snapshot = shared.getSnapshot(textFieldEntry)
+		isSnapshotTaken = true
+	}
+
 	switch keystrokeAsString {
+	case "ctrl+z":
+		shared.undo(textFieldEntry)
+		shared.updateCursor(textFieldEntry)
+		shared.updateViewport(textFieldEntry)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
+	case "ctrl+y":
+		shared.redo(textFieldEntry)
+		shared.updateCursor(textFieldEntry)
+		shared.updateViewport(textFieldEntry)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
 	case "ctrl+a":
 		// Select all text
 		textFieldEntry.HighlightStart = 0
@@ -450,27 +495,7 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 	case "ctrl+c", "ctrl+insert": // Copy
 		// Copy highlighted text
 		if textFieldEntry.IsHighlightActive {
-			start := textFieldEntry.HighlightStart
-			end := textFieldEntry.HighlightEnd
-			if start > end {
-				start, end = end, start
-			}
-
-			// Ensure we don't exceed array bounds
-			if end >= len(textFieldEntry.CurrentValue) {
-				end = len(textFieldEntry.CurrentValue) - 1
-			}
-			if start < 0 {
-				start = 0
-			}
-
-			highlightedText := string(textFieldEntry.CurrentValue[start : end+1])
-			err := clipboard.WriteAll(highlightedText)
-			if err != nil {
-				panic(1)
-				// Handle clipboard write error (could log it)
-				// fmt.Println("Clipboard write error:", err)
-			}
+			setClipboardText(shared.getHighlightedText(textFieldEntry))
 		}
 		isScreenUpdateRequired = true
 		isKeystrokeConsumed = true
@@ -478,105 +503,27 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 	case "ctrl+x": // Cut
 		// Cut highlighted text
 		if textFieldEntry.IsHighlightActive {
-			start := textFieldEntry.HighlightStart
-			end := textFieldEntry.HighlightEnd
-			if start > end {
-				start, end = end, start
-			}
-
-			// Ensure we don't exceed array bounds
-			if end >= len(textFieldEntry.CurrentValue) {
-				end = len(textFieldEntry.CurrentValue) - 1
-			}
-			if start < 0 {
-				start = 0
-			}
-
 			// Copy to clipboard before deleting
-			highlightedText := string(textFieldEntry.CurrentValue[start : end+1])
-			err := clipboard.WriteAll(highlightedText)
-			if err != nil {
-				// Handle clipboard write error (could log it)
-				// fmt.Println("Clipboard write error:", err)
-			}
-
-			// Include the cursor position in the deletion
-			if textFieldEntry.CursorPosition > end {
-				end = textFieldEntry.CursorPosition
-			} else if textFieldEntry.CursorPosition < start {
-				start = textFieldEntry.CursorPosition
-			}
-			// Preserve the trailing blank character
-			if end == len(textFieldEntry.CurrentValue)-1 {
-				// If we're deleting up to the end, keep the trailing blank
-				textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], ' ')
-			} else {
-				// Otherwise, delete the highlighted text
-				textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], textFieldEntry.CurrentValue[end+1:]...)
-			}
-			textFieldEntry.CursorPosition = start
-			textFieldEntry.IsHighlightActive = false
+			setClipboardText(shared.getHighlightedText(textFieldEntry))
+			shared.deleteHighlightedText(textFieldEntry)
 			isScreenUpdateRequired = true
 			isKeystrokeConsumed = true
 		}
 
 	case "ctrl+v", "shift+insert": // Paste
-		// If there's highlighted text, delete it first
-		if textFieldEntry.IsHighlightActive {
-			start := textFieldEntry.HighlightStart
-			end := textFieldEntry.HighlightEnd
-			if start > end {
-				start, end = end, start
-			}
-
-			// Include the cursor position in the deletion
-			if textFieldEntry.CursorPosition > end {
-				end = textFieldEntry.CursorPosition
-			} else if textFieldEntry.CursorPosition < start {
-				start = textFieldEntry.CursorPosition
-			}
-
-			// Preserve the trailing blank character
-			if end == len(textFieldEntry.CurrentValue)-1 {
-				// If we're deleting up to the end, keep the trailing blank
-				textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], ' ')
-			} else {
-				// Otherwise, delete the highlighted text
-				textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], textFieldEntry.CurrentValue[end+1:]...)
-			}
-			textFieldEntry.CursorPosition = start
-			textFieldEntry.IsHighlightActive = false
-		}
-
-		// Get text from clipboard
-		clipboardText, err := clipboard.ReadAll()
-		if err != nil {
-			// Handle clipboard read error (could log it)
-			// fmt.Println("Clipboard read error:", err)
-		} else {
-			// Insert clipboard text at cursor position
-			// For text fields, we only insert the first line (no newlines)
-			clipboardLine := strings.Split(clipboardText, "\n")[0]
-
-			// Check if adding the clipboard text would exceed the max length
-			if len(textFieldEntry.CurrentValue)+len(clipboardLine) <= textFieldEntry.MaxLengthAllowed+1 {
-				// Insert the clipboard text at the cursor position
-				newValue := append([]rune{}, textFieldEntry.CurrentValue[:textFieldEntry.CursorPosition]...)
-				newValue = append(newValue, []rune(clipboardLine)...)
-				newValue = append(newValue, textFieldEntry.CurrentValue[textFieldEntry.CursorPosition:]...)
-				textFieldEntry.CurrentValue = newValue
-
-				// Move cursor to after the inserted text
-				textFieldEntry.CursorPosition += len(clipboardLine)
-				shared.updateCursor(textFieldEntry)
-				shared.updateViewport(textFieldEntry)
-			}
-		}
+		shared.insertPastedText(textFieldEntry, getClipboardText())
 		isScreenUpdateRequired = true
 		isKeystrokeConsumed = true
 
 	case "delete", "shift+delete":
-		if textFieldEntry.IsHighlightActive {
+		if len(textFieldEntry.InputMask) > 0 {
+			// Masked values are cleared in place, so that the rest of the value keeps its format.
+			if textFieldEntry.IsHighlightActive {
+				shared.deleteHighlightedText(textFieldEntry)
+			} else {
+				shared.deleteMaskedCharacter(textFieldEntry, false)
+			}
+		} else if textFieldEntry.IsHighlightActive {
 			// Delete highlighted text
 			start := textFieldEntry.HighlightStart
 			end := textFieldEntry.HighlightEnd
@@ -630,7 +577,14 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 		isKeystrokeConsumed = true
 
 	case "backspace", "backspace2", "shift+backspace", "shift+backspace2":
-		if textFieldEntry.IsHighlightActive {
+		if len(textFieldEntry.InputMask) > 0 {
+			// Masked values are cleared in place, so that the rest of the value keeps its format.
+			if textFieldEntry.IsHighlightActive {
+				shared.deleteHighlightedText(textFieldEntry)
+			} else {
+				shared.deleteMaskedCharacter(textFieldEntry, true)
+			}
+		} else if textFieldEntry.IsHighlightActive {
 			// Delete highlighted text
 			start := textFieldEntry.HighlightStart
 			end := textFieldEntry.HighlightEnd
@@ -695,10 +649,12 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 
 	default:
 		// Handle regular character input
-		if len(keystroke) == 1 {
+		if len(keystroke) == 1 && (len(textFieldEntry.InputMask) > 0 || shared.isCharacterAllowedByFilter(textFieldEntry.CharacterFilter, keystroke[0])) {
 			// Check if character limit is under max length allowed
-			if len(textFieldEntry.CurrentValue) < textFieldEntry.MaxLengthAllowed+1 {
-				if textFieldEntry.IsHighlightActive && !IsShiftPressed() {
+			if len(textFieldEntry.CurrentValue) < textFieldEntry.MaxLengthAllowed+1 || len(textFieldEntry.InputMask) > 0 {
+				if textFieldEntry.IsHighlightActive && !IsShiftPressed() && len(textFieldEntry.InputMask) > 0 {
+					shared.deleteHighlightedText(textFieldEntry)
+				} else if textFieldEntry.IsHighlightActive && !IsShiftPressed() {
 					// Delete highlighted text before inserting a new character
 					start := textFieldEntry.HighlightStart
 					end := textFieldEntry.HighlightEnd
@@ -722,8 +678,12 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 					textFieldEntry.CursorPosition = start
 					textFieldEntry.IsHighlightActive = false
 				}
-				shared.insertCharacterAtPosition(textFieldEntry, keystroke[0])
-				textFieldEntry.CursorPosition++
+				if len(textFieldEntry.InputMask) > 0 {
+					shared.insertMaskedCharacter(textFieldEntry, keystroke[0])
+				} else {
+					shared.insertCharacterAtPosition(textFieldEntry, keystroke[0])
+					textFieldEntry.CursorPosition++
+				}
 				shared.updateCursor(textFieldEntry)
 				shared.updateViewport(textFieldEntry)
 				isScreenUpdateRequired = true
@@ -744,9 +704,275 @@ func (shared *textFieldType) updateKeyboardEventManually(layerAlias string, text
 			isKeystrokeConsumed = true
 		}
 	}
+	// Only keep the snapshot if the keystroke actually changed the value.
+	if isSnapshotTaken && string(snapshot.CurrentValue) != string(textFieldEntry.CurrentValue) {
+		textFieldEntry.EditHistory.AddEntry(snapshot, editType, constants.DefaultUndoHistoryLimit)
+	}
+	// Validate as the user types, so that mistakes are shown before the value is submitted.
+	if previousValue != string(textFieldEntry.CurrentValue) {
+		shared.validate(layerAlias, textFieldAlias, textFieldEntry)
+		shared.updateSuggestions(layerAlias, textFieldAlias, textFieldEntry)
+	}
 	return isScreenUpdateRequired, isKeystrokeConsumed
 }
 
+/*
+getKeystrokeEditType is a method which determines what kind of edit a keystroke will make to a text field, so that it
+can be recorded in the undo history.
+
+In addition, the following should be noted:
+
+- Keystrokes which do not edit text, such as cursor movement, return 'constants.EditTypeNone'.
+
+- Pasting, cutting, or replacing highlighted text is always recorded as a new transaction.
+
+Example:
+
+	editType, isNewTransaction := TextField.getKeystrokeEditType(textFieldEntry, []rune("a"))
+*/
+func (shared *textFieldType) getKeystrokeEditType(textFieldEntry *types.TextFieldEntryType, keystroke []rune) (int, bool) {
+	switch string(keystroke) {
+	case "ctrl+v", "shift+insert":
+		return constants.EditTypeReplace, true
+	case "ctrl+x":
+		if textFieldEntry.IsHighlightActive {
+			return constants.EditTypeReplace, true
+		}
+	case "delete", "shift+delete":
+		if textFieldEntry.IsHighlightActive {
+			return constants.EditTypeReplace, true
+		}
+		return constants.EditTypeDelete, false
+	case "backspace", "backspace2", "shift+backspace", "shift+backspace2":
+		if textFieldEntry.IsHighlightActive {
+			return constants.EditTypeReplace, true
+		}
+		return constants.EditTypeBackspace, false
+	default:
+		if len(keystroke) == 1 {
+			if textFieldEntry.IsHighlightActive && !IsShiftPressed() {
+				return constants.EditTypeReplace, true
+			}
+			previousCharacter := ' '
+			if textFieldEntry.CursorPosition > 0 && textFieldEntry.CursorPosition <= len(textFieldEntry.CurrentValue) {
+				previousCharacter = textFieldEntry.CurrentValue[textFieldEntry.CursorPosition-1]
+			}
+			return constants.EditTypeInsert, isWordBoundaryEdit(constants.EditTypeInsert, previousCharacter, keystroke[0])
+		}
+	}
+	return constants.EditTypeNone, false
+}
+
+/*
+getSnapshot is a method which obtains a copy of the value and cursor position of a text field, so that it can be
+stored in the undo history.
+
+Example:
+
+	snapshot := TextField.getSnapshot(textFieldEntry)
+*/
+func (shared *textFieldType) getSnapshot(textFieldEntry *types.TextFieldEntryType) types.TextFieldSnapshotType {
+	var snapshot types.TextFieldSnapshotType
+	snapshot.CurrentValue = append([]rune{}, textFieldEntry.CurrentValue...)
+	snapshot.CursorPosition = textFieldEntry.CursorPosition
+	return snapshot
+}
+
+/*
+restoreSnapshot is a method which returns a text field to the value and cursor position stored in a snapshot.
+
+In addition, the following should be noted:
+
+- Any active highlight is removed.
+
+Example:
+
+	TextField.restoreSnapshot(textFieldEntry, snapshot)
+*/
+func (shared *textFieldType) restoreSnapshot(textFieldEntry *types.TextFieldEntryType, snapshot types.TextFieldSnapshotType) {
+	textFieldEntry.CurrentValue = snapshot.CurrentValue
+	textFieldEntry.CursorPosition = snapshot.CursorPosition
+	textFieldEntry.IsHighlightActive = false
+	textFieldEntry.IsHighlightModeToggled = false
+}
+
+/*
+undo is a method which reverts the last edit transaction made to a text field. If there is nothing to undo, false is
+returned.
+
+Example:
+
+	isUndone := TextField.undo(textFieldEntry)
+*/
+func (shared *textFieldType) undo(textFieldEntry *types.TextFieldEntryType) bool {
+	snapshot, isUndone := textFieldEntry.EditHistory.Undo(shared.getSnapshot(textFieldEntry))
+	if isUndone {
+		shared.restoreSnapshot(textFieldEntry, snapshot)
+	}
+	return isUndone
+}
+
+/*
+redo is a method which reapplies the last edit transaction which was undone in a text field. If there is nothing to
+redo, false is returned.
+
+Example:
+
+	isRedone := TextField.redo(textFieldEntry)
+*/
+func (shared *textFieldType) redo(textFieldEntry *types.TextFieldEntryType) bool {
+	snapshot, isRedone := textFieldEntry.EditHistory.Redo(shared.getSnapshot(textFieldEntry))
+	if isRedone {
+		shared.restoreSnapshot(textFieldEntry, snapshot)
+	}
+	return isRedone
+}
+
+/*
+getHighlightedText is a method which allows you to obtain the text currently highlighted in a text field. If no text
+is highlighted, an empty string is returned.
+
+Example:
+
+	highlightedText := TextField.getHighlightedText(textFieldEntry)
+*/
+func (shared *textFieldType) getHighlightedText(textFieldEntry *types.TextFieldEntryType) string {
+	if !textFieldEntry.IsHighlightActive {
+		return ""
+	}
+	start := textFieldEntry.HighlightStart
+	end := textFieldEntry.HighlightEnd
+	if start > end {
+		start, end = end, start
+	}
+
+	// Ensure we don't exceed array bounds
+	if end >= len(textFieldEntry.CurrentValue) {
+		end = len(textFieldEntry.CurrentValue) - 1
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > end {
+		return ""
+	}
+	return string(textFieldEntry.CurrentValue[start : end+1])
+}
+
+/*
+deleteHighlightedText is a method which allows you to delete the text currently highlighted in a text field, along
+with the character under the cursor if it sits just outside the highlight. The cursor is moved to where the text
+was, and the highlight is cleared. If the text field has an input mask, the text is cleared in place instead.
+
+Example:
+
+	TextField.deleteHighlightedText(textFieldEntry)
+*/
+func (shared *textFieldType) deleteHighlightedText(textFieldEntry *types.TextFieldEntryType) {
+	start := textFieldEntry.HighlightStart
+	end := textFieldEntry.HighlightEnd
+	if start > end {
+		start, end = end, start
+	}
+
+	// Ensure we don't exceed array bounds
+	if end >= len(textFieldEntry.CurrentValue) {
+		end = len(textFieldEntry.CurrentValue) - 1
+	}
+	if start < 0 {
+		start = 0
+	}
+
+	// Include the cursor position in the deletion
+	if textFieldEntry.CursorPosition > end {
+		end = textFieldEntry.CursorPosition
+	} else if textFieldEntry.CursorPosition < start {
+		start = textFieldEntry.CursorPosition
+	}
+	if len(textFieldEntry.InputMask) > 0 {
+		shared.deleteMaskedText(textFieldEntry, start, end)
+		textFieldEntry.IsHighlightActive = false
+		return
+	}
+	// Preserve the trailing blank character
+	if end >= len(textFieldEntry.CurrentValue)-1 {
+		// If we're deleting up to the end, keep the trailing blank
+		textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], ' ')
+	} else {
+		// Otherwise, delete the highlighted text
+		textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], textFieldEntry.CurrentValue[end+1:]...)
+	}
+	textFieldEntry.CursorPosition = start
+	textFieldEntry.IsHighlightActive = false
+}
+
+/*
+insertPastedText is a method which allows you to insert pasted text at the cursor of a text field, replacing any text
+which is highlighted. In addition, the following should be noted:
+
+- Since text fields hold a single line, only the first line of the text is inserted.
+
+  - Masked text fields accept the text one character at a time, so that literals are filled in. Otherwise, the text
+    is filtered, and nothing is inserted if it would exceed the maximum length of the text field.
+
+Example:
+
+	TextField.insertPastedText(textFieldEntry, "Hello")
+*/
+func (shared *textFieldType) insertPastedText(textFieldEntry *types.TextFieldEntryType, text string) {
+	// If there's highlighted text, delete it first
+	if textFieldEntry.IsHighlightActive {
+		shared.deleteHighlightedText(textFieldEntry)
+	}
+	clipboardLine := strings.TrimSuffix(strings.Split(text, "\n")[0], "\r")
+	if len(textFieldEntry.InputMask) > 0 {
+		for _, currentCharacter := range clipboardLine {
+			shared.insertMaskedCharacter(textFieldEntry, currentCharacter)
+		}
+		shared.updateCursor(textFieldEntry)
+		shared.updateViewport(textFieldEntry)
+		return
+	}
+	clipboardLine = shared.getFilteredText(textFieldEntry, clipboardLine)
+
+	// Check if adding the clipboard text would exceed the max length
+	if len(textFieldEntry.CurrentValue)+len(clipboardLine) <= textFieldEntry.MaxLengthAllowed+1 {
+		// Insert the clipboard text at the cursor position
+		newValue := append([]rune{}, textFieldEntry.CurrentValue[:textFieldEntry.CursorPosition]...)
+		newValue = append(newValue, []rune(clipboardLine)...)
+		newValue = append(newValue, textFieldEntry.CurrentValue[textFieldEntry.CursorPosition:]...)
+		textFieldEntry.CurrentValue = newValue
+
+		// Move cursor to after the inserted text
+		textFieldEntry.CursorPosition += len([]rune(clipboardLine))
+		shared.updateCursor(textFieldEntry)
+		shared.updateViewport(textFieldEntry)
+	}
+}
+
+/*
+pasteText is a method which allows you to paste text into a text field as a single edit, such as text received from
+a bracketed paste. The whole paste is undone at once, just as a paste from the clipboard would be. If the text field
+is disabled, then no operation takes place.
+
+Example:
+
+	TextField.pasteText("layer1", "textField1", "Hello")
+*/
+func (shared *textFieldType) pasteText(layerAlias string, textFieldAlias string, text string) {
+	textFieldEntry := TextFields.Get(layerAlias, textFieldAlias)
+	if !textFieldEntry.IsEnabled {
+		return
+	}
+	snapshot := shared.getSnapshot(textFieldEntry)
+	shared.insertPastedText(textFieldEntry, text)
+	if string(snapshot.CurrentValue) != string(textFieldEntry.CurrentValue) {
+		textFieldEntry.EditHistory.AddEntry(snapshot, constants.EditTypeReplace, constants.DefaultUndoHistoryLimit)
+		shared.validate(layerAlias, textFieldAlias, textFieldEntry)
+		shared.updateSuggestions(layerAlias, textFieldAlias, textFieldEntry)
+	}
+}
+
 /*
 updateKeyboardEvent is a method which updates the state of all text fields according to the current keystroke event.
 
@@ -767,7 +993,13 @@ func (shared *textFieldType) updateKeyboardEvent(keystroke []rune) (bool, bool)
 	if focusedControlType != constants.CellTypeTextField || !TextFields.IsExists(focusedLayerAlias, focusedControlAlias) {
 		return false, false
 	}
-	return shared.updateKeyboardEventManually(focusedLayerAlias, focusedControlAlias, keystroke)
+	// Pressing enter to accept a suggestion should not also submit the text field.
+	isSuggestionTrayOpen := TextFields.Get(focusedLayerAlias, focusedControlAlias).IsSuggestionTrayOpen
+	isScreenUpdateRequired, isKeystrokeConsumed := shared.updateKeyboardEventManually(focusedLayerAlias, focusedControlAlias, keystroke)
+	if string(keystroke) == "enter" && !isSuggestionTrayOpen {
+		fireSubmitHandler(focusedLayerAlias, focusedControlAlias)
+	}
+	return isScreenUpdateRequired, isKeystrokeConsumed
 }
 
 /*
@@ -782,7 +1014,7 @@ Example:
 	updateRequired := TextField.updateMouseEvent()
 */
 func (shared *textFieldType) updateMouseEvent() bool {
-	isScreenUpdateRequired := false
+	isScreenUpdateRequired := shared.updateSuggestionMouseEvent()
 	var characterEntry types.CharacterEntryType
 	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
 	if buttonPressed != 0 && eventStateMemory.stateId != constants.EventStateDragAndDropScrollbar &&
@@ -793,6 +1025,8 @@ func (shared *textFieldType) updateMouseEvent() bool {
 			if !textFieldEntry.IsEnabled {
 				return isScreenUpdateRequired
 			}
+			// Moving the cursor with the mouse ends the typing currently being grouped for undo.
+			textFieldEntry.EditHistory.EndTransaction()
 			textFieldEntry.CursorPosition = characterEntry.AttributeEntry.CellControlId
 			shared.updateCursor(textFieldEntry)
 			setFocusedControl(characterEntry.LayerAlias, characterEntry.AttributeEntry.CellControlAlias, constants.CellTypeTextField)
@@ -865,6 +1099,9 @@ In addition, the following should be noted:
 
 - If the text field is password protected, the value will be stored but displayed as masked characters.
 
+  - The value is not filtered by any input mask or character filter assigned, but it is validated, so an
+    unacceptable value will place the text field in its error state.
+
 - If the text field does not exist, the request will be ignored.
 
 Example:
@@ -878,6 +1115,122 @@ func (shared *TextFieldInstanceType) SetValue(value string) *TextFieldInstanceTy
 		textFieldEntry.CurrentValue = []rune(value + " ")
 		textFieldEntry.CursorPosition = len(value)
 		TextField.updateViewport(textFieldEntry)
+		TextField.validate(shared.layerAlias, shared.controlAlias, textFieldEntry)
+		TextField.closeSuggestionTray(shared.layerAlias, textFieldEntry)
+	}
+	return shared
+}
+
+/*
+Copy is a method which copies the text highlighted in the text field to the clipboard, just as if the user had pressed
+ctrl+c. If no text is highlighted, or the text field does not exist, then no operation takes place.
+
+Example:
+
+	textField.Copy()
+*/
+func (shared *TextFieldInstanceType) Copy() *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		TextField.updateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+c"))
+	}
+	return shared
+}
+
+/*
+Cut is a method which moves the text highlighted in the text field to the clipboard, just as if the user had pressed
+ctrl+x. If no text is highlighted, or the text field does not exist, then no operation takes place.
+
+Example:
+
+	textField.Cut()
+*/
+func (shared *TextFieldInstanceType) Cut() *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		TextField.updateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+x"))
+	}
+	return shared
+}
+
+/*
+Paste is a method which inserts the first line of the text held by the clipboard at the cursor of the text field,
+replacing any text highlighted, just as if the user had pressed ctrl+v. The paste is undone all at once. If the text
+field does not exist, then no operation takes place.
+
+Example:
+
+	textField.Paste()
+*/
+func (shared *TextFieldInstanceType) Paste() *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		TextField.updateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+v"))
+	}
+	return shared
+}
+
+/*
+Undo is a method which reverts the last edit made to the text field, just as if the user had pressed ctrl+z.
+
+In addition, the following should be noted:
+
+- Typing is undone a word at a time, while a paste or the deletion of highlighted text is undone all at once.
+
+- If there is nothing to undo, or the text field does not exist, false is returned.
+
+Example:
+
+	isUndone := textField.Undo()
+*/
+func (shared *TextFieldInstanceType) Undo() bool {
+	if !TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		return false
+	}
+	validatorTextField(shared.layerAlias, shared.controlAlias)
+	textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+	isUndone := TextField.undo(textFieldEntry)
+	TextField.updateCursor(textFieldEntry)
+	TextField.updateViewport(textFieldEntry)
+	TextField.validate(shared.layerAlias, shared.controlAlias, textFieldEntry)
+	return isUndone
+}
+
+/*
+Redo is a method which reapplies the last edit undone in the text field, just as if the user had pressed ctrl+y.
+
+In addition, the following should be noted:
+
+- Making a new edit after undoing discards anything which could have been redone.
+
+- If there is nothing to redo, or the text field does not exist, false is returned.
+
+Example:
+
+	isRedone := textField.Redo()
+*/
+func (shared *TextFieldInstanceType) Redo() bool {
+	if !TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		return false
+	}
+	validatorTextField(shared.layerAlias, shared.controlAlias)
+	textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+	isRedone := TextField.redo(textFieldEntry)
+	TextField.updateCursor(textFieldEntry)
+	TextField.updateViewport(textFieldEntry)
+	TextField.validate(shared.layerAlias, shared.controlAlias, textFieldEntry)
+	return isRedone
+}
+
+/*
+ClearUndoHistory is a method which discards all undo and redo history for the text field.
+
+Example:
+
+	textField.ClearUndoHistory()
+*/
+func (shared *TextFieldInstanceType) ClearUndoHistory() *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+		textFieldEntry.EditHistory.Clear()
 	}
 	return shared
 }
@@ -0,0 +1,435 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+	"strings"
+)
+
+/*
+TextFieldSuggestionProviderType is a type which represents a callback that supplies suggestions for a text field. It
+is passed the current value of the text field, and should return the suggestions to show for it, in the order they
+should appear.
+*/
+type TextFieldSuggestionProviderType func(value string) []string
+
+/*
+textFieldSuggestionEntryType is a structure which holds where the suggestions of a text field come from, along with
+the most suggestions which can be shown at once.
+*/
+type textFieldSuggestionEntryType struct {
+	suggestions []string
+	provider    TextFieldSuggestionProviderType
+	trayHeight  int
+}
+
+/*
+textFieldSuggestions is a variable which holds the suggestion source of every text field which offers suggestions,
+grouped by layer.
+*/
+var textFieldSuggestions = memory.NewControlMemoryManager[textFieldSuggestionEntryType]()
+
+/*
+SetSuggestions is a method which allows you to offer a fixed list of suggestions for a text field. As the user types,
+the suggestions which start with what has been typed are shown in a tray under the text field. If the text field
+instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- Suggestions are matched without regard to case.
+
+- The tray height is the most suggestions shown at once. If more match, a scroll bar is shown.
+
+- Up and down move through the suggestions, while enter or tab accepts the one highlighted. Escape closes the tray.
+
+- Clicking a suggestion with the mouse also accepts it.
+
+- Calling this method replaces any suggestion provider assigned previously.
+
+- If the tray height is less than or equal to 0, a panic will be generated.
+
+Example:
+
+	textField.SetSuggestions([]string{"alpha.example.com", "beta.example.com"}, 5)
+*/
+func (shared *TextFieldInstanceType) SetSuggestions(suggestions []string, trayHeight int) *TextFieldInstanceType {
+	validateSuggestionTrayHeight(trayHeight)
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		suggestionEntry := textFieldSuggestionEntryType{suggestions: append([]string{}, suggestions...), trayHeight: trayHeight}
+		TextField.setSuggestionSource(shared.layerAlias, shared.controlAlias, &suggestionEntry)
+	}
+	return shared
+}
+
+/*
+SetSuggestionProvider is a method which allows you to offer suggestions for a text field which are worked out as the
+user types, such as host names looked up from a list which changes, or files found on disk. If the text field
+instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+  - The provider is called every time the user changes the value of the text field, and should return quickly since
+    it is called while processing keystrokes.
+
+- Suggestions returned by the provider are shown exactly as given, without being matched against the value.
+
+- Pressing down while the tray is closed asks the provider for suggestions, even if nothing has been typed yet.
+
+- Calling this method replaces any list of suggestions assigned previously. Passing in nil removes all suggestions.
+
+- If the tray height is less than or equal to 0, a panic will be generated.
+
+Example:
+
+	textField.SetSuggestionProvider(func(value string) []string {
+		return getMatchingCommands(value)
+	}, 8)
+*/
+func (shared *TextFieldInstanceType) SetSuggestionProvider(provider TextFieldSuggestionProviderType, trayHeight int) *TextFieldInstanceType {
+	if provider == nil {
+		return shared.ClearSuggestions()
+	}
+	validateSuggestionTrayHeight(trayHeight)
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		suggestionEntry := textFieldSuggestionEntryType{provider: provider, trayHeight: trayHeight}
+		TextField.setSuggestionSource(shared.layerAlias, shared.controlAlias, &suggestionEntry)
+	}
+	return shared
+}
+
+/*
+ClearSuggestions is a method which allows you to stop offering suggestions for a text field. Any tray currently shown
+is closed. If the text field instance no longer exists, then no operation takes place.
+
+Example:
+
+	textField.ClearSuggestions()
+*/
+func (shared *TextFieldInstanceType) ClearSuggestions() *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		deleteTextFieldSuggestions(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+IsSuggestionTrayOpen is a method which allows you to check whether the suggestions of a text field are currently
+shown. If the text field instance no longer exists, then false is returned.
+
+Example:
+
+	isOpen := textField.IsSuggestionTrayOpen()
+*/
+func (shared *TextFieldInstanceType) IsSuggestionTrayOpen() bool {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+		return textFieldEntry.IsSuggestionTrayOpen
+	}
+	return false
+}
+
+/*
+setSuggestionSource is a method which allows you to assign where the suggestions of a text field come from. In
+addition, the following should be noted:
+
+  - The tray is a selector with a border, placed directly under the text field, just as a dropdown places its
+    tray under itself. Any tray created previously is replaced, so that its height matches the one requested.
+
+- Creating the tray does not take focus away from whichever control currently has it.
+
+Example:
+
+	TextField.setSuggestionSource("layer1", "textField1", &suggestionEntry)
+*/
+func (shared *textFieldType) setSuggestionSource(layerAlias string, textFieldAlias string, suggestionEntry *textFieldSuggestionEntryType) {
+	textFieldEntry := TextFields.Get(layerAlias, textFieldAlias)
+	shared.deleteSuggestionTray(layerAlias, textFieldEntry)
+	if textFieldSuggestions.IsExists(layerAlias, textFieldAlias) {
+		textFieldSuggestions.Remove(layerAlias, textFieldAlias)
+	}
+	textFieldSuggestions.Add(layerAlias, textFieldAlias, suggestionEntry)
+	// Here we subtract 2 from the width so that the border of the tray lines up with the edges of the text field.
+	itemWidth := textFieldEntry.Width - 2
+	if itemWidth < 1 {
+		itemWidth = 1
+	}
+	focusedControl := eventStateMemory.currentlyFocusedControl
+	textFieldEntry.SuggestionSelectorAlias = stringformat.GetLastSortedUUID()
+	// Here we add +1 to x and +2 to y to account for the border around the selection.
+	Selector.Add(layerAlias, textFieldEntry.SuggestionSelectorAlias, textFieldEntry.StyleEntry, types.NewSelectionEntry(), textFieldEntry.XLocation+1, textFieldEntry.YLocation+2, suggestionEntry.trayHeight, itemWidth, 1, 0, constants.NullItemSelection, false, true)
+	setFocusedControl(focusedControl.layerAlias, focusedControl.controlAlias, focusedControl.controlType)
+	selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+	selectorEntry.IsVisible = false
+	scrollBarEntry := ScrollBars.Get(layerAlias, selectorEntry.ScrollbarAlias)
+	scrollBarEntry.IsVisible = false
+}
+
+/*
+getSuggestions is a method which allows you to obtain the suggestions which should be shown for a value of a text
+field. If the text field offers no suggestions, an empty list is returned.
+
+Example:
+
+	suggestions := TextField.getSuggestions("layer1", "textField1", "al")
+*/
+func (shared *textFieldType) getSuggestions(layerAlias string, textFieldAlias string, value string) []string {
+	var suggestions []string
+	if !textFieldSuggestions.IsExists(layerAlias, textFieldAlias) {
+		return suggestions
+	}
+	suggestionEntry := textFieldSuggestions.Get(layerAlias, textFieldAlias)
+	if suggestionEntry.provider != nil {
+		return suggestionEntry.provider(value)
+	}
+	if value == "" {
+		return suggestions
+	}
+	for _, currentSuggestion := range suggestionEntry.suggestions {
+		if strings.HasPrefix(strings.ToLower(currentSuggestion), strings.ToLower(value)) {
+			suggestions = append(suggestions, currentSuggestion)
+		}
+	}
+	return suggestions
+}
+
+/*
+updateSuggestions is a method which allows you to refresh the suggestions shown for a text field after its value
+changes. In addition, the following should be noted:
+
+- If nothing matches, or the only match is exactly what has already been typed, the tray is closed.
+
+- Otherwise the tray is opened with the first suggestion highlighted.
+
+Example:
+
+	isOpen := TextField.updateSuggestions("layer1", "textField1", textFieldEntry)
+*/
+func (shared *textFieldType) updateSuggestions(layerAlias string, textFieldAlias string, textFieldEntry *types.TextFieldEntryType) bool {
+	if !textFieldSuggestions.IsExists(layerAlias, textFieldAlias) || !Selectors.IsExists(layerAlias, textFieldEntry.SuggestionSelectorAlias) {
+		return false
+	}
+	value := string(textFieldEntry.CurrentValue)
+	if len(textFieldEntry.CurrentValue) > 0 {
+		value = string(textFieldEntry.CurrentValue[:len(textFieldEntry.CurrentValue)-1])
+	}
+	suggestions := shared.getSuggestions(layerAlias, textFieldAlias, value)
+	if len(suggestions) == 0 || (len(suggestions) == 1 && suggestions[0] == value) {
+		shared.closeSuggestionTray(layerAlias, textFieldEntry)
+		return false
+	}
+	selectionEntry := types.NewSelectionEntry()
+	for _, currentSuggestion := range suggestions {
+		selectionEntry.Add(currentSuggestion, currentSuggestion)
+	}
+	suggestionEntry := textFieldSuggestions.Get(layerAlias, textFieldAlias)
+	var selectorInstance SelectorInstanceType
+	selectorInstance.layerAlias = layerAlias
+	selectorInstance.controlAlias = textFieldEntry.SuggestionSelectorAlias
+	selectorInstance.SetSelectionEntry(selectionEntry)
+	selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+	selectorEntry.Height = suggestionEntry.trayHeight
+	if len(suggestions) < selectorEntry.Height {
+		selectorEntry.Height = len(suggestions)
+	}
+	selectorEntry.XLocation = textFieldEntry.XLocation + 1
+	selectorEntry.YLocation = textFieldEntry.YLocation + 2
+	selectorEntry.ItemHighlighted = 0
+	selectorEntry.IsVisible = true
+	scrollBarEntry := ScrollBars.Get(layerAlias, selectorEntry.ScrollbarAlias)
+	scrollBarEntry.ScrollValue = 0
+	scrollbar.computeHandlePositionByScrollValue(layerAlias, selectorEntry.ScrollbarAlias)
+	textFieldEntry.IsSuggestionTrayOpen = true
+	return true
+}
+
+/*
+closeSuggestionTray is a method which allows you to hide the suggestions of a text field. If the tray is not open,
+then no operation will be performed.
+
+Example:
+
+	TextField.closeSuggestionTray("layer1", textFieldEntry)
+*/
+func (shared *textFieldType) closeSuggestionTray(layerAlias string, textFieldEntry *types.TextFieldEntryType) {
+	textFieldEntry.IsSuggestionTrayOpen = false
+	if !Selectors.IsExists(layerAlias, textFieldEntry.SuggestionSelectorAlias) {
+		return
+	}
+	selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+	selectorEntry.IsVisible = false
+	if ScrollBars.IsExists(layerAlias, selectorEntry.ScrollbarAlias) {
+		scrollBarEntry := ScrollBars.Get(layerAlias, selectorEntry.ScrollbarAlias)
+		scrollBarEntry.IsVisible = false
+	}
+}
+
+/*
+acceptSuggestion is a method which allows you to replace the value of a text field with one of the suggestions shown
+in its tray, and then close the tray. In addition, the following should be noted:
+
+- The cursor is placed at the end of the new value.
+
+- Accepting a suggestion can be undone in a single step.
+
+- If the item index does not refer to a suggestion, the tray is closed without changing the value.
+
+Example:
+
+	TextField.acceptSuggestion("layer1", "textField1", textFieldEntry, 0)
+*/
+func (shared *textFieldType) acceptSuggestion(layerAlias string, textFieldAlias string, textFieldEntry *types.TextFieldEntryType, itemIndex int) {
+	selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+	shared.closeSuggestionTray(layerAlias, textFieldEntry)
+	if itemIndex < 0 || itemIndex >= len(selectorEntry.SelectionEntry.SelectionValue) {
+		return
+	}
+	suggestion := selectorEntry.SelectionEntry.SelectionValue[itemIndex]
+	textFieldEntry.EditHistory.AddEntry(shared.getSnapshot(textFieldEntry), constants.EditTypeReplace, constants.DefaultUndoHistoryLimit)
+	textFieldEntry.CurrentValue = []rune(suggestion + " ")
+	textFieldEntry.CursorPosition = len(textFieldEntry.CurrentValue) - 1
+	textFieldEntry.IsHighlightActive = false
+	shared.updateViewport(textFieldEntry)
+	shared.validate(layerAlias, textFieldAlias, textFieldEntry)
+}
+
+/*
+updateSuggestionKeyboardEvent is a method which allows you to process a keystroke meant for the suggestion tray of a
+text field. If the keystroke was handled by the tray, true is returned and the text field should not process it any
+further.
+
+Example:
+
+	isHandled := TextField.updateSuggestionKeyboardEvent("layer1", "textField1", textFieldEntry, "down")
+*/
+func (shared *textFieldType) updateSuggestionKeyboardEvent(layerAlias string, textFieldAlias string, textFieldEntry *types.TextFieldEntryType, keystroke string) bool {
+	if !textFieldSuggestions.IsExists(layerAlias, textFieldAlias) || !Selectors.IsExists(layerAlias, textFieldEntry.SuggestionSelectorAlias) {
+		return false
+	}
+	if !textFieldEntry.IsSuggestionTrayOpen {
+		if keystroke == "down" {
+			return shared.updateSuggestions(layerAlias, textFieldAlias, textFieldEntry)
+		}
+		return false
+	}
+	switch keystroke {
+	case "down", "up":
+		Selector.updateKeyboardEventForSelector(layerAlias, textFieldEntry.SuggestionSelectorAlias, []rune(keystroke))
+		return true
+	case "enter", "tab":
+		selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+		shared.acceptSuggestion(layerAlias, textFieldAlias, textFieldEntry, selectorEntry.ItemHighlighted)
+		return true
+	case "esc", "escape":
+		shared.closeSuggestionTray(layerAlias, textFieldEntry)
+		return true
+	}
+	return false
+}
+
+/*
+updateSuggestionMouseEvent is a method which allows you to close the suggestion trays of all text fields when the
+user clicks somewhere outside of them. Clicking a tray or its scroll bar leaves it open, so that a suggestion can be
+picked or scrolled to.
+
+Example:
+
+	isUpdateRequired := TextField.updateSuggestionMouseEvent()
+*/
+func (shared *textFieldType) updateSuggestionMouseEvent() bool {
+	isUpdateRequired := false
+	mouseXLocation, mouseYLocation, buttonPressed, _ := GetMouseStatus()
+	_, _, previousButtonPress, _ := GetPreviousMouseStatus()
+	if buttonPressed == 0 || previousButtonPress != 0 {
+		return isUpdateRequired
+	}
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	cellControlAlias := characterEntry.AttributeEntry.CellControlAlias
+	TextFields.MemoryManager.Range(func(key, value interface{}) bool {
+		layerAlias := key.(string)
+		for _, textFieldEntry := range TextFields.GetAllEntries(layerAlias) {
+			if !textFieldEntry.IsSuggestionTrayOpen || !Selectors.IsExists(layerAlias, textFieldEntry.SuggestionSelectorAlias) {
+				continue
+			}
+			selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+			if characterEntry.LayerAlias == layerAlias && (cellControlAlias == selectorEntry.Alias || cellControlAlias == selectorEntry.ScrollbarAlias) {
+				continue
+			}
+			shared.closeSuggestionTray(layerAlias, textFieldEntry)
+			isUpdateRequired = true
+		}
+		return true
+	})
+	return isUpdateRequired
+}
+
+/*
+getSuggestionTrayOwner is a method which allows you to obtain the alias of the text field a selector belongs to, if
+the selector is the suggestion tray of a text field. Otherwise, an empty string is returned.
+
+Example:
+
+	textFieldAlias := TextField.getSuggestionTrayOwner("layer1", selectorAlias)
+*/
+func (shared *textFieldType) getSuggestionTrayOwner(layerAlias string, selectorAlias string) string {
+	for _, textFieldEntry := range TextFields.GetAllEntries(layerAlias) {
+		if textFieldEntry.SuggestionSelectorAlias == selectorAlias {
+			return textFieldEntry.Alias
+		}
+	}
+	return ""
+}
+
+/*
+isFocusedSuggestionTrayOpen is a method which allows you to check whether the text field which currently has focus is
+showing its suggestions. This is used so that tab can accept a suggestion instead of moving focus.
+
+Example:
+
+	isOpen := TextField.isFocusedSuggestionTrayOpen()
+*/
+func (shared *textFieldType) isFocusedSuggestionTrayOpen() bool {
+	focusedControl := eventStateMemory.currentlyFocusedControl
+	if focusedControl.controlType != constants.CellTypeTextField || !TextFields.IsExists(focusedControl.layerAlias, focusedControl.controlAlias) {
+		return false
+	}
+	return TextFields.Get(focusedControl.layerAlias, focusedControl.controlAlias).IsSuggestionTrayOpen
+}
+
+/*
+deleteSuggestionTray is a method which allows you to remove the selector and scroll bar used to show the suggestions
+of a text field. If the text field has no tray, then no operation will be performed.
+
+Example:
+
+	TextField.deleteSuggestionTray("layer1", textFieldEntry)
+*/
+func (shared *textFieldType) deleteSuggestionTray(layerAlias string, textFieldEntry *types.TextFieldEntryType) {
+	if Selectors.IsExists(layerAlias, textFieldEntry.SuggestionSelectorAlias) {
+		selectorEntry := Selectors.Get(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+		if ScrollBars.IsExists(layerAlias, selectorEntry.ScrollbarAlias) {
+			ScrollBars.Remove(layerAlias, selectorEntry.ScrollbarAlias)
+		}
+		Selectors.Remove(layerAlias, textFieldEntry.SuggestionSelectorAlias)
+	}
+	textFieldEntry.SuggestionSelectorAlias = ""
+	textFieldEntry.IsSuggestionTrayOpen = false
+}
+
+/*
+deleteTextFieldSuggestions is a method which allows you to remove the suggestions offered by a text field, along
+with the tray used to show them. If the text field offers no suggestions, then no operation will be performed.
+
+Example:
+
+	deleteTextFieldSuggestions("layer1", "textField1")
+*/
+func deleteTextFieldSuggestions(layerAlias string, textFieldAlias string) {
+	if TextFields.IsExists(layerAlias, textFieldAlias) {
+		TextField.deleteSuggestionTray(layerAlias, TextFields.Get(layerAlias, textFieldAlias))
+	}
+	if textFieldSuggestions.IsExists(layerAlias, textFieldAlias) {
+		textFieldSuggestions.Remove(layerAlias, textFieldAlias)
+	}
+}
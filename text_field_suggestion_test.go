@@ -0,0 +1,94 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+TestTextFieldSuggestions is a test which verifies that a text field shows matching suggestions from a fixed list as
+the user types, and that one can be picked with the keyboard.
+
+Example:
+
+	Expected Inputs:
+	    A text field offering three suggestions, typed into, navigated with the arrow keys, and accepted with enter.
+
+	Expected Outputs:
+	    Only suggestions starting with what was typed are shown, the highlighted suggestion replaces the value when
+	    accepted without submitting the text field, and the change can be undone in one step.
+*/
+func TestTextFieldSuggestions(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	textFieldInstance.SetSuggestions([]string{"alpha", "Alpine", "beta"}, 5)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	isSubmitted := false
+	textFieldInstance.OnSubmit(func(control *BaseControlInstanceType) { isSubmitted = true })
+	assert.Falsef(test, textFieldInstance.IsSuggestionTrayOpen(), "The suggestion tray was open before anything was typed!")
+	TextField.updateKeyboardEventTextboxWithString("al")
+	assert.Truef(test, textFieldInstance.IsSuggestionTrayOpen(), "The suggestion tray was not opened when suggestions matched!")
+	textFieldEntry := TextFields.Get(layer1.layerAlias, textFieldInstance.controlAlias)
+	selectorEntry := Selectors.Get(layer1.layerAlias, textFieldEntry.SuggestionSelectorAlias)
+	assert.Equalf(test, []string{"alpha", "Alpine"}, selectorEntry.SelectionEntry.SelectionValue, "The wrong suggestions were shown!")
+	assert.Equalf(test, 2, selectorEntry.Height, "The tray was not sized to fit its suggestions!")
+	assert.Equalf(test, textFieldInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "The text field lost focus when its suggestions were shown!")
+
+	TextField.updateKeyboardEvent([]rune("down"))
+	TextField.updateKeyboardEvent([]rune("enter"))
+	assert.Equalf(test, "Alpine", textFieldInstance.GetValue(), "The highlighted suggestion was not accepted!")
+	assert.Falsef(test, textFieldInstance.IsSuggestionTrayOpen(), "The suggestion tray was not closed after a suggestion was accepted!")
+	assert.Falsef(test, isSubmitted, "Accepting a suggestion also submitted the text field!")
+	textFieldInstance.Undo()
+	assert.Equalf(test, "al", textFieldInstance.GetValue(), "Accepting a suggestion could not be undone in one step!")
+
+	TextField.updateKeyboardEventTextboxWithString("z")
+	assert.Falsef(test, textFieldInstance.IsSuggestionTrayOpen(), "The suggestion tray stayed open when nothing matched!")
+	TextField.updateKeyboardEvent([]rune("enter"))
+	assert.Truef(test, isSubmitted, "Pressing enter without suggestions shown did not submit the text field!")
+	assert.Panicsf(test, func() { textFieldInstance.SetSuggestions([]string{"alpha"}, 0) }, "An invalid tray height did not panic!")
+}
+
+/*
+TestTextFieldSuggestionProvider is a test which verifies that a text field can ask a provider for its suggestions,
+and that the tray can be closed or removed.
+
+Example:
+
+	Expected Inputs:
+	    A text field whose provider returns host names built from what was typed, accepted with tab, opened again
+	    with the down arrow, and closed with escape.
+
+	Expected Outputs:
+	    The provider is asked for suggestions as the user types, tab accepts the first suggestion without moving
+	    focus, and escape or clearing the suggestions closes the tray.
+*/
+func TestTextFieldSuggestionProvider(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	layer1.AddTextField(styleEntry, 2, 6, 20, 30, false, "", true)
+	textFieldInstance.SetSuggestionProvider(func(value string) []string {
+		return []string{value + ".example.com", value + ".example.org"}
+	}, 3)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	TextField.updateKeyboardEventTextboxWithString("host")
+	assert.Truef(test, textFieldInstance.IsSuggestionTrayOpen(), "The suggestions of the provider were not shown!")
+	assert.Truef(test, TextField.isFocusedSuggestionTrayOpen(), "The tray of the focused text field was not reported as open!")
+	TextField.updateKeyboardEvent([]rune("tab"))
+	assert.Equalf(test, "host.example.com", textFieldInstance.GetValue(), "Tab did not accept the highlighted suggestion!")
+	assert.Equalf(test, textFieldInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Accepting a suggestion moved focus!")
+
+	TextField.updateKeyboardEvent([]rune("down"))
+	assert.Truef(test, textFieldInstance.IsSuggestionTrayOpen(), "The down arrow did not open the suggestion tray!")
+	TextField.updateKeyboardEvent([]rune("esc"))
+	assert.Falsef(test, textFieldInstance.IsSuggestionTrayOpen(), "Escape did not close the suggestion tray!")
+	assert.Equalf(test, "host.example.com", textFieldInstance.GetValue(), "Closing the tray changed the value!")
+
+	textFieldEntry := TextFields.Get(layer1.layerAlias, textFieldInstance.controlAlias)
+	selectorAlias := textFieldEntry.SuggestionSelectorAlias
+	textFieldInstance.ClearSuggestions()
+	assert.Falsef(test, Selectors.IsExists(layer1.layerAlias, selectorAlias), "The suggestion tray was not removed when the suggestions were cleared!")
+	TextField.updateKeyboardEvent([]rune("down"))
+	assert.Falsef(test, textFieldInstance.IsSuggestionTrayOpen(), "The suggestion tray was opened after the suggestions were cleared!")
+}
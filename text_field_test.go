@@ -337,3 +337,47 @@ func TestTextFieldBackspaceStop(test *testing.T) {
 		fmt.Println("Obtained:\n", obtainedValueBase64)
 	}
 }
+
+/*
+TestTextFieldUndoRedo is a test which verifies that edits made to a text field can be undone and redone.
+
+Example:
+
+	Expected Inputs:
+	    Two words typed into a text field, followed by a group of deletes and a character typed over highlighted
+	    text, with ctrl+z and ctrl+y pressed in between.
+
+	Expected Outputs:
+	    Typing is undone a word at a time, consecutive deletes are undone together, highlighted text which was typed
+	    over is restored in one step, and redo reapplies each change.
+*/
+func TestTextFieldUndoRedo(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	TextField.updateKeyboardEventTextboxWithString("save file")
+	TextField.updateKeyboardEvent([]rune("ctrl+z"))
+	assert.Equalf(test, "save ", textFieldInstance.GetValue(), "Undo did not remove the last word typed!")
+	TextField.updateKeyboardEvent([]rune("ctrl+z"))
+	assert.Equalf(test, "", textFieldInstance.GetValue(), "Undo did not remove the first word typed!")
+	TextField.updateKeyboardEvent([]rune("ctrl+y"))
+	assert.Truef(test, textFieldInstance.Redo(), "Redo reported failure when there was something to redo!")
+	assert.Equalf(test, "save file", textFieldInstance.GetValue(), "Redo did not restore the words undone!")
+
+	TextField.updateKeyboardEventTextboxWithCommands("home", "delete", "delete")
+	assert.Equalf(test, "ve file", textFieldInstance.GetValue(), "Delete did not remove the expected characters!")
+	assert.Truef(test, textFieldInstance.Undo(), "Undo reported failure when there was something to undo!")
+	assert.Equalf(test, "save file", textFieldInstance.GetValue(), "Consecutive deletes were not undone together!")
+
+	textFieldEntry := TextFields.Get(layer1.layerAlias, textFieldInstance.controlAlias)
+	textFieldEntry.IsHighlightActive = true
+	textFieldEntry.HighlightStart = 0
+	textFieldEntry.HighlightEnd = 3
+	textFieldEntry.CursorPosition = 0
+	TextField.updateKeyboardEvent([]rune("x"))
+	assert.Equalf(test, "x file", textFieldInstance.GetValue(), "The highlighted text was not replaced!")
+	TextField.updateKeyboardEvent([]rune("ctrl+z"))
+	assert.Equalf(test, "save file", textFieldInstance.GetValue(), "Undo did not restore the highlighted text which was replaced!")
+	textFieldInstance.ClearUndoHistory()
+	assert.Falsef(test, textFieldInstance.Undo(), "Undo reported success after the history was cleared!")
+}
@@ -0,0 +1,455 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/types"
+	"unicode"
+)
+
+/*
+TextFieldValidatorType is a type which represents a callback that checks the value of a text field. It should return
+nil if the value is acceptable, or an error describing what is wrong with it.
+*/
+type TextFieldValidatorType func(value string) error
+
+/*
+textFieldValidatorEntryType is a structure which holds the validator assigned to a text field.
+*/
+type textFieldValidatorEntryType struct {
+	validator TextFieldValidatorType
+}
+
+/*
+textFieldValidators is a variable which holds the validator of every text field which has one assigned, grouped by
+layer.
+*/
+var textFieldValidators = memory.NewControlMemoryManager[textFieldValidatorEntryType]()
+
+/*
+SetInputMask is a method which allows you to restrict a text field to values of a fixed format, such as a date or a
+phone number. If the text field instance no longer exists, then no operation takes place. In addition, the following
+should be noted:
+
+  - Each character of the mask describes what may be typed in the same position. '#' accepts a digit, 'A' accepts a
+    letter, 'H' accepts a hexadecimal digit, and '*' accepts a letter or a digit. Any other character is a literal
+    which is inserted automatically as the user types.
+
+  - Ready made masks are available, such as 'constants.InputMaskDate', 'constants.InputMaskTime', and
+    'constants.InputMaskPhoneNumber'. Since every position of a mask must be filled, values whose parts vary in
+    length, such as IPv4 addresses, should be checked with SetValidator instead.
+
+  - Typing inside an existing value overwrites the characters already there, and deleting a character clears its
+    position instead of moving the characters after it, so that the format is kept intact.
+
+- The value is only considered valid when it is empty or when every position of the mask has been filled.
+
+- Passing in an empty mask removes it.
+
+Example:
+
+	textField.SetInputMask(constants.InputMaskDate)
+*/
+func (shared *TextFieldInstanceType) SetInputMask(inputMask string) *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+		textFieldEntry.InputMask = []rune(inputMask)
+		TextField.validate(shared.layerAlias, shared.controlAlias, textFieldEntry)
+	}
+	return shared
+}
+
+/*
+SetCharacterFilter is a method which allows you to restrict which characters can be typed or pasted into a text
+field. If the text field instance no longer exists, then no operation takes place. In addition, the following should
+be noted:
+
+  - The supported filters are 'constants.CharacterFilterDigits', 'constants.CharacterFilterHex',
+    'constants.CharacterFilterAlphanumeric', 'constants.CharacterFilterInteger', and
+    'constants.CharacterFilterDecimal'. Passing in 'constants.CharacterFilterNone' removes the filter.
+
+  - The integer filter also accepts a minus sign, while the decimal filter accepts both a minus sign and a
+    decimal point.
+
+- Characters which do not pass the filter are ignored when typed, and left out when pasted.
+
+- If an invalid filter type is passed in, a panic will be generated.
+
+Example:
+
+	textField.SetCharacterFilter(constants.CharacterFilterDigits)
+*/
+func (shared *TextFieldInstanceType) SetCharacterFilter(filterType int) *TextFieldInstanceType {
+	validateCharacterFilterType(filterType)
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+		textFieldEntry.CharacterFilter = filterType
+		TextField.validate(shared.layerAlias, shared.controlAlias, textFieldEntry)
+	}
+	return shared
+}
+
+/*
+SetValidator is a method which allows you to assign a callback which checks the value of a text field every time it
+changes. If the text field instance no longer exists, then no operation takes place. In addition, the following should
+be noted:
+
+  - The validator is called after every edit the user makes, as well as when the value is set programmatically, so
+    that mistakes can be shown while the user is still typing rather than after the form is submitted.
+
+  - If the validator returns an error, the text field is drawn in its error colors, and the message of the error can
+    be obtained with GetValidationMessage.
+
+- The validator is only called once the value satisfies any input mask or character filter assigned.
+
+- Registering a new validator replaces any validator registered previously. Passing in nil removes it.
+
+Example:
+
+	textField.SetValidator(func(value string) error {
+		if value == "" {
+			return errors.New("A name is required.")
+		}
+		return nil
+	})
+*/
+func (shared *TextFieldInstanceType) SetValidator(validator TextFieldValidatorType) *TextFieldInstanceType {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		deleteTextFieldValidator(shared.layerAlias, shared.controlAlias)
+		if validator != nil {
+			validatorEntry := textFieldValidatorEntryType{validator: validator}
+			textFieldValidators.Add(shared.layerAlias, shared.controlAlias, &validatorEntry)
+		}
+		textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+		TextField.validate(shared.layerAlias, shared.controlAlias, textFieldEntry)
+	}
+	return shared
+}
+
+/*
+IsValid is a method which allows you to check whether the current value of a text field satisfies its input mask,
+character filter, and validator. If the text field instance no longer exists, then true is returned.
+
+Example:
+
+	if !textField.IsValid() {
+		statusLabel.SetValue(textField.GetValidationMessage())
+	}
+*/
+func (shared *TextFieldInstanceType) IsValid() bool {
+	return shared.GetValidationMessage() == ""
+}
+
+/*
+GetValidationMessage is a method which allows you to obtain the reason the current value of a text field is invalid.
+If the value is valid, or the text field instance no longer exists, then an empty string is returned.
+
+Example:
+
+	message := textField.GetValidationMessage()
+*/
+func (shared *TextFieldInstanceType) GetValidationMessage() string {
+	if TextFields.IsExists(shared.layerAlias, shared.controlAlias) {
+		validatorTextField(shared.layerAlias, shared.controlAlias)
+		textFieldEntry := TextFields.Get(shared.layerAlias, shared.controlAlias)
+		return textFieldEntry.ValidationMessage
+	}
+	return ""
+}
+
+/*
+validate is a method which allows you to check the current value of a text field and record whether it is valid. In
+addition, the following should be noted:
+
+  - The input mask is checked first, followed by the character filter, and finally the validator. Literals of the
+    mask are not checked against the character filter.
+
+- Only the first problem found is recorded.
+
+Example:
+
+	TextField.validate("layer1", "textField1", textFieldEntry)
+*/
+func (shared *textFieldType) validate(layerAlias string, textFieldAlias string, textFieldEntry *types.TextFieldEntryType) {
+	var value []rune
+	if len(textFieldEntry.CurrentValue) > 0 {
+		value = textFieldEntry.CurrentValue[:len(textFieldEntry.CurrentValue)-1]
+	}
+	textFieldEntry.ValidationMessage = ""
+	if !shared.isValueMatchingInputMask(textFieldEntry.InputMask, value) {
+		textFieldEntry.ValidationMessage = fmt.Sprintf("The value must match the format '%s'.", string(textFieldEntry.InputMask))
+		return
+	}
+	for currentIndex, currentCharacter := range value {
+		if len(textFieldEntry.InputMask) > 0 && !shared.isInputMaskPlaceholder(textFieldEntry.InputMask[currentIndex]) {
+			continue
+		}
+		if !shared.isCharacterAllowedByFilter(textFieldEntry.CharacterFilter, currentCharacter) {
+			textFieldEntry.ValidationMessage = fmt.Sprintf("The character '%c' is not allowed.", currentCharacter)
+			return
+		}
+	}
+	if textFieldValidators.IsExists(layerAlias, textFieldAlias) {
+		validatorEntry := textFieldValidators.Get(layerAlias, textFieldAlias)
+		if err := validatorEntry.validator(string(value)); err != nil {
+			textFieldEntry.ValidationMessage = err.Error()
+		}
+	}
+}
+
+/*
+isValueMatchingInputMask is a method which allows you to check whether a value satisfies an input mask. A value
+matches if the mask is empty, the value is empty, or every position of the mask has been filled with an acceptable
+character.
+
+Example:
+
+	isMatching := TextField.isValueMatchingInputMask([]rune("####"), []rune("2024"))
+*/
+func (shared *textFieldType) isValueMatchingInputMask(inputMask []rune, value []rune) bool {
+	if len(inputMask) == 0 || len(value) == 0 {
+		return true
+	}
+	if len(value) != len(inputMask) {
+		return false
+	}
+	for currentIndex, currentCharacter := range value {
+		if shared.isInputMaskPlaceholder(inputMask[currentIndex]) {
+			if !shared.isCharacterAllowedByInputMask(inputMask[currentIndex], currentCharacter) {
+				return false
+			}
+		} else if currentCharacter != inputMask[currentIndex] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+isInputMaskPlaceholder is a method which allows you to check whether a character of an input mask is a placeholder
+for user input, rather than a literal.
+
+Example:
+
+	isPlaceholder := TextField.isInputMaskPlaceholder('#')
+*/
+func (shared *textFieldType) isInputMaskPlaceholder(maskCharacter rune) bool {
+	switch maskCharacter {
+	case '#', 'A', 'H', '*':
+		return true
+	}
+	return false
+}
+
+/*
+isCharacterAllowedByInputMask is a method which allows you to check whether a character may be typed in a position
+of an input mask with the given placeholder.
+
+Example:
+
+	isAllowed := TextField.isCharacterAllowedByInputMask('#', '7')
+*/
+func (shared *textFieldType) isCharacterAllowedByInputMask(maskCharacter rune, character rune) bool {
+	switch maskCharacter {
+	case '#':
+		return shared.isCharacterAllowedByFilter(constants.CharacterFilterDigits, character)
+	case 'A':
+		return unicode.IsLetter(character)
+	case 'H':
+		return shared.isCharacterAllowedByFilter(constants.CharacterFilterHex, character)
+	case '*':
+		return shared.isCharacterAllowedByFilter(constants.CharacterFilterAlphanumeric, character)
+	}
+	return false
+}
+
+/*
+isCharacterAllowedByFilter is a method which allows you to check whether a character passes a character filter. Every
+character passes 'constants.CharacterFilterNone'.
+
+Example:
+
+	isAllowed := TextField.isCharacterAllowedByFilter(constants.CharacterFilterHex, 'f')
+*/
+func (shared *textFieldType) isCharacterAllowedByFilter(filterType int, character rune) bool {
+	switch filterType {
+	case constants.CharacterFilterDigits:
+		return character >= '0' && character <= '9'
+	case constants.CharacterFilterHex:
+		return (character >= '0' && character <= '9') || (character >= 'a' && character <= 'f') || (character >= 'A' && character <= 'F')
+	case constants.CharacterFilterAlphanumeric:
+		return unicode.IsLetter(character) || unicode.IsDigit(character)
+	case constants.CharacterFilterInteger:
+		return (character >= '0' && character <= '9') || character == '-'
+	case constants.CharacterFilterDecimal:
+		return (character >= '0' && character <= '9') || character == '-' || character == '.'
+	}
+	return true
+}
+
+/*
+getFilteredText is a method which allows you to remove every character from a piece of text which does not pass the
+character filter of a text field, such as before pasting it in.
+
+Example:
+
+	filteredText := TextField.getFilteredText(textFieldEntry, "12-34")
+*/
+func (shared *textFieldType) getFilteredText(textFieldEntry *types.TextFieldEntryType, text string) string {
+	var filteredText []rune
+	for _, currentCharacter := range text {
+		if shared.isCharacterAllowedByFilter(textFieldEntry.CharacterFilter, currentCharacter) {
+			filteredText = append(filteredText, currentCharacter)
+		}
+	}
+	return string(filteredText)
+}
+
+/*
+insertMaskedCharacter is a method which allows you to type a character into a text field which has an input mask.
+In addition, the following should be noted:
+
+  - Literals of the mask found at the cursor are filled in automatically, until a placeholder which accepts the
+    character is reached. Typing the literal itself simply moves past it.
+
+- Characters are written over any already present, so that the rest of the value keeps its format.
+
+- The character filter of the text field, if any, is applied to placeholders but not to literals.
+
+- If the character cannot be placed, the text field is left unchanged and false is returned.
+
+Example:
+
+	isInserted := TextField.insertMaskedCharacter(textFieldEntry, '7')
+*/
+func (shared *textFieldType) insertMaskedCharacter(textFieldEntry *types.TextFieldEntryType, character rune) bool {
+	inputMask := textFieldEntry.InputMask
+	maskPosition := textFieldEntry.CursorPosition
+	for maskPosition < len(inputMask) && !shared.isInputMaskPlaceholder(inputMask[maskPosition]) {
+		if inputMask[maskPosition] == character {
+			break
+		}
+		maskPosition++
+	}
+	if maskPosition >= len(inputMask) {
+		return false
+	}
+	if shared.isInputMaskPlaceholder(inputMask[maskPosition]) {
+		if !shared.isCharacterAllowedByInputMask(inputMask[maskPosition], character) || !shared.isCharacterAllowedByFilter(textFieldEntry.CharacterFilter, character) {
+			return false
+		}
+	}
+	for currentPosition := textFieldEntry.CursorPosition; currentPosition < maskPosition; currentPosition++ {
+		shared.setCharacterAtPosition(textFieldEntry, currentPosition, inputMask[currentPosition])
+	}
+	shared.setCharacterAtPosition(textFieldEntry, maskPosition, character)
+	textFieldEntry.CursorPosition = maskPosition + 1
+	return true
+}
+
+/*
+deleteMaskedCharacter is a method which allows you to delete a single character from a text field which has an input
+mask. In addition, the following should be noted:
+
+  - Backspace clears the closest placeholder before the cursor, and delete clears the closest placeholder at or after
+    it. Literals of the mask are skipped, since they are filled in automatically.
+
+- The cursor is moved to the position cleared, so that typing fills it in again.
+
+Example:
+
+	TextField.deleteMaskedCharacter(textFieldEntry, true)
+*/
+func (shared *textFieldType) deleteMaskedCharacter(textFieldEntry *types.TextFieldEntryType, isBackspace bool) {
+	inputMask := textFieldEntry.InputMask
+	lastPosition := len(textFieldEntry.CurrentValue) - 1
+	if lastPosition > len(inputMask) {
+		lastPosition = len(inputMask)
+	}
+	position := textFieldEntry.CursorPosition
+	if isBackspace {
+		position--
+		for position >= 0 && position < lastPosition && !shared.isInputMaskPlaceholder(inputMask[position]) {
+			position--
+		}
+	} else {
+		for position >= 0 && position < lastPosition && !shared.isInputMaskPlaceholder(inputMask[position]) {
+			position++
+		}
+	}
+	if position < 0 || position >= lastPosition {
+		return
+	}
+	shared.deleteMaskedText(textFieldEntry, position, position)
+}
+
+/*
+deleteMaskedText is a method which allows you to delete a range of characters from a text field which has an input
+mask. In addition, the following should be noted:
+
+  - Placeholders in the range are cleared in place, and literals are kept, so that the characters after the range
+    remain at the positions the mask expects them.
+
+  - If nothing but literals and cleared placeholders would remain after the range, the value is shortened to end
+    where the range starts instead.
+
+- The cursor is moved to the start of the range.
+
+Example:
+
+	TextField.deleteMaskedText(textFieldEntry, 5, 6)
+*/
+func (shared *textFieldType) deleteMaskedText(textFieldEntry *types.TextFieldEntryType, start int, end int) {
+	inputMask := textFieldEntry.InputMask
+	isTrailingTextFilled := false
+	for currentPosition := end + 1; currentPosition < len(textFieldEntry.CurrentValue)-1; currentPosition++ {
+		if currentPosition >= len(inputMask) || (shared.isInputMaskPlaceholder(inputMask[currentPosition]) && textFieldEntry.CurrentValue[currentPosition] != ' ') {
+			isTrailingTextFilled = true
+			break
+		}
+	}
+	if !isTrailingTextFilled {
+		textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:start], ' ')
+	} else {
+		for currentPosition := start; currentPosition <= end; currentPosition++ {
+			if currentPosition < len(inputMask) && shared.isInputMaskPlaceholder(inputMask[currentPosition]) {
+				textFieldEntry.CurrentValue[currentPosition] = ' '
+			}
+		}
+	}
+	textFieldEntry.CursorPosition = start
+}
+
+/*
+setCharacterAtPosition is a method which allows you to replace the character at a position of a text field. If the
+position is at the end of the value, the character is appended instead.
+
+Example:
+
+	TextField.setCharacterAtPosition(textFieldEntry, 4, '-')
+*/
+func (shared *textFieldType) setCharacterAtPosition(textFieldEntry *types.TextFieldEntryType, position int, character rune) {
+	if position < len(textFieldEntry.CurrentValue)-1 {
+		textFieldEntry.CurrentValue[position] = character
+		return
+	}
+	textFieldEntry.CurrentValue = append(textFieldEntry.CurrentValue[:len(textFieldEntry.CurrentValue)-1], character, ' ')
+}
+
+/*
+deleteTextFieldValidator is a method which allows you to remove the validator assigned to a text field. If the text
+field has no validator, then no operation will be performed.
+
+Example:
+
+	deleteTextFieldValidator("layer1", "textField1")
+*/
+func deleteTextFieldValidator(layerAlias string, textFieldAlias string) {
+	if textFieldValidators.IsExists(layerAlias, textFieldAlias) {
+		textFieldValidators.Remove(layerAlias, textFieldAlias)
+	}
+}
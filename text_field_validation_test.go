@@ -0,0 +1,162 @@
+package consolizer
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"strings"
+	"testing"
+)
+
+/*
+TestTextFieldInputMask is a test which verifies that an input mask restricts what can be typed into a text field and
+fills in its literals automatically.
+
+Example:
+
+	Expected Inputs:
+	    A text field with a date mask, typed into with digits, letters, and literals, and then edited in the middle.
+
+	Expected Outputs:
+	    Only digits are accepted in digit positions, literals are inserted as the user types, characters past the end
+	    of the mask are ignored, and the field is only valid when empty or fully filled.
+*/
+func TestTextFieldInputMask(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	textFieldInstance.SetInputMask(constants.InputMaskDate)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	assert.Truef(test, textFieldInstance.IsValid(), "An empty masked text field was not valid!")
+	TextField.updateKeyboardEventTextboxWithString("2024x")
+	assert.Equalf(test, "2024", textFieldInstance.GetValue(), "A letter was accepted in a digit position!")
+	TextField.updateKeyboardEventTextboxWithString("01")
+	assert.Equalf(test, "2024-01", textFieldInstance.GetValue(), "The literal was not inserted automatically!")
+	assert.Falsef(test, textFieldInstance.IsValid(), "A partially filled masked text field was valid!")
+	assert.Equalf(test, "The value must match the format '####-##-##'.", textFieldInstance.GetValidationMessage(), "The wrong validation message was reported!")
+	TextField.updateKeyboardEventTextboxWithString("-159")
+	assert.Equalf(test, "2024-01-15", textFieldInstance.GetValue(), "Typing a literal or typing past the end of the mask was not handled!")
+	assert.Truef(test, textFieldInstance.IsValid(), "A fully filled masked text field was not valid!")
+
+	TextField.updateKeyboardEventTextboxWithCommands("home")
+	TextField.updateKeyboardEventTextboxWithString("1999")
+	assert.Equalf(test, "1999-01-15", textFieldInstance.GetValue(), "Typing in the middle did not overwrite the existing characters!")
+	textFieldInstance.SetValue("19990115")
+	assert.Falsef(test, textFieldInstance.IsValid(), "A value set programmatically was not validated against the mask!")
+}
+
+/*
+TestTextFieldInputMaskDelete is a test which verifies that deleting characters from a masked text field clears them in
+place, without moving the characters after them across the literals of the mask.
+
+Example:
+
+	Expected Inputs:
+	    A text field with a filled in date mask, edited with backspace and delete in the middle, over a literal, and at
+	    the end of its value.
+
+	Expected Outputs:
+	    Characters deleted in the middle leave an empty position which typing fills in again, literals are skipped,
+	    and deleting at the end shortens the value.
+*/
+func TestTextFieldInputMaskDelete(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	textFieldInstance.SetInputMask(constants.InputMaskDate)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	TextField.updateKeyboardEventTextboxWithString("20240115")
+	assert.Equalf(test, "2024-01-15", textFieldInstance.GetValue(), "The masked value was not typed in!")
+
+	TextField.updateKeyboardEventTextboxWithCommands("home", "right", "right", "right", "right", "backspace")
+	assert.Equalf(test, "202 -01-15", textFieldInstance.GetValue(), "Backspace did not clear the character in place!")
+	assert.Falsef(test, textFieldInstance.IsValid(), "A masked text field with a cleared position was valid!")
+	TextField.updateKeyboardEventTextboxWithString("5")
+	assert.Equalf(test, "2025-01-15", textFieldInstance.GetValue(), "Typing did not fill in the cleared position!")
+	TextField.updateKeyboardEventTextboxWithCommands("delete")
+	assert.Equalf(test, "2025- 1-15", textFieldInstance.GetValue(), "Delete on a literal did not clear the next character in place!")
+	TextField.updateKeyboardEventTextboxWithString("0")
+	assert.Equalf(test, "2025-01-15", textFieldInstance.GetValue(), "Typing did not fill in the position cleared by delete!")
+	TextField.updateKeyboardEventTextboxWithCommands("home", "right", "right", "right", "right", "right", "backspace")
+	assert.Equalf(test, "202 -01-15", textFieldInstance.GetValue(), "Backspace after a literal did not skip over it!")
+	TextField.updateKeyboardEventTextboxWithString("5")
+	assert.Truef(test, textFieldInstance.IsValid(), "The masked value was not valid once filled in again!")
+
+	TextField.updateKeyboardEventTextboxWithCommands("end", "backspace", "backspace", "backspace")
+	assert.Equalf(test, "2025-0", textFieldInstance.GetValue(), "Backspace at the end did not shorten the value!")
+	TextField.updateKeyboardEventTextboxWithString("215")
+	assert.Equalf(test, "2025-02-15", textFieldInstance.GetValue(), "Typing after the value was shortened did not fill in the literals!")
+}
+
+/*
+TestTextFieldCharacterFilter is a test which verifies that a character filter restricts what can be typed or pasted
+into a text field.
+
+Example:
+
+	Expected Inputs:
+	    Text fields with digit, hexadecimal, and alphanumeric filters, typed into with a mix of characters.
+
+	Expected Outputs:
+	    Only characters which pass the filter are inserted, and an invalid filter type panics.
+*/
+func TestTextFieldCharacterFilter(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	textFieldInstance.SetCharacterFilter(constants.CharacterFilterDigits)
+	TextField.updateKeyboardEventTextboxWithString("1a2-3 ")
+	assert.Equalf(test, "123", textFieldInstance.GetValue(), "The digit filter accepted other characters!")
+	textFieldInstance.SetValue("")
+	textFieldInstance.SetCharacterFilter(constants.CharacterFilterHex)
+	TextField.updateKeyboardEventTextboxWithString("0xBeEfG")
+	assert.Equalf(test, "0BeEf", textFieldInstance.GetValue(), "The hexadecimal filter accepted other characters!")
+	textFieldInstance.SetValue("")
+	textFieldInstance.SetCharacterFilter(constants.CharacterFilterAlphanumeric)
+	TextField.updateKeyboardEventTextboxWithString("ab 12!")
+	assert.Equalf(test, "ab12", textFieldInstance.GetValue(), "The alphanumeric filter accepted other characters!")
+	textFieldInstance.SetValue("ab 12")
+	assert.Equalf(test, "The character ' ' is not allowed.", textFieldInstance.GetValidationMessage(), "A value set programmatically was not checked against the filter!")
+	textFieldEntry := TextFields.Get(layer1.layerAlias, textFieldInstance.controlAlias)
+	assert.Equalf(test, "1234", TextField.getFilteredText(textFieldEntry, "12-34"), "Text to be pasted was not filtered!")
+	assert.Panicsf(test, func() { textFieldInstance.SetCharacterFilter(99) }, "An invalid character filter did not panic!")
+}
+
+/*
+TestTextFieldValidator is a test which verifies that a validator is called as the user types, and that an invalid
+text field is drawn in its error colors.
+
+Example:
+
+	Expected Inputs:
+	    A text field with a validator which requires at least three characters, typed into one character at a
+	    time, and then undone.
+
+	Expected Outputs:
+	    The text field reports the message of the validator and is drawn in error colors until enough characters are
+	    typed, and returns to its error state when the edit is undone.
+*/
+func TestTextFieldValidator(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textFieldInstance := layer1.AddTextField(styleEntry, 2, 2, 20, 30, false, "", true)
+	setFocusedControl(layer1.layerAlias, textFieldInstance.controlAlias, constants.CellTypeTextField)
+	textFieldInstance.SetValidator(func(value string) error {
+		if len(strings.TrimSpace(value)) < 3 {
+			return errors.New("At least three characters are required.")
+		}
+		return nil
+	})
+	assert.Falsef(test, textFieldInstance.IsValid(), "The validator was not run when it was assigned!")
+	TextField.updateKeyboardEventTextboxWithString("ab")
+	assert.Equalf(test, "At least three characters are required.", textFieldInstance.GetValidationMessage(), "The message of the validator was not reported!")
+	layerEntry := Layers.Get(layer1.layerAlias)
+	TextField.drawOnLayer(*layerEntry)
+	assert.Equalf(test, styleEntry.TextField.ErrorBackgroundColor, layerEntry.CharacterMemory[2][2].AttributeEntry.BackgroundColor, "An invalid text field was not drawn in its error colors!")
+
+	TextField.updateKeyboardEventTextboxWithString("c")
+	assert.Truef(test, textFieldInstance.IsValid(), "The text field was not valid after enough characters were typed!")
+	TextField.drawOnLayer(*layerEntry)
+	assert.Equalf(test, styleEntry.TextField.BackgroundColor, layerEntry.CharacterMemory[2][2].AttributeEntry.BackgroundColor, "A valid text field was drawn in its error colors!")
+	textFieldInstance.Undo()
+	assert.Falsef(test, textFieldInstance.IsValid(), "The text field was not validated again after an undo!")
+	textFieldInstance.SetValidator(nil)
+	assert.Truef(test, textFieldInstance.IsValid(), "The text field was still invalid after its validator was removed!")
+}
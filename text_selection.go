@@ -0,0 +1,203 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"unicode"
+)
+
+/*
+textSelectionMemoryType is a structure which holds the state of a selection being made with the mouse, so that it can
+be extended as the mouse is dragged. The anchor is the range of text selected by the click which began the selection,
+which is a single character, a word, or a line depending on how many times the mouse was clicked.
+*/
+type textSelectionMemoryType struct {
+	layerAlias    string
+	controlAlias  string
+	selectionUnit int
+	anchorStartX  int
+	anchorStartY  int
+	anchorEndX    int
+	anchorEndY    int
+}
+
+/*
+textSelectionMemory is a variable which holds the selection currently being made with the mouse.
+*/
+var textSelectionMemory textSelectionMemoryType
+
+/*
+startMouseSelection is a method which allows you to begin a selection with the mouse at the location clicked. In
+addition, the following should be noted:
+
+- A single click selects nothing, a double click selects the word clicked, and a triple click selects the line clicked.
+
+  - Dragging the mouse afterwards extends the selection by the same unit, so that a selection started with a double
+    click always covers whole words.
+
+Example:
+
+	startMouseSelection("layer1", "textbox1", textData, 4, 2, GetMouseClickCount())
+*/
+func startMouseSelection(layerAlias string, controlAlias string, textData [][]rune, xLocation int, yLocation int, clickCount int) {
+	selectionUnit := constants.TextSelectionUnitCharacter
+	if clickCount == 2 {
+		selectionUnit = constants.TextSelectionUnitWord
+	} else if clickCount == 3 {
+		selectionUnit = constants.TextSelectionUnitLine
+	}
+	startX, startY, endX, endY := getTextSelectionUnitRange(textData, xLocation, yLocation, selectionUnit)
+	textSelectionMemory = textSelectionMemoryType{
+		layerAlias:    layerAlias,
+		controlAlias:  controlAlias,
+		selectionUnit: selectionUnit,
+		anchorStartX:  startX,
+		anchorStartY:  startY,
+		anchorEndX:    endX,
+		anchorEndY:    endY,
+	}
+}
+
+/*
+extendMouseSelection is a method which allows you to continue a selection from the location given, such as when the
+mouse is clicked while shift is held down. The selection is extended one character at a time.
+
+Example:
+
+	extendMouseSelection("layer1", "textbox1", 0, 0)
+*/
+func extendMouseSelection(layerAlias string, controlAlias string, anchorXLocation int, anchorYLocation int) {
+	textSelectionMemory = textSelectionMemoryType{
+		layerAlias:    layerAlias,
+		controlAlias:  controlAlias,
+		selectionUnit: constants.TextSelectionUnitCharacter,
+		anchorStartX:  anchorXLocation,
+		anchorStartY:  anchorYLocation,
+		anchorEndX:    anchorXLocation,
+		anchorEndY:    anchorYLocation,
+	}
+}
+
+/*
+isMouseSelectionInProgress is a method which allows you to detect if the selection being made with the mouse belongs
+to the control specified.
+
+Example:
+
+	isMouseSelectionInProgress("layer1", "textbox1")
+*/
+func isMouseSelectionInProgress(layerAlias string, controlAlias string) bool {
+	return textSelectionMemory.layerAlias == layerAlias && textSelectionMemory.controlAlias == controlAlias
+}
+
+/*
+getMouseSelection is a method which allows you to obtain the selection being made with the mouse, once it has been
+extended to the location given. In addition, the following should be noted:
+
+  - The anchor is the fixed end of the selection, while the cursor is the end which follows the mouse. Both ends are
+    included in the selection.
+
+- If only a single character was clicked without dragging, the selection is reported as inactive.
+
+Example:
+
+	anchorX, anchorY, cursorX, cursorY, isActive := getMouseSelection(textData, 10, 2)
+*/
+func getMouseSelection(textData [][]rune, xLocation int, yLocation int) (int, int, int, int, bool) {
+	startX, startY, endX, endY := getTextSelectionUnitRange(textData, xLocation, yLocation, textSelectionMemory.selectionUnit)
+	if isTextLocationBefore(startX, startY, textSelectionMemory.anchorStartX, textSelectionMemory.anchorStartY) {
+		return textSelectionMemory.anchorEndX, textSelectionMemory.anchorEndY, startX, startY, true
+	}
+	isActive := textSelectionMemory.selectionUnit != constants.TextSelectionUnitCharacter ||
+		endX != textSelectionMemory.anchorStartX || endY != textSelectionMemory.anchorStartY
+	return textSelectionMemory.anchorStartX, textSelectionMemory.anchorStartY, endX, endY, isActive
+}
+
+/*
+getTextSelectionUnitRange is a method which allows you to obtain the range of text covered by a selection unit at the
+location given. In addition, the following should be noted:
+
+  - Words are made up of word characters, runs of whitespace are selected together, and any other character is
+    selected on its own.
+
+- Lines are selected from their first character to their last.
+
+Example:
+
+	startX, startY, endX, endY := getTextSelectionUnitRange(textData, 4, 2, constants.TextSelectionUnitWord)
+*/
+func getTextSelectionUnitRange(textData [][]rune, xLocation int, yLocation int, selectionUnit int) (int, int, int, int) {
+	if yLocation < 0 || yLocation >= len(textData) || selectionUnit == constants.TextSelectionUnitCharacter {
+		return xLocation, yLocation, xLocation, yLocation
+	}
+	line := textData[yLocation]
+	if selectionUnit == constants.TextSelectionUnitLine || len(line) == 0 {
+		endX := len(line) - 1
+		if endX < 0 {
+			endX = 0
+		}
+		return 0, yLocation, endX, yLocation
+	}
+	if xLocation >= len(line) {
+		xLocation = len(line) - 1
+	}
+	if xLocation < 0 {
+		xLocation = 0
+	}
+	characterClass := getSelectionCharacterClass(line[xLocation])
+	startX := xLocation
+	endX := xLocation
+	if characterClass != 0 {
+		for startX > 0 && getSelectionCharacterClass(line[startX-1]) == characterClass {
+			startX--
+		}
+		for endX < len(line)-1 && getSelectionCharacterClass(line[endX+1]) == characterClass {
+			endX++
+		}
+	}
+	return startX, yLocation, endX, yLocation
+}
+
+/*
+getSelectionCharacterClass is a method which allows you to group characters when selecting a word. Word characters
+return 1, whitespace returns 2, and any other character returns 0, which is never grouped with its neighbours.
+
+Example:
+
+	getSelectionCharacterClass('a')
+*/
+func getSelectionCharacterClass(character rune) int {
+	if isWordCharacter(character) {
+		return 1
+	}
+	if unicode.IsSpace(character) {
+		return 2
+	}
+	return 0
+}
+
+/*
+isTextLocationBefore is a method which allows you to detect if one location in a block of text comes before another.
+
+Example:
+
+	isTextLocationBefore(0, 1, 5, 1)
+*/
+func isTextLocationBefore(xLocation int, yLocation int, otherXLocation int, otherYLocation int) bool {
+	return yLocation < otherYLocation || (yLocation == otherYLocation && xLocation < otherXLocation)
+}
+
+/*
+isTextLocationSelected is a method which allows you to detect if a location in a block of text falls within a
+selection. The anchor and cursor may be given in either order, and both are included in the selection.
+
+Example:
+
+	isTextLocationSelected(3, 1, anchorX, anchorY, cursorX, cursorY)
+*/
+func isTextLocationSelected(xLocation int, yLocation int, anchorXLocation int, anchorYLocation int, cursorXLocation int, cursorYLocation int) bool {
+	startX, startY, endX, endY := anchorXLocation, anchorYLocation, cursorXLocation, cursorYLocation
+	if isTextLocationBefore(endX, endY, startX, startY) {
+		startX, startY, endX, endY = endX, endY, startX, startY
+	}
+	return !isTextLocationBefore(xLocation, yLocation, startX, startY) && !isTextLocationBefore(endX, endY, xLocation, yLocation)
+}
@@ -0,0 +1,143 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+clickMouse is a method which simulates pressing and releasing the left mouse button at the location given, for the
+text selection tests.
+
+Example:
+
+	clickMouse(4, 2, textbox.updateMouseEvent)
+*/
+func clickMouse(xLocation int, yLocation int, updateMouseEvent func() bool) {
+	SetMouseStatus(xLocation, yLocation, 1, "")
+	updateMouseEvent()
+	SetMouseStatus(xLocation, yLocation, 0, "")
+	updateMouseEvent()
+}
+
+/*
+TestTextboxMouseSelection is a test which verifies that text in a textbox can be selected with the mouse.
+
+Example:
+
+	Expected Inputs:
+	    A textbox holding two lines of text, which is clicked, dragged over, double clicked, triple clicked, and clicked
+	    while shift is held down.
+
+	Expected Outputs:
+	    Clicking only moves the cursor, dragging highlights the text passed over, a double click highlights a word, a
+	    triple click highlights a line, a shift click highlights from the cursor, and the highlight can be copied or
+	    cleared with the keyboard.
+*/
+func TestTextboxMouseSelection(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	defer SetClipboardProvider(nil)
+	textboxInstance := layer1.AddTextbox(styleEntry, 0, 0, 20, 5, false)
+	textboxInstance.SetText("hello world\nsecond line")
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textboxInstance.controlAlias)
+	UpdateDisplay(false)
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(2, 1, 1, "")
+	textbox.updateMouseEvent()
+	textbox.updateMouseEvent()
+	assert.Equalf(test, []int{2, 1}, []int{textboxEntry.CursorXLocation, textboxEntry.CursorYLocation}, "Clicking did not move the cursor!")
+	assert.Falsef(test, textboxEntry.IsHighlightActive, "Clicking without dragging highlighted text!")
+	SetMouseStatus(2, 2, 1, "")
+	textbox.updateMouseEvent()
+	textbox.updateMouseEvent()
+	assert.Equalf(test, "llo world\nsec", textboxInstance.GetSelectedText(), "Dragging did not highlight the text passed over!")
+	SetMouseStatus(2, 2, 0, "")
+	textbox.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, styleEntry.Textbox.HighlightBackgroundColor, commonResource.screenLayer.CharacterMemory[1][5].AttributeEntry.BackgroundColor, "The text selected was not drawn highlighted!")
+
+	clickMouse(8, 1, textbox.updateMouseEvent)
+	clickMouse(8, 1, textbox.updateMouseEvent)
+	assert.Equalf(test, 2, GetMouseClickCount(), "The second click was not counted as a double click!")
+	assert.Equalf(test, "world", textboxInstance.GetSelectedText(), "Double clicking did not highlight the word clicked!")
+	SetMouseStatus(8, 1, 1, "")
+	textbox.updateMouseEvent()
+	assert.Equalf(test, "hello world", textboxInstance.GetSelectedText(), "Triple clicking did not highlight the line clicked!")
+	SetMouseStatus(8, 2, 1, "")
+	textbox.updateMouseEvent()
+	assert.Equalf(test, "hello world\nsecond line", textboxInstance.GetSelectedText(), "Dragging after a triple click did not highlight whole lines!")
+	SetMouseStatus(8, 2, 0, "")
+
+	clickMouse(0, 1, textbox.updateMouseEvent)
+	assert.Equalf(test, 1, GetMouseClickCount(), "A click elsewhere was counted as part of a double click!")
+	eventStateMemory.modifierKeys = tcell.ModShift
+	clickMouse(4, 1, textbox.updateMouseEvent)
+	eventStateMemory.modifierKeys = tcell.ModNone
+	assert.Equalf(test, "hello", textboxInstance.GetSelectedText(), "Clicking while holding shift did not highlight from the cursor!")
+	memoryProvider := NewMemoryClipboardProvider()
+	SetClipboardProvider(memoryProvider)
+	textboxInstance.Copy()
+	clipboardText, _ := memoryProvider.ReadText()
+	assert.Equalf(test, "hello", clipboardText, "The text selected with the mouse was not copied!")
+	textbox.UpdateKeyboardEventManually(layer1.layerAlias, textboxInstance.controlAlias, []rune("right"))
+	assert.Falsef(test, textboxEntry.IsHighlightActive, "Moving the cursor with the keyboard did not clear the highlight!")
+}
+
+/*
+TestViewportMouseSelection is a test which verifies that text in a read-only viewport can be selected with the mouse
+and copied.
+
+Example:
+
+	Expected Inputs:
+	    A viewport holding a line with markup and a plain line, which is dragged over, double clicked, triple clicked,
+	    clicked past the end of a line, and clicked while shift is held down, before ctrl+c is pressed.
+
+	Expected Outputs:
+	    The text passed over, the word clicked, or the line clicked is selected without its markup codes, clicking past
+	    the end of a line selects up to its end, the selection is drawn highlighted, and ctrl+c copies it.
+*/
+func TestViewportMouseSelection(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	defer SetClipboardProvider(nil)
+	viewportInstance := layer1.AddViewport(styleEntry, 0, 5, 30, 4, false, false, 100)
+	viewportInstance.Println("{{red}}error{{/}}: disk full")
+	viewportInstance.Println("second entry")
+	UpdateDisplay(false)
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(2, 5, 1, "")
+	viewport.updateMouseEvent()
+	assert.Equalf(test, "", viewportInstance.GetSelectedText(), "Clicking without dragging selected text!")
+	assert.Truef(test, isControlCurrentlyFocused(layer1.layerAlias, viewportInstance.controlAlias, constants.CellTypeTextbox), "Clicking did not focus the viewport!")
+	SetMouseStatus(2, 6, 1, "")
+	viewport.updateMouseEvent()
+	assert.Equalf(test, "ror: disk full\nsec", viewportInstance.GetSelectedText(), "Dragging did not select the text passed over without its markup!")
+	SetMouseStatus(2, 6, 0, "")
+	viewport.updateMouseEvent()
+	UpdateDisplay(false)
+	assert.Equalf(test, styleEntry.Textbox.HighlightBackgroundColor, commonResource.screenLayer.CharacterMemory[6][0].AttributeEntry.BackgroundColor, "The text selected was not drawn highlighted!")
+
+	clickMouse(8, 5, viewport.updateMouseEvent)
+	clickMouse(8, 5, viewport.updateMouseEvent)
+	assert.Equalf(test, "disk", viewportInstance.GetSelectedText(), "Double clicking did not select the word clicked!")
+	clickMouse(8, 5, viewport.updateMouseEvent)
+	assert.Equalf(test, "error: disk full", viewportInstance.GetSelectedText(), "Triple clicking did not select the line clicked!")
+
+	clickMouse(0, 6, viewport.updateMouseEvent)
+	eventStateMemory.modifierKeys = tcell.ModShift
+	clickMouse(25, 6, viewport.updateMouseEvent)
+	eventStateMemory.modifierKeys = tcell.ModNone
+	assert.Equalf(test, "second entry", viewportInstance.GetSelectedText(), "Clicking past the end of a line while holding shift did not select up to its end!")
+	memoryProvider := NewMemoryClipboardProvider()
+	SetClipboardProvider(memoryProvider)
+	isUpdated, isConsumed := viewport.updateKeyboardEvent([]rune("ctrl+c"))
+	assert.Truef(test, isUpdated && isConsumed, "Ctrl+c was not used by the focused viewport!")
+	clipboardText, _ := memoryProvider.ReadText()
+	assert.Equalf(test, "second entry", clipboardText, "Ctrl+c did not copy the text selected!")
+	viewportInstance.Clear()
+	assert.Equalf(test, "", viewportInstance.GetSelectedText(), "Clearing the viewport did not clear its selection!")
+}
@@ -2,7 +2,6 @@ package consolizer
 
 import (
 	"fmt"
-	"github.com/atotto/clipboard"
 	"github.com/supercom32/consolizer/memory"
 	"github.com/supercom32/consolizer/stringformat"
 	"math"
@@ -225,6 +224,129 @@ func (shared *TextboxInstanceType) SetAutoIndent(enabled bool) *TextboxInstanceT
 	return shared
 }
 
+/*
+Copy is a method which allows you to copy the text highlighted in a textbox to the clipboard, just as if the user had
+pressed ctrl+c. If no text is highlighted, or the textbox instance no longer exists, then no operation takes place.
+
+Example:
+
+	textbox.Copy()
+*/
+func (shared *TextboxInstanceType) Copy() *TextboxInstanceType {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textbox.UpdateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+c"))
+	}
+	return shared
+}
+
+/*
+Cut is a method which allows you to move the text highlighted in a textbox to the clipboard, just as if the user had
+pressed ctrl+x. If no text is highlighted, or the textbox instance no longer exists, then no operation takes place.
+
+Example:
+
+	textbox.Cut()
+*/
+func (shared *TextboxInstanceType) Cut() *TextboxInstanceType {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textbox.UpdateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+x"))
+	}
+	return shared
+}
+
+/*
+Paste is a method which allows you to insert the text held by the clipboard at the cursor of a textbox, replacing any
+text highlighted, just as if the user had pressed ctrl+v. The paste is undone all at once. If the textbox instance no
+longer exists, then no operation takes place.
+
+Example:
+
+	textbox.Paste()
+*/
+func (shared *TextboxInstanceType) Paste() *TextboxInstanceType {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textbox.UpdateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+v"))
+	}
+	return shared
+}
+
+/*
+GetSelectedText is a method which allows you to obtain the text currently highlighted in a textbox, whether it was
+selected with the keyboard or the mouse. If no text is highlighted, or the textbox instance no longer exists, then an
+empty string is returned.
+
+Example:
+
+	selectedText := textbox.GetSelectedText()
+*/
+func (shared *TextboxInstanceType) GetSelectedText() string {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return ""
+	}
+	return textbox.getHighlightedText(Textboxes.Get(shared.layerAlias, shared.controlAlias))
+}
+
+/*
+Undo is a method which allows you to revert the last edit made to a textbox, just as if the user had pressed ctrl+z.
+If the textbox instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- Typing is undone a word at a time, while a paste or the deletion of highlighted text is undone all at once.
+
+- If there is nothing to undo, false is returned.
+
+Example:
+
+	isUndone := textbox.Undo()
+*/
+func (shared *TextboxInstanceType) Undo() bool {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return false
+	}
+	textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+	isUndone := textbox.undo(textboxEntry)
+	textbox.updateAfterTextChange(shared.layerAlias, shared.controlAlias, textboxEntry)
+	return isUndone
+}
+
+/*
+Redo is a method which allows you to reapply the last edit undone in a textbox, just as if the user had pressed
+ctrl+y. If the textbox instance no longer exists, then no operation takes place. In addition, the following should
+be noted:
+
+- Making a new edit after undoing discards anything which could have been redone.
+
+- If there is nothing to redo, false is returned.
+
+Example:
+
+	isRedone := textbox.Redo()
+*/
+func (shared *TextboxInstanceType) Redo() bool {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return false
+	}
+	textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+	isRedone := textbox.redo(textboxEntry)
+	textbox.updateAfterTextChange(shared.layerAlias, shared.controlAlias, textboxEntry)
+	return isRedone
+}
+
+/*
+ClearUndoHistory is a method which allows you to discard all undo and redo history for a textbox, for example after
+a document has been saved or loaded. If the textbox instance no longer exists, then no operation takes place.
+
+Example:
+
+	textbox.ClearUndoHistory()
+*/
+func (shared *TextboxInstanceType) ClearUndoHistory() *TextboxInstanceType {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+		textboxEntry.EditHistory.Clear()
+	}
+	return shared
+}
+
 /*
 getTextboxClickCoordinates is a method which allows you to convert a cell ID to x and y coordinates within a textbox. In addition, the following should be noted:
 
@@ -263,6 +385,7 @@ func (shared *textboxType) insertCharacterUsingAbsoluteCoordinates(textboxEntry
 	textboxEntry.TextData[yLocation] = append(textboxEntry.TextData[yLocation][:xLocation], characterToInsert)
 	textboxEntry.TextData[yLocation] = append(textboxEntry.TextData[yLocation], stringDataSuffixAfterInsert...)
 	textboxEntry.CursorXLocation++
+	textboxEntry.IsTextChanged = true
 }
 
 /*
@@ -328,6 +451,7 @@ func (shared *textboxType) deleteCharacterUsingAbsoluteCoordinates(textboxEntry
 		textboxEntry.TextData[yLocation] = append(textboxEntry.TextData[yLocation], textboxEntry.TextData[yLocation+1]...)
 		copy(textboxEntry.TextData[yLocation+1:], textboxEntry.TextData[yLocation+2:])
 		textboxEntry.TextData = textboxEntry.TextData[:len(textboxEntry.TextData)-1]
+		textboxEntry.IsTextChanged = true
 		return
 	}
 
@@ -337,6 +461,7 @@ func (shared *textboxType) deleteCharacterUsingAbsoluteCoordinates(textboxEntry
 	stringDataSuffixAfterInsert := textboxEntry.TextData[yLocation][xLocation+1 : len(textboxEntry.TextData[yLocation])]
 	textboxEntry.TextData[yLocation] = append([]rune{}, textboxEntry.TextData[yLocation][:xLocation]...)
 	textboxEntry.TextData[yLocation] = append(textboxEntry.TextData[yLocation], stringDataSuffixAfterInsert...)
+	textboxEntry.IsTextChanged = true
 }
 
 /*
@@ -360,6 +485,7 @@ func (shared *textboxType) moveRemainingTextToPreviousLine(textboxEntry *types.T
 	textboxEntry.TextData[yLocation-1] = textboxEntry.TextData[yLocation-1][:len(textboxEntry.TextData[yLocation-1])-1]
 	textboxEntry.TextData[yLocation-1] = append(textboxEntry.TextData[yLocation-1], textboxEntry.TextData[yLocation]...)
 	textboxEntry.TextData = shared.removeLine(textboxEntry.TextData, yLocation)
+	textboxEntry.IsTextChanged = true
 }
 
 /*
@@ -424,6 +550,7 @@ func (shared *textboxType) moveTextAfterCursorToNextLine(textboxEntry *types.Tex
 
 	// Create a new line with our default ' ' rune.
 	textboxEntry.TextData = shared.insertLine(textboxEntry.TextData, []rune{' '}, yLocation+1)
+	textboxEntry.IsTextChanged = true
 
 	// Copy everything past our cursor on the current line.
 	charactersToCopy := textboxEntry.TextData[textboxEntry.CursorYLocation][textboxEntry.CursorXLocation:]
@@ -579,7 +706,7 @@ func (shared *textboxType) setTextboxMaxScrollBarValues(layerAlias string, textb
 	hScrollBarEntry := ScrollBars.Get(layerAlias, textboxEntry.HorizontalScrollbarAlias)
 	vScrollBarEntry := ScrollBars.Get(layerAlias, textboxEntry.VerticalScrollbarAlias)
 
-	maxHorizontalValue = maxHorizontalValue - textboxEntry.Width
+	maxHorizontalValue = maxHorizontalValue - shared.getTextAreaWidth(textboxEntry)
 	// If the max horizontal width is smaller than the textbox width, disable scrolling.
 	if maxHorizontalValue <= 0 {
 		maxHorizontalValue = 0
@@ -605,6 +732,49 @@ func (shared *textboxType) setTextboxMaxScrollBarValues(layerAlias string, textb
 	vScrollBarEntry.MaxScrollValue = maxVerticalValue
 }
 
+/*
+updateScrollbarBounds is a method which allows you to place the scroll bars of a textbox along its bottom and right
+edges, based on its current position and size. In addition, the following should be noted:
+
+- If a border is drawn, the scroll bars are placed over the border instead of inside it.
+
+- The limits of the scroll bars are recalculated, since they depend on the size of the textbox.
+
+Example:
+
+	textbox.updateScrollbarBounds("layer1", "textbox1")
+*/
+func (shared *textboxType) updateScrollbarBounds(layerAlias string, textboxAlias string) {
+	textboxEntry := Textboxes.Get(layerAlias, textboxAlias)
+	if textboxEntry == nil {
+		return
+	}
+	if ScrollBars.IsExists(layerAlias, textboxEntry.HorizontalScrollbarAlias) {
+		hScrollbarEntry := ScrollBars.Get(layerAlias, textboxEntry.HorizontalScrollbarAlias)
+		hScrollbarEntry.XLocation = textboxEntry.XLocation
+		hScrollbarEntry.YLocation = textboxEntry.YLocation + textboxEntry.Height
+		hScrollbarEntry.Length = textboxEntry.Width
+		if textboxEntry.IsBorderDrawn {
+			hScrollbarEntry.XLocation--
+			hScrollbarEntry.YLocation++
+			hScrollbarEntry.Length += 2
+		}
+	}
+	if ScrollBars.IsExists(layerAlias, textboxEntry.VerticalScrollbarAlias) {
+		vScrollbarEntry := ScrollBars.Get(layerAlias, textboxEntry.VerticalScrollbarAlias)
+		vScrollbarEntry.XLocation = textboxEntry.XLocation + textboxEntry.Width
+		vScrollbarEntry.YLocation = textboxEntry.YLocation
+		vScrollbarEntry.Length = textboxEntry.Height
+		if textboxEntry.IsBorderDrawn {
+			vScrollbarEntry.XLocation++
+			vScrollbarEntry.YLocation--
+			vScrollbarEntry.Length += 2
+		}
+	}
+	shared.setTextboxMaxScrollBarValues(layerAlias, textboxAlias)
+	shared.updateScrollbarBasedOnTextboxViewport(layerAlias, textboxAlias)
+}
+
 /*
 Add is a method which allows you to add a text box to a text layer. Once called, an instance of your control is
 returned which will allow you to read or manipulate the properties for it. The Style of the text box will be determined
@@ -646,22 +816,9 @@ func (shared *textboxType) Add(layerAlias string, textboxAlias string, styleEntr
 	textboxEntry.TextData = append(textboxEntry.TextData, stringformat.GetRunesFromString(" "))
 	textboxEntry.HorizontalScrollbarAlias = stringformat.GetLastSortedUUID()
 	textboxEntry.VerticalScrollbarAlias = stringformat.GetLastSortedUUID()
-	hScrollbarWidth := width
-	hScrollbarXLocation := xLocation
-	hScrollbarYLocation := yLocation + height
-	vScrollbarHeight := height
-	vScrollbarXLocation := xLocation + width
-	vScrollbarYLocation := yLocation
-	if isBorderDrawn == true {
-		hScrollbarYLocation++
-		hScrollbarXLocation--
-		vScrollbarXLocation++
-		vScrollbarYLocation--
-		hScrollbarWidth = hScrollbarWidth + 2
-		vScrollbarHeight = vScrollbarHeight + 2
-	}
-	scrollbar.Add(layerAlias, textboxEntry.HorizontalScrollbarAlias, styleEntry, hScrollbarXLocation, hScrollbarYLocation, hScrollbarWidth, 0, 0, 1, true)
-	scrollbar.Add(layerAlias, textboxEntry.VerticalScrollbarAlias, styleEntry, vScrollbarXLocation, vScrollbarYLocation, vScrollbarHeight, 0, 0, 1, false)
+	// The scroll bars are placed around the textbox once their parent information is set below.
+	scrollbar.Add(layerAlias, textboxEntry.HorizontalScrollbarAlias, styleEntry, 0, 0, 0, 0, 0, 1, true)
+	scrollbar.Add(layerAlias, textboxEntry.VerticalScrollbarAlias, styleEntry, 0, 0, 0, 0, 0, 1, false)
 
 	// Set parent control information for scrollbars
 	hScrollbarEntry := ScrollBars.Get(layerAlias, textboxEntry.HorizontalScrollbarAlias)
@@ -676,7 +833,7 @@ func (shared *textboxType) Add(layerAlias string, textboxAlias string, styleEntr
 		vScrollbarEntry.ParentControlType = constants.CellTypeTextbox
 	}
 
-	shared.setTextboxMaxScrollBarValues(layerAlias, textboxAlias)
+	shared.updateScrollbarBounds(layerAlias, textboxAlias)
 	var textboxInstance TextboxInstanceType
 	textboxInstance.layerAlias = layerAlias
 	textboxInstance.controlAlias = textboxAlias
@@ -695,6 +852,7 @@ Example:
 */
 func (shared *textboxType) Delete(layerAlias string, textboxAlias string) {
 	Textboxes.Remove(layerAlias, textboxAlias)
+	deleteTextboxSyntax(layerAlias, textboxAlias)
 }
 
 /*
@@ -712,6 +870,7 @@ Example:
 */
 func (shared *textboxType) DeleteAll(layerAlias string) {
 	Textboxes.RemoveAll(layerAlias)
+	textboxSyntaxMemory.RemoveAll(layerAlias)
 }
 
 /*
@@ -751,57 +910,104 @@ func (shared *textboxType) wrapTextToWidth(text [][]rune, width int) [][]rune {
 	result := make([][]rune, 0)
 
 	for _, line := range text {
-		if len(line) <= width {
-			// Line fits, no wrapping needed
-			result = append(result, line)
-			continue
-		}
-
-		// Line needs wrapping
-		currentPos := 0
-		for currentPos < len(line) {
-			// Determine end position for this segment
-			endPos := currentPos + width
-			if endPos > len(line) {
-				endPos = len(line)
-			} else {
-				// Try to break at word boundary
-				for endPos > currentPos && !unicode.IsSpace(line[endPos-1]) {
-					// Look for a space to break at
-					foundSpace := false
-					for i := endPos - 1; i > currentPos && i > endPos-width/4; i-- {
-						if unicode.IsSpace(line[i]) {
-							endPos = i + 1 // Break after the space
-							foundSpace = true
-							break
-						}
-					}
-					if foundSpace {
-						break
-					} else {
-						// If no good break point, just use the full width
-						endPos = currentPos + width
-						if endPos > len(line) {
-							endPos = len(line)
-						}
+		for _, lineSegment := range shared.getWrappedLineSegments(line, width) {
+			// Add this segment as a new line
+			segment := make([]rune, lineSegment[1]-lineSegment[0])
+			copy(segment, line[lineSegment[0]:lineSegment[1]])
+			result = append(result, segment)
+		}
+	}
+
+	return result
+}
+
+/*
+getWrappedLineSegments is a method which allows you to obtain where a single line of text would be split when
+wrapped to fit within a specified width. In addition, the following should be noted:
+
+- Each segment is returned as a start and end index into the line provided.
+
+- A line which already fits is returned as a single segment.
+
+Example:
+
+	lineSegments := textbox.getWrappedLineSegments(line, 20)
+*/
+func (shared *textboxType) getWrappedLineSegments(line []rune, width int) [][2]int {
+	if len(line) <= width {
+		// Line fits, no wrapping needed
+		return [][2]int{{0, len(line)}}
+	}
+
+	// Line needs wrapping
+	var lineSegments [][2]int
+	currentPos := 0
+	for currentPos < len(line) {
+		// Determine end position for this segment
+		endPos := currentPos + width
+		if endPos > len(line) {
+			endPos = len(line)
+		} else {
+			// Try to break at word boundary
+			for endPos > currentPos && !unicode.IsSpace(line[endPos-1]) {
+				// Look for a space to break at
+				foundSpace := false
+				for i := endPos - 1; i > currentPos && i > endPos-width/4; i-- {
+					if unicode.IsSpace(line[i]) {
+						endPos = i + 1 // Break after the space
+						foundSpace = true
 						break
 					}
 				}
+				if foundSpace {
+					break
+				} else {
+					// If no good break point, just use the full width
+					endPos = currentPos + width
+					if endPos > len(line) {
+						endPos = len(line)
+					}
+					break
+				}
 			}
+		}
+		lineSegments = append(lineSegments, [2]int{currentPos, endPos})
 
-			// Add this segment as a new line
-			segment := make([]rune, endPos-currentPos)
-			copy(segment, line[currentPos:endPos])
-			result = append(result, segment)
+		// Move to next segment, skipping spaces at the beginning
+		currentPos = endPos
+		for currentPos < len(line) && unicode.IsSpace(line[currentPos]) {
+			currentPos++
+		}
+	}
+	return lineSegments
+}
+
+/*
+wrapSyntaxAttributesToWidth is a method which allows you to split the syntax attributes of each line in the same
+way the text itself is split when wrapped, so that each wrapped line keeps the attributes of its characters.
+
+Example:
 
-			// Move to next segment, skipping spaces at the beginning
-			currentPos = endPos
-			for currentPos < len(line) && unicode.IsSpace(line[currentPos]) {
-				currentPos++
+	wrappedAttributes := textbox.wrapSyntaxAttributesToWidth(text, syntaxAttributes, 20)
+*/
+func (shared *textboxType) wrapSyntaxAttributesToWidth(text [][]rune, syntaxAttributes [][]*types.AttributeEntryType, width int) [][]*types.AttributeEntryType {
+	if width <= 0 {
+		return syntaxAttributes
+	}
+	result := make([][]*types.AttributeEntryType, 0)
+	for lineIndex, line := range text {
+		var lineAttributes []*types.AttributeEntryType
+		if lineIndex < len(syntaxAttributes) {
+			lineAttributes = syntaxAttributes[lineIndex]
+		}
+		for _, lineSegment := range shared.getWrappedLineSegments(line, width) {
+			if lineSegment[1] <= len(lineAttributes) {
+				result = append(result, lineAttributes[lineSegment[0]:lineSegment[1]])
+			} else {
+				result = append(result, nil)
 			}
 		}
 	}
-
 	return result
 }
 
@@ -833,26 +1039,60 @@ func (shared *textboxType) draw(layerEntry *types.LayerEntryType, textboxAlias s
 	fillArea(layerEntry, attributeEntry, " ", textboxEntry.XLocation, textboxEntry.YLocation, textboxEntry.Width, textboxEntry.Height, textboxEntry.ViewportYLocation)
 	attributeEntry.CellControlAlias = textboxAlias
 
+	// Make room for the gutter, if one is shown.
+	gutterWidth := shared.getGutterWidth(textboxEntry)
+	textAreaWidth := textboxEntry.Width - gutterWidth
+	textAreaXLocation := textboxEntry.XLocation + gutterWidth
+
 	// Apply word wrapping if enabled
 	var displayText [][]rune
+	var syntaxAttributes [][]*types.AttributeEntryType
+	var displayLineIndexes []int
 	if textboxEntry.IsWordWrapEnabled {
-		displayText = shared.wrapTextToWidth(textboxEntry.TextData, textboxEntry.Width)
+		displayText = shared.wrapTextToWidth(textboxEntry.TextData, textAreaWidth)
+		// Wrapped lines can't be mapped back to the text without wrapping everything, so every line is tokenized.
+		syntaxAttributes = getTextboxSyntaxAttributes(layerEntry.LayerAlias, textboxAlias, textboxEntry, len(textboxEntry.TextData)-1)
+		if syntaxAttributes != nil {
+			syntaxAttributes = shared.wrapSyntaxAttributesToWidth(textboxEntry.TextData, syntaxAttributes, textAreaWidth)
+		}
+		if gutterWidth > 0 {
+			displayLineIndexes = shared.getWrappedLineIndexes(textboxEntry.TextData, textAreaWidth)
+		}
 	} else {
 		displayText = textboxEntry.TextData
+		syntaxAttributes = getTextboxSyntaxAttributes(layerEntry.LayerAlias, textboxAlias, textboxEntry, textboxEntry.ViewportYLocation+textboxEntry.Height-1)
 	}
 
 	for currentLine := 0; currentLine < textboxEntry.Height; currentLine++ {
 		var arrayOfRunes []rune
+		var lineAttributes []*types.AttributeEntryType
+		if gutterWidth > 0 {
+			lineIndex := -1
+			if textboxEntry.IsWordWrapEnabled && textboxEntry.ViewportYLocation+currentLine < len(displayLineIndexes) && textboxEntry.ViewportYLocation+currentLine >= 0 {
+				lineIndex = displayLineIndexes[textboxEntry.ViewportYLocation+currentLine]
+			} else if !textboxEntry.IsWordWrapEnabled && textboxEntry.ViewportYLocation+currentLine < len(displayText) && textboxEntry.ViewportYLocation+currentLine >= 0 {
+				lineIndex = textboxEntry.ViewportYLocation + currentLine
+			}
+			shared.drawGutter(layerEntry, textboxAlias, textboxEntry, attributeEntry, textboxEntry.YLocation+currentLine, textboxEntry.ViewportYLocation+currentLine, lineIndex)
+		}
 		if textboxEntry.ViewportYLocation+currentLine < len(displayText) && textboxEntry.ViewportYLocation+currentLine >= 0 {
 			arrayOfRunes = displayText[textboxEntry.ViewportYLocation+currentLine]
+			if textboxEntry.ViewportYLocation+currentLine < len(syntaxAttributes) {
+				lineAttributes = syntaxAttributes[textboxEntry.ViewportYLocation+currentLine]
+			}
 			if !textboxEntry.IsWordWrapEnabled {
 				// Only apply horizontal scrolling if word wrap is disabled
 				if textboxEntry.ViewportXLocation < len(arrayOfRunes) && textboxEntry.ViewportXLocation >= 0 {
-					if textboxEntry.ViewportXLocation+textboxEntry.Width < len(arrayOfRunes) {
-						arrayOfRunes = arrayOfRunes[textboxEntry.ViewportXLocation : textboxEntry.ViewportXLocation+textboxEntry.Width]
+					if textboxEntry.ViewportXLocation+textAreaWidth < len(arrayOfRunes) {
+						arrayOfRunes = arrayOfRunes[textboxEntry.ViewportXLocation : textboxEntry.ViewportXLocation+textAreaWidth]
 					} else {
 						arrayOfRunes = arrayOfRunes[textboxEntry.ViewportXLocation:]
 					}
+					if textboxEntry.ViewportXLocation < len(lineAttributes) {
+						lineAttributes = lineAttributes[textboxEntry.ViewportXLocation:]
+					} else {
+						lineAttributes = nil
+					}
 				} else {
 					// If scrolled too far right and there are no column text to print, just show blanks.
 					// If scrolled too far left (negative value) then show blanks. Note: This case should never happen really.
@@ -860,12 +1100,12 @@ func (shared *textboxType) draw(layerEntry *types.LayerEntryType, textboxAlias s
 				}
 			}
 
-			arrayOfRunes = stringformat.GetMaxCharactersThatFitInStringSize(arrayOfRunes, textboxEntry.Width)
-			shared.printControlText(layerEntry, textboxAlias, textboxEntry.StyleEntry, attributeEntry, textboxEntry.XLocation, textboxEntry.YLocation+currentLine, arrayOfRunes, textboxEntry.ViewportYLocation+currentLine, textboxEntry.ViewportXLocation, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation)
+			arrayOfRunes = stringformat.GetMaxCharactersThatFitInStringSize(arrayOfRunes, textAreaWidth)
+			shared.printControlText(layerEntry, textboxAlias, textboxEntry.StyleEntry, attributeEntry, textAreaXLocation, textboxEntry.YLocation+currentLine, arrayOfRunes, lineAttributes, textboxEntry.ViewportYLocation+currentLine, textboxEntry.ViewportXLocation, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation)
 		} else {
 			// If scrolled too far down and there are no more rows to print, just show blanks.
 			// If scrolled too far up and there are no rows to print, just print blanks. Note: This case should never happen really.
-			shared.printControlText(layerEntry, textboxAlias, textboxEntry.StyleEntry, attributeEntry, textboxEntry.XLocation, textboxEntry.YLocation+currentLine, arrayOfRunes, textboxEntry.ViewportYLocation+currentLine, textboxEntry.ViewportXLocation, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation)
+			shared.printControlText(layerEntry, textboxAlias, textboxEntry.StyleEntry, attributeEntry, textAreaXLocation, textboxEntry.YLocation+currentLine, arrayOfRunes, lineAttributes, textboxEntry.ViewportYLocation+currentLine, textboxEntry.ViewportXLocation, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation)
 		}
 	}
 	scrollbar.drawOnLayerByAlias(layerEntry, textboxEntry.HorizontalScrollbarAlias)
@@ -1034,16 +1274,20 @@ printControlText is a method which allows you to print text with control IDs. In
 
 - Manages cursor and highlight rendering.
 
+  - Applies any syntax attributes provided to characters which are not highlighted or under the cursor. Characters
+    with a nil syntax attribute are drawn normally.
+
 Example:
 
-	textbox.printControlText(layerEntry, "textbox1", style, attribute, 10, 10, runes, 0, 0, 0, 0)
+	textbox.printControlText(layerEntry, "textbox1", style, attribute, 10, 10, runes, nil, 0, 0, 0, 0)
 */
-func (shared *textboxType) printControlText(layerEntry *types.LayerEntryType, textboxAlias string, styleEntry types.TuiStyleEntryType, attributeEntry types.AttributeEntryType, xLocation int, yLocation int, arrayOfRunes []rune, controlYLocation int, startingControlId int, cursorXLocation int, cursorYLocation int) {
+func (shared *textboxType) printControlText(layerEntry *types.LayerEntryType, textboxAlias string, styleEntry types.TuiStyleEntryType, attributeEntry types.AttributeEntryType, xLocation int, yLocation int, arrayOfRunes []rune, syntaxAttributes []*types.AttributeEntryType, controlYLocation int, startingControlId int, cursorXLocation int, cursorYLocation int) {
 	currentControlId := startingControlId
 	currentXOffset := 0
-	for _, currentCharacter := range arrayOfRunes {
+	for characterIndex, currentCharacter := range arrayOfRunes {
 		attributeEntry.CellControlId = currentControlId
 		attributeEntry.CellControlLocation = controlYLocation
+		isSyntaxColorAllowed := true
 		// If the textbox being drawn is focused, render the cursor as well.
 		if isControlCurrentlyFocused(layerEntry.LayerAlias, textboxAlias, constants.CellTypeTextbox) {
 			textboxEntry := Textboxes.Get(layerEntry.LayerAlias, textboxAlias)
@@ -1091,9 +1335,11 @@ func (shared *textboxType) printControlText(layerEntry *types.LayerEntryType, te
 				if isHighlighted {
 					attributeEntry.ForegroundColor = styleEntry.Textbox.HighlightForegroundColor
 					attributeEntry.BackgroundColor = styleEntry.Textbox.HighlightBackgroundColor
+					isSyntaxColorAllowed = false
 				} else if cursorXLocation == currentControlId && cursorYLocation == controlYLocation {
 					attributeEntry.ForegroundColor = styleEntry.Textbox.CursorForegroundColor
 					attributeEntry.BackgroundColor = styleEntry.Textbox.CursorBackgroundColor
+					isSyntaxColorAllowed = false
 				} else {
 					attributeEntry.ForegroundColor = styleEntry.Textbox.ForegroundColor
 					attributeEntry.BackgroundColor = styleEntry.Textbox.BackgroundColor
@@ -1101,17 +1347,22 @@ func (shared *textboxType) printControlText(layerEntry *types.LayerEntryType, te
 			} else if cursorXLocation == currentControlId && cursorYLocation == controlYLocation {
 				attributeEntry.ForegroundColor = styleEntry.Textbox.CursorForegroundColor
 				attributeEntry.BackgroundColor = styleEntry.Textbox.CursorBackgroundColor
+				isSyntaxColorAllowed = false
 			} else {
 				attributeEntry.ForegroundColor = styleEntry.Textbox.ForegroundColor
 				attributeEntry.BackgroundColor = styleEntry.Textbox.BackgroundColor
 			}
 		}
-		layer.printLayer(layerEntry, attributeEntry, xLocation+currentXOffset, yLocation, []rune{currentCharacter})
+		printAttributeEntry := attributeEntry
+		if isSyntaxColorAllowed && characterIndex < len(syntaxAttributes) && syntaxAttributes[characterIndex] != nil {
+			printAttributeEntry = getSyntaxAttributeEntry(attributeEntry, *syntaxAttributes[characterIndex])
+		}
+		layer.printLayer(layerEntry, printAttributeEntry, xLocation+currentXOffset, yLocation, []rune{currentCharacter})
 		if stringformat.IsRuneCharacterWide(currentCharacter) {
 			// If we find a wide character, we add a blank space with the same ID as the previous
 			// character so the next printed character doesn't get covered by the wide one.
 			currentXOffset++
-			layer.printLayer(layerEntry, attributeEntry, xLocation+currentXOffset, yLocation, []rune{' '})
+			layer.printLayer(layerEntry, printAttributeEntry, xLocation+currentXOffset, yLocation, []rune{' '})
 			currentXOffset++
 		} else {
 			currentXOffset++
@@ -1185,6 +1436,7 @@ func (shared *textboxType) updateViewport(textboxEntry *types.TextboxEntryType)
 		textboxEntry.CursorYLocation = 0
 		textboxEntry.CursorXLocation = 0
 	}
+	textAreaWidth := shared.getTextAreaWidth(textboxEntry)
 
 	// Ensure the current line has at least one character
 	if textboxEntry.CursorYLocation < len(textboxEntry.TextData) && len(textboxEntry.TextData[textboxEntry.CursorYLocation]) == 0 {
@@ -1213,7 +1465,7 @@ func (shared *textboxType) updateViewport(textboxEntry *types.TextboxEntryType)
 			isCursorJumped = true
 		}
 		// If the cursor xLocation is less than the size of our viewport and was jumped, just set the viewport to 0.
-		if isCursorJumped && textboxEntry.CursorXLocation-textboxEntry.Width < 0 {
+		if isCursorJumped && textboxEntry.CursorXLocation-textAreaWidth < 0 {
 			textboxEntry.ViewportXLocation = 0
 		} else {
 			// Otherwise, this is a normal backwards scroll so make viewport equal to our cursor location.
@@ -1244,13 +1496,13 @@ func (shared *textboxType) updateViewport(textboxEntry *types.TextboxEntryType)
 
 	// Figure out how much displayable space is in our current viewport window.
 	arrayOfRunesAvailableToPrint := textboxEntry.TextData[textboxEntry.CursorYLocation][textboxEntry.ViewportXLocation:]
-	arrayOfRunesThatFitStringSize := stringformat.GetMaxCharactersThatFitInStringSize(arrayOfRunesAvailableToPrint, textboxEntry.Width)
+	arrayOfRunesThatFitStringSize := stringformat.GetMaxCharactersThatFitInStringSize(arrayOfRunesAvailableToPrint, textAreaWidth)
 
 	// If the cursor xLocation is equal or greater than the visible viewport window width.
 	if textboxEntry.CursorXLocation >= textboxEntry.ViewportXLocation+len(arrayOfRunesThatFitStringSize) {
 		// Then make the viewport xLocation equal to the visible viewport width behind it.
-		maxViewportWidthAvaliable := textboxEntry.Width
-		if textboxEntry.CursorXLocation-textboxEntry.Width < 0 {
+		maxViewportWidthAvaliable := textAreaWidth
+		if textboxEntry.CursorXLocation-textAreaWidth < 0 {
 			maxViewportWidthAvaliable = textboxEntry.CursorXLocation
 		}
 
@@ -1263,7 +1515,7 @@ func (shared *textboxType) updateViewport(textboxEntry *types.TextboxEntryType)
 		if startIndex < len(textboxEntry.TextData[textboxEntry.CursorYLocation]) &&
 			textboxEntry.CursorXLocation <= len(textboxEntry.TextData[textboxEntry.CursorYLocation]) {
 			arrayOfRunesAvailableToPrint = textboxEntry.TextData[textboxEntry.CursorYLocation][startIndex:textboxEntry.CursorXLocation]
-			numberOfRunesThatFitStringSize := stringformat.GetMaxCharactersThatFitInStringSizeReverse(arrayOfRunesAvailableToPrint, textboxEntry.Width)
+			numberOfRunesThatFitStringSize := stringformat.GetMaxCharactersThatFitInStringSizeReverse(arrayOfRunesAvailableToPrint, textAreaWidth)
 			// LogInfo(fmt.Sprintf("v: %d x: %d off: %d fit: %d, aval: %s", textboxEntry.ViewportXLocation, textboxEntry.CursorXLocation, maxViewportWidthAvaliable, numberOfRunesThatFitStringSize, string(arrayOfRunesAvailableToPrint)))
 			textboxEntry.ViewportXLocation = textboxEntry.CursorXLocation - numberOfRunesThatFitStringSize + 1
 
@@ -1275,6 +1527,67 @@ func (shared *textboxType) updateViewport(textboxEntry *types.TextboxEntryType)
 	}
 }
 
+/*
+updateAfterTextChange is a method which allows you to bring the cursor, viewport, and scrollbars of a textbox up
+to date after its text or cursor was changed by something other than a keystroke, such as an undo or a search.
+
+Example:
+
+	textbox.updateAfterTextChange("layer1", "textbox1", entry)
+*/
+func (shared *textboxType) updateAfterTextChange(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType) {
+	shared.updateCursor(textboxEntry, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation)
+	shared.updateViewport(textboxEntry)
+	shared.setTextboxMaxScrollBarValues(layerAlias, textboxAlias)
+	shared.updateScrollbarBasedOnTextboxViewport(layerAlias, textboxAlias)
+}
+
+/*
+insertPastedText is a method which allows you to insert pasted text at the cursor of a textbox, replacing any text
+which is highlighted. Line breaks start new lines, while carriage returns are ignored so that text copied on Windows
+is not double spaced.
+
+Example:
+
+	textbox.insertPastedText(textboxEntry, "Hello\nWorld")
+*/
+func (shared *textboxType) insertPastedText(textboxEntry *types.TextboxEntryType, text string) {
+	if textboxEntry.IsHighlightActive {
+		shared.deleteHighlightedText(textboxEntry)
+		textboxEntry.IsHighlightActive = false
+	}
+	for _, currentCharacter := range text {
+		if currentCharacter == '\n' {
+			shared.moveTextAfterCursorToNextLine(textboxEntry, textboxEntry.CursorYLocation)
+		} else if currentCharacter != '\r' {
+			shared.insertCharacterUsingAbsoluteCoordinates(textboxEntry, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation, currentCharacter)
+		}
+	}
+}
+
+/*
+pasteText is a method which allows you to paste text into a textbox as a single edit, such as text received from a
+bracketed paste. The whole paste is undone at once, just as a paste from the clipboard would be. If the textbox is
+disabled, then no operation takes place.
+
+Example:
+
+	textbox.pasteText("layer1", "textbox1", "Hello\nWorld")
+*/
+func (shared *textboxType) pasteText(layerAlias string, textboxAlias string, text string) {
+	textboxEntry := Textboxes.Get(layerAlias, textboxAlias)
+	if !textboxEntry.IsEnabled {
+		return
+	}
+	snapshot := shared.getSnapshot(textboxEntry)
+	textboxEntry.IsTextChanged = false
+	shared.insertPastedText(textboxEntry, text)
+	if textboxEntry.IsTextChanged {
+		textboxEntry.EditHistory.AddEntry(snapshot, constants.EditTypeReplace, constants.DefaultUndoHistoryLimit)
+	}
+	shared.updateAfterTextChange(layerAlias, textboxAlias, textboxEntry)
+}
+
 /*
 UpdateKeyboardEventTextboxWithString is a method which allows you to process a string of characters as keyboard input. In addition, the following should be noted:
 
@@ -1343,21 +1656,36 @@ func (shared *textboxType) UpdateKeyboardEventManually(layerAlias string, textbo
 		textboxEntry.IsHighlightModeToggled = false
 	}
 
+	// Take a snapshot before editing, so that the edit can be undone later. Typing which continues the
+	// transaction already open is not recorded separately, so no snapshot is needed for it.
+	editType, isNewTransaction := shared.getKeystrokeEditType(textboxEntry, keystroke)
+	var snapshot types.TextboxSnapshotType
+	isSnapshotTaken := false
+	if editType == constants.EditTypeNone {
+		textboxEntry.EditHistory.EndTransaction()
+	} else if isNewTransaction || !textboxEntry.EditHistory.IsContinuingTransaction(editType) {
+		snapshot = shared.getSnapshot(textboxEntry)
+		isSnapshotTaken = true
+		textboxEntry.IsTextChanged = false
+	}
+
 	// Handle cursor movement and text modification
 	switch keystrokeAsString {
+	// Undo and redo
+	case "ctrl+z":
+		shared.undo(textboxEntry)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
+	case "ctrl+y":
+		shared.redo(textboxEntry)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
 	// Clipboard operations
 	case "ctrl+c", "ctrl+insert": // Copy
 		if textboxEntry.IsHighlightActive {
-			// Get highlighted text and copy to clipboard
-			clipboardText := shared.getHighlightedText(textboxEntry)
-			err := clipboard.WriteAll(clipboardText)
-			if err != nil {
-				RestoreTerminalSettings()
-				fmt.Println("Clipboard write error:", err)
-				safeSttyPanic(err)
-				// Handle clipboard write error (could log it)
-				// fmt.Println("Clipboard write error:", err)
-			}
+			setClipboardText(shared.getHighlightedText(textboxEntry))
 		}
 		isScreenUpdateRequired = true
 		isKeystrokeConsumed = true
@@ -1365,12 +1693,7 @@ func (shared *textboxType) UpdateKeyboardEventManually(layerAlias string, textbo
 	case "ctrl+x": // Cut
 		if textboxEntry.IsHighlightActive {
 			// Get highlighted text, copy to clipboard, then delete
-			clipboardText := shared.getHighlightedText(textboxEntry)
-			err := clipboard.WriteAll(clipboardText)
-			if err != nil {
-				// Handle clipboard write error (could log it)
-				// fmt.Println("Clipboard write error:", err)
-			}
+			setClipboardText(shared.getHighlightedText(textboxEntry))
 			shared.deleteHighlightedText(textboxEntry)
 			textboxEntry.IsHighlightActive = false
 		}
@@ -1378,23 +1701,7 @@ func (shared *textboxType) UpdateKeyboardEventManually(layerAlias string, textbo
 		isKeystrokeConsumed = true
 
 	case "ctrl+v", "shift+insert": // Paste
-		if textboxEntry.IsHighlightActive {
-			shared.deleteHighlightedText(textboxEntry)
-			textboxEntry.IsHighlightActive = false
-		}
-		clipboardText, err := clipboard.ReadAll()
-		if err != nil {
-			// Handle clipboard read error (could log it)
-			// fmt.Println("Clipboard read error:", err)
-		} else {
-			for _, char := range clipboardText {
-				if char == '\n' {
-					shared.moveTextAfterCursorToNextLine(textboxEntry, textboxEntry.CursorYLocation)
-				} else {
-					shared.insertCharacterUsingAbsoluteCoordinates(textboxEntry, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation, char)
-				}
-			}
-		}
+		shared.insertPastedText(textboxEntry, getClipboardText())
 		isScreenUpdateRequired = true
 		isKeystrokeConsumed = true
 
@@ -1525,6 +1832,22 @@ func (shared *textboxType) UpdateKeyboardEventManually(layerAlias string, textbo
 		isScreenUpdateRequired = true
 		isKeystrokeConsumed = true
 
+	// Search
+	case "ctrl+f":
+		shared.showFindPrompt(layerAlias, textboxAlias)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
+	case "f3":
+		shared.findAndReportError(layerAlias, textboxAlias, textboxEntry, true)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
+	case "shift+f3":
+		shared.findAndReportError(layerAlias, textboxAlias, textboxEntry, false)
+		isScreenUpdateRequired = true
+		isKeystrokeConsumed = true
+
 	case "ctrl+w": // Toggle word wrap
 		textboxEntry.IsWordWrapEnabled = !textboxEntry.IsWordWrapEnabled
 
@@ -1648,6 +1971,11 @@ func (shared *textboxType) UpdateKeyboardEventManually(layerAlias string, textbo
 		}
 	}
 
+	// Only keep the snapshot if the keystroke actually changed the text.
+	if isSnapshotTaken && textboxEntry.IsTextChanged {
+		textboxEntry.EditHistory.AddEntry(snapshot, editType, constants.DefaultUndoHistoryLimit)
+	}
+
 	// Update the highlight end position if highlight mode is toggled on
 	if textboxEntry.IsHighlightActive {
 		textboxEntry.HighlightEndX = textboxEntry.CursorXLocation
@@ -1684,6 +2012,122 @@ func (shared *textboxType) UpdateKeyboardEvent(keystroke []rune) (bool, bool) {
 	return shared.UpdateKeyboardEventManually(focusedLayerAlias, focusedControlAlias, keystroke)
 }
 
+/*
+getKeystrokeEditType is a method which allows you to determine what kind of edit a keystroke will make to a textbox,
+so that it can be recorded in the undo history. In addition, the following should be noted:
+
+- Keystrokes which do not edit text, such as cursor movement, return 'constants.EditTypeNone'.
+
+  - Pasting, cutting, or replacing highlighted text is always recorded as a new transaction, while typing is grouped
+    into words and consecutive deletes are grouped together.
+
+Example:
+
+	editType, isNewTransaction := textbox.getKeystrokeEditType(entry, []rune("a"))
+*/
+func (shared *textboxType) getKeystrokeEditType(textboxEntry *types.TextboxEntryType, keystroke []rune) (int, bool) {
+	switch string(keystroke) {
+	case "ctrl+v", "shift+insert":
+		return constants.EditTypeReplace, true
+	case "ctrl+x":
+		if textboxEntry.IsHighlightActive {
+			return constants.EditTypeReplace, true
+		}
+	case "delete", "shift+delete":
+		if textboxEntry.IsHighlightActive {
+			return constants.EditTypeReplace, true
+		}
+		return constants.EditTypeDelete, false
+	case "backspace", "backspace2", "shift+backspace", "shift+backspace2":
+		if textboxEntry.IsHighlightActive {
+			return constants.EditTypeReplace, true
+		}
+		return constants.EditTypeBackspace, false
+	case "enter":
+		return constants.EditTypeInsert, false
+	default:
+		if len(keystroke) == 1 {
+			if textboxEntry.IsHighlightActive {
+				return constants.EditTypeReplace, true
+			}
+			previousCharacter := ' '
+			if textboxEntry.CursorYLocation < len(textboxEntry.TextData) && textboxEntry.CursorXLocation > 0 &&
+				textboxEntry.CursorXLocation <= len(textboxEntry.TextData[textboxEntry.CursorYLocation]) {
+				previousCharacter = textboxEntry.TextData[textboxEntry.CursorYLocation][textboxEntry.CursorXLocation-1]
+			}
+			return constants.EditTypeInsert, isWordBoundaryEdit(constants.EditTypeInsert, previousCharacter, keystroke[0])
+		}
+	}
+	return constants.EditTypeNone, false
+}
+
+/*
+getSnapshot is a method which allows you to obtain a copy of the text and cursor position of a textbox, so that it
+can be stored in the undo history.
+
+Example:
+
+	snapshot := textbox.getSnapshot(entry)
+*/
+func (shared *textboxType) getSnapshot(textboxEntry *types.TextboxEntryType) types.TextboxSnapshotType {
+	var snapshot types.TextboxSnapshotType
+	snapshot.TextData = make([][]rune, len(textboxEntry.TextData))
+	for currentIndex, currentLine := range textboxEntry.TextData {
+		snapshot.TextData[currentIndex] = append([]rune{}, currentLine...)
+	}
+	snapshot.CursorXLocation = textboxEntry.CursorXLocation
+	snapshot.CursorYLocation = textboxEntry.CursorYLocation
+	return snapshot
+}
+
+/*
+restoreSnapshot is a method which allows you to return a textbox to the text and cursor position stored in a
+snapshot. Any active highlight is removed.
+
+Example:
+
+	textbox.restoreSnapshot(entry, snapshot)
+*/
+func (shared *textboxType) restoreSnapshot(textboxEntry *types.TextboxEntryType, snapshot types.TextboxSnapshotType) {
+	textboxEntry.TextData = snapshot.TextData
+	textboxEntry.CursorXLocation = snapshot.CursorXLocation
+	textboxEntry.CursorYLocation = snapshot.CursorYLocation
+	textboxEntry.IsHighlightActive = false
+	textboxEntry.IsHighlightModeToggled = false
+}
+
+/*
+undo is a method which allows you to revert the last edit transaction made to a textbox. If there is nothing to undo,
+false is returned.
+
+Example:
+
+	isUndone := textbox.undo(entry)
+*/
+func (shared *textboxType) undo(textboxEntry *types.TextboxEntryType) bool {
+	snapshot, isUndone := textboxEntry.EditHistory.Undo(shared.getSnapshot(textboxEntry))
+	if isUndone {
+		shared.restoreSnapshot(textboxEntry, snapshot)
+	}
+	return isUndone
+}
+
+/*
+redo is a method which allows you to reapply the last edit transaction which was undone in a textbox. If there is
+nothing to redo, false is returned.
+
+Example:
+
+	isRedone := textbox.redo(entry)
+*/
+func (shared *textboxType) redo(textboxEntry *types.TextboxEntryType) bool {
+	snapshot, isRedone := textboxEntry.EditHistory.Redo(shared.getSnapshot(textboxEntry))
+	if isRedone {
+		shared.restoreSnapshot(textboxEntry, snapshot)
+	}
+	return isRedone
+}
+
 /*
 getHighlightedText is a method which allows you to retrieve the text that is currently highlighted in the textbox. In addition, the following should be noted:
 
@@ -1827,6 +2271,7 @@ func (shared *textboxType) deleteHighlightedText(textboxEntry *types.TextboxEntr
 		// Delete the highlighted portion of the line
 		line := textboxEntry.TextData[highlightStartY]
 		if highlightStartX < len(line) {
+			textboxEntry.IsTextChanged = true
 			if highlightEndX+1 < len(line) {
 				textboxEntry.TextData[highlightStartY] = append(line[:highlightStartX], line[highlightEndX+1:]...)
 			} else {
@@ -1864,6 +2309,7 @@ func (shared *textboxType) deleteHighlightedText(textboxEntry *types.TextboxEntr
 		}
 
 		textboxEntry.TextData = newTextData
+		textboxEntry.IsTextChanged = true
 	}
 
 	// Move cursor to the start of the deleted text
@@ -1894,6 +2340,51 @@ func (shared *textboxType) deleteHighlightedText(textboxEntry *types.TextboxEntr
 	textboxEntry.IsHighlightActive = false
 }
 
+/*
+updateMouseSelection is a method which allows you to select text in a textbox with the left mouse button. In addition,
+the following should be noted:
+
+- Clicking moves the cursor and clears any text highlighted, while dragging highlights the text passed over.
+
+- Double clicking highlights a word, and triple clicking highlights a line.
+
+- Clicking while shift is held down highlights from the existing highlight, or the cursor, to the location clicked.
+
+Example:
+
+	textbox.updateMouseSelection("layer1", "textbox1", entry, 4, 2)
+*/
+func (shared *textboxType) updateMouseSelection(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType, xLocation int, yLocation int) {
+	anchorXLocation := textboxEntry.CursorXLocation
+	anchorYLocation := textboxEntry.CursorYLocation
+	if textboxEntry.IsHighlightActive {
+		anchorXLocation = textboxEntry.HighlightStartX
+		anchorYLocation = textboxEntry.HighlightStartY
+	}
+	shared.updateCursor(textboxEntry, xLocation, yLocation)
+	xLocation = textboxEntry.CursorXLocation
+	yLocation = textboxEntry.CursorYLocation
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	if previousButtonPressed == 0 {
+		if IsShiftPressed() {
+			extendMouseSelection(layerAlias, textboxAlias, anchorXLocation, anchorYLocation)
+		} else {
+			startMouseSelection(layerAlias, textboxAlias, textboxEntry.TextData, xLocation, yLocation, GetMouseClickCount())
+		}
+	} else if !isMouseSelectionInProgress(layerAlias, textboxAlias) {
+		return
+	}
+	highlightStartX, highlightStartY, cursorXLocation, cursorYLocation, isHighlightActive := getMouseSelection(textboxEntry.TextData, xLocation, yLocation)
+	textboxEntry.HighlightStartX = highlightStartX
+	textboxEntry.HighlightStartY = highlightStartY
+	textboxEntry.CursorXLocation = cursorXLocation
+	textboxEntry.CursorYLocation = cursorYLocation
+	textboxEntry.HighlightEndX = cursorXLocation
+	textboxEntry.HighlightEndY = cursorYLocation
+	textboxEntry.IsHighlightActive = isHighlightActive
+	textboxEntry.IsHighlightModeToggled = false
+}
+
 /*
 updateMouseEvent is a method which allows you to process mouse events for a textbox. In addition, the following should be noted:
 
@@ -1922,7 +2413,13 @@ func (shared *textboxType) updateMouseEvent() bool {
 			textboxEntry.TextData = [][]rune{[]rune{' '}}
 		}
 
-		shared.updateCursor(textboxEntry, characterEntry.AttributeEntry.CellControlId, characterEntry.AttributeEntry.CellControlLocation)
+		// Moving the cursor with the mouse ends the typing currently being grouped for undo.
+		textboxEntry.EditHistory.EndTransaction()
+		if buttonPressed == constants.MouseButtonLeft {
+			shared.updateMouseSelection(layerAlias, characterEntry.AttributeEntry.CellControlAlias, textboxEntry, characterEntry.AttributeEntry.CellControlId, characterEntry.AttributeEntry.CellControlLocation)
+		} else {
+			shared.updateCursor(textboxEntry, characterEntry.AttributeEntry.CellControlId, characterEntry.AttributeEntry.CellControlLocation)
+		}
 		shared.updateViewport(textboxEntry)
 		shared.setTextboxMaxScrollBarValues(layerAlias, characterEntry.AttributeEntry.CellControlAlias)
 		shared.updateScrollbarBasedOnTextboxViewport(layerAlias, characterEntry.AttributeEntry.CellControlAlias)
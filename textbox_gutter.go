@@ -0,0 +1,228 @@
+package consolizer
+
+import (
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+)
+
+/*
+SetLineNumbers is a method which allows you to show or hide line numbers in a gutter along the left side of a
+textbox. If the textbox instance no longer exists, then no operation takes place. In addition, the following should
+be noted:
+
+- The gutter takes space away from the text, so less of each line is visible.
+
+- The gutter grows as needed to fit the number of the last line.
+
+- The number of the line the cursor is on is drawn in a different color.
+
+  - When word wrapping is enabled, only the first part of each wrapped line is numbered, so that numbers always refer
+    to the lines of the original text.
+
+Example:
+
+	textbox.SetLineNumbers(true)
+*/
+func (shared *TextboxInstanceType) SetLineNumbers(enabled bool) *TextboxInstanceType {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+		textboxEntry.IsLineNumbersEnabled = enabled
+		textbox.updateAfterTextChange(shared.layerAlias, shared.controlAlias, textboxEntry)
+	}
+	return shared
+}
+
+/*
+SetLineMarker is a method which allows you to mark a line of a textbox with an error, warning, or bookmark symbol in
+its gutter. If the textbox instance no longer exists, then no operation takes place. In addition, the following
+should be noted:
+
+- Line indexes start at 0, so the first line is line index 0 even though it is numbered 1 in the gutter.
+
+- A gutter is shown whenever at least one line is marked, even if line numbers are disabled.
+
+- Passing in 'constants.LineMarkerNone' removes the marker from the line.
+
+  - Markers stay on the line index they were placed on, even if lines are later added or removed above them. If an
+    invalid marker type is passed in, a panic will be generated.
+
+Example:
+
+	textbox.SetLineMarker(11, constants.LineMarkerError)
+*/
+func (shared *TextboxInstanceType) SetLineMarker(lineIndex int, markerType int) *TextboxInstanceType {
+	validateLineMarkerType(markerType)
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+		if markerType == constants.LineMarkerNone {
+			delete(textboxEntry.LineMarkers, lineIndex)
+		} else {
+			textboxEntry.LineMarkers[lineIndex] = markerType
+		}
+		textbox.updateAfterTextChange(shared.layerAlias, shared.controlAlias, textboxEntry)
+	}
+	return shared
+}
+
+/*
+GetLineMarker is a method which allows you to obtain the marker placed on a line of a textbox. If the line has no
+marker, or the textbox instance no longer exists, then 'constants.LineMarkerNone' is returned.
+
+Example:
+
+	markerType := textbox.GetLineMarker(11)
+*/
+func (shared *TextboxInstanceType) GetLineMarker(lineIndex int) int {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+		return textboxEntry.LineMarkers[lineIndex]
+	}
+	return constants.LineMarkerNone
+}
+
+/*
+ClearLineMarkers is a method which allows you to remove every marker from a textbox, for example before showing the
+results of a new compile. If the textbox instance no longer exists, then no operation takes place.
+
+Example:
+
+	textbox.ClearLineMarkers()
+*/
+func (shared *TextboxInstanceType) ClearLineMarkers() *TextboxInstanceType {
+	if Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+		textboxEntry.LineMarkers = make(map[int]int)
+		textbox.updateAfterTextChange(shared.layerAlias, shared.controlAlias, textboxEntry)
+	}
+	return shared
+}
+
+/*
+getGutterWidth is a method which allows you to obtain how many columns the gutter of a textbox occupies. In
+addition, the following should be noted:
+
+  - The gutter holds one column for markers and one blank column to separate it from the text. If line numbers are
+    enabled, enough columns for the largest line number are added after the markers, with a blank column between
+    them.
+
+- If the textbox has neither line numbers nor markers, the gutter is hidden and 0 is returned.
+
+- The gutter never takes up the entire width of the textbox, so at least one column is always left for text.
+
+Example:
+
+	gutterWidth := textbox.getGutterWidth(entry)
+*/
+func (shared *textboxType) getGutterWidth(textboxEntry *types.TextboxEntryType) int {
+	if !textboxEntry.IsLineNumbersEnabled && len(textboxEntry.LineMarkers) == 0 {
+		return 0
+	}
+	gutterWidth := 2
+	if textboxEntry.IsLineNumbersEnabled {
+		gutterWidth += len(fmt.Sprintf("%d", len(textboxEntry.TextData))) + 1
+	}
+	if gutterWidth > textboxEntry.Width-1 {
+		gutterWidth = textboxEntry.Width - 1
+	}
+	if gutterWidth < 0 {
+		gutterWidth = 0
+	}
+	return gutterWidth
+}
+
+/*
+getTextAreaWidth is a method which allows you to obtain how many columns of a textbox are available for text, once
+space for its gutter has been taken away.
+
+Example:
+
+	textAreaWidth := textbox.getTextAreaWidth(entry)
+*/
+func (shared *textboxType) getTextAreaWidth(textboxEntry *types.TextboxEntryType) int {
+	return textboxEntry.Width - shared.getGutterWidth(textboxEntry)
+}
+
+/*
+getWrappedLineIndexes is a method which allows you to obtain which line of the original text each wrapped line
+belongs to. In addition, the following should be noted:
+
+- The result holds one entry for each line produced by 'wrapTextToWidth' with the same width.
+
+- Only the first part of each original line holds its line index. Parts which were wrapped hold -1.
+
+Example:
+
+	lineIndexes := textbox.getWrappedLineIndexes(text, 20)
+*/
+func (shared *textboxType) getWrappedLineIndexes(text [][]rune, width int) []int {
+	var lineIndexes []int
+	for lineIndex, line := range text {
+		lineIndexes = append(lineIndexes, lineIndex)
+		if width <= 0 {
+			continue
+		}
+		for segmentIndex := 1; segmentIndex < len(shared.getWrappedLineSegments(line, width)); segmentIndex++ {
+			lineIndexes = append(lineIndexes, -1)
+		}
+	}
+	return lineIndexes
+}
+
+/*
+drawGutter is a method which allows you to draw a single row of the gutter of a textbox. In addition, the following
+should be noted:
+
+- A line index of -1 draws an empty gutter row, such as for a wrapped line or a row past the end of the text.
+
+  - Clicking the gutter places the cursor on the same line, since each gutter cell belongs to the first visible
+    character of its row.
+
+Example:
+
+	textbox.drawGutter(layerEntry, "textbox1", entry, attributeEntry, 3, 3, 5)
+*/
+func (shared *textboxType) drawGutter(layerEntry *types.LayerEntryType, textboxAlias string, textboxEntry *types.TextboxEntryType, attributeEntry types.AttributeEntryType, yLocation int, controlYLocation int, lineIndex int) {
+	gutterWidth := shared.getGutterWidth(textboxEntry)
+	if gutterWidth == 0 {
+		return
+	}
+	textboxStyle := textboxEntry.StyleEntry.Textbox
+	attributeEntry.CellControlAlias = textboxAlias
+	attributeEntry.CellType = constants.CellTypeTextbox
+	attributeEntry.CellControlId = textboxEntry.ViewportXLocation
+	attributeEntry.CellControlLocation = controlYLocation
+	attributeEntry.BackgroundColor = textboxStyle.GutterBackgroundColor
+	gutterText := make([]rune, gutterWidth)
+	for currentIndex := range gutterText {
+		gutterText[currentIndex] = ' '
+	}
+	if lineIndex >= 0 && textboxEntry.IsLineNumbersEnabled {
+		lineNumber := []rune(fmt.Sprintf("%d", lineIndex+1))
+		if len(lineNumber) > gutterWidth-3 {
+			lineNumber = lineNumber[len(lineNumber)-max(gutterWidth-3, 0):]
+		}
+		copy(gutterText[gutterWidth-1-len(lineNumber):], lineNumber)
+	}
+	attributeEntry.ForegroundColor = textboxStyle.GutterForegroundColor
+	if lineIndex >= 0 && lineIndex == textboxEntry.CursorYLocation {
+		attributeEntry.ForegroundColor = textboxStyle.GutterCurrentLineColor
+	}
+	layer.printLayer(layerEntry, attributeEntry, textboxEntry.XLocation+1, yLocation, gutterText[1:])
+	gutterText[0] = ' '
+	attributeEntry.ForegroundColor = textboxStyle.GutterForegroundColor
+	if lineIndex >= 0 {
+		switch textboxEntry.LineMarkers[lineIndex] {
+		case constants.LineMarkerError:
+			gutterText[0] = textboxStyle.ErrorMarker
+			attributeEntry.ForegroundColor = textboxStyle.ErrorMarkerColor
+		case constants.LineMarkerWarning:
+			gutterText[0] = textboxStyle.WarningMarker
+			attributeEntry.ForegroundColor = textboxStyle.WarningMarkerColor
+		case constants.LineMarkerBookmark:
+			gutterText[0] = textboxStyle.BookmarkMarker
+			attributeEntry.ForegroundColor = textboxStyle.BookmarkMarkerColor
+		}
+	}
+	layer.printLayer(layerEntry, attributeEntry, textboxEntry.XLocation, yLocation, gutterText[:1])
+}
@@ -0,0 +1,93 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+TestTextboxGutter is a test which verifies that a textbox draws line numbers and markers in a gutter which follows
+the viewport.
+
+Example:
+
+	Expected Inputs:
+	    A textbox with twelve lines, line numbers enabled, and markers placed on several lines, drawn before and
+	    after scrolling.
+
+	Expected Outputs:
+	    Each visible line is numbered and its text starts after the gutter, markers are drawn in their colors, the
+	    line holding the cursor is numbered in the current line color, and the numbers follow the viewport.
+*/
+func TestTextboxGutter(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textboxInstance := layer1.AddTextbox(styleEntry, 2, 2, 20, 4, false)
+	textboxInstance.SetText("zero\none\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven")
+	textboxInstance.SetLineNumbers(true)
+	textboxInstance.SetLineMarker(1, constants.LineMarkerError)
+	textboxInstance.SetLineMarker(2, constants.LineMarkerWarning)
+	textboxInstance.SetLineMarker(10, constants.LineMarkerBookmark)
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textboxInstance.controlAlias)
+	layerEntry := Layers.Get(layer1.layerAlias)
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, 5, textbox.getGutterWidth(textboxEntry), "The gutter was not sized to fit two digit line numbers!")
+	assert.Equalf(test, "   1  ", getTextboxGutterTestRow(layerEntry, 2, 2, 6), "The first line was not numbered!")
+	assert.Equalf(test, "E  2 zero", getTextboxGutterTestRow(layerEntry, 2, 3, 9), "The error marker or line text was not drawn!")
+	assert.Equalf(test, "W  3 one", getTextboxGutterTestRow(layerEntry, 2, 4, 8), "The warning marker was not drawn!")
+	assert.Equalf(test, styleEntry.Textbox.ErrorMarkerColor, layerEntry.CharacterMemory[3][2].AttributeEntry.ForegroundColor, "The error marker was not drawn in its color!")
+	assert.Equalf(test, styleEntry.Textbox.GutterCurrentLineColor, layerEntry.CharacterMemory[2][5].AttributeEntry.ForegroundColor, "The current line was not numbered in the current line color!")
+	assert.Equalf(test, styleEntry.Textbox.GutterForegroundColor, layerEntry.CharacterMemory[3][5].AttributeEntry.ForegroundColor, "Another line was numbered in the current line color!")
+
+	textboxInstance.SetViewport(0, 9)
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, "  10 eight", getTextboxGutterTestRow(layerEntry, 2, 2, 10), "The line numbers did not follow the viewport!")
+	assert.Equalf(test, "• 11 nine", getTextboxGutterTestRow(layerEntry, 2, 3, 9), "The bookmark marker did not follow the viewport!")
+
+	textboxInstance.SetLineNumbers(false)
+	assert.Equalf(test, 2, textbox.getGutterWidth(textboxEntry), "The gutter was not kept for markers when line numbers were disabled!")
+	textboxInstance.ClearLineMarkers()
+	assert.Equalf(test, 0, textbox.getGutterWidth(textboxEntry), "The gutter was still shown without line numbers or markers!")
+	assert.Equalf(test, constants.LineMarkerNone, textboxInstance.GetLineMarker(1), "A marker remained after the markers were cleared!")
+	assert.Panicsf(test, func() { textboxInstance.SetLineMarker(0, 99) }, "An invalid marker type did not panic!")
+}
+
+/*
+TestTextboxGutterWordWrap is a test which verifies that only the first part of a wrapped line is numbered.
+
+Example:
+
+	Expected Inputs:
+	    A narrow textbox with word wrapping and line numbers enabled, holding a line too long to fit.
+
+	Expected Outputs:
+	    The wrapped part of the long line has no number, and the following line keeps its original number.
+*/
+func TestTextboxGutterWordWrap(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textboxInstance := layer1.AddTextbox(styleEntry, 2, 2, 12, 4, false)
+	textboxInstance.SetText("abc def ghi\nend")
+	textboxInstance.SetWordWrap(true)
+	textboxInstance.SetLineNumbers(true)
+	layerEntry := Layers.Get(layer1.layerAlias)
+	textbox.draw(layerEntry, textboxInstance.controlAlias)
+	assert.Equalf(test, "  2 abc def ", getTextboxGutterTestRow(layerEntry, 2, 3, 12), "The first part of the wrapped line was not numbered!")
+	assert.Equalf(test, "    ghi", getTextboxGutterTestRow(layerEntry, 2, 4, 7), "The wrapped part of the line was numbered!")
+	assert.Equalf(test, "  3 end", getTextboxGutterTestRow(layerEntry, 2, 5, 7), "The line after a wrapped line was not numbered correctly!")
+}
+
+/*
+getTextboxGutterTestRow is a method which allows you to obtain the characters drawn on part of a row of a layer.
+
+Example:
+
+	rowText := getTextboxGutterTestRow(layerEntry, 2, 3, 9)
+*/
+func getTextboxGutterTestRow(layerEntry *types.LayerEntryType, xLocation int, yLocation int, width int) string {
+	var rowText []rune
+	for currentX := xLocation; currentX < xLocation+width; currentX++ {
+		rowText = append(rowText, layerEntry.CharacterMemory[yLocation][currentX].Character)
+	}
+	return string(rowText)
+}
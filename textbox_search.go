@@ -0,0 +1,352 @@
+package consolizer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+FindNext is a method which allows you to search a textbox for the next occurrence of some text after the cursor. If
+the textbox instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+- If a match is found, it is highlighted and the viewport scrolls so that it is visible.
+
+- The search wraps around to the start of the text if no match is found before the end.
+
+  - If regular expressions are enabled, the search text is treated as a Go regular expression. An invalid regular
+    expression returns an error instead of searching.
+
+- Matches never span more than one line.
+
+- The search is remembered, so that the user can repeat it by pressing F3 or shift+F3.
+
+- If no match is found, false is returned.
+
+Example:
+
+	isFound, err := textbox.FindNext("hello", false, false)
+*/
+func (shared *TextboxInstanceType) FindNext(searchText string, isCaseSensitive bool, isRegularExpression bool) (bool, error) {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return false, nil
+	}
+	textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+	textbox.setSearch(textboxEntry, searchText, isCaseSensitive, isRegularExpression)
+	return textbox.find(shared.layerAlias, shared.controlAlias, textboxEntry, true)
+}
+
+/*
+FindPrevious is a method which allows you to search a textbox for the previous occurrence of some text before the
+cursor. If the textbox instance no longer exists, then no operation takes place. In addition, the following should
+be noted:
+
+- If a match is found, it is highlighted and the viewport scrolls so that it is visible.
+
+- The search wraps around to the end of the text if no match is found before the start.
+
+  - If regular expressions are enabled, the search text is treated as a Go regular expression. An invalid regular
+    expression returns an error instead of searching.
+
+- If no match is found, false is returned.
+
+Example:
+
+	isFound, err := textbox.FindPrevious("hello", false, false)
+*/
+func (shared *TextboxInstanceType) FindPrevious(searchText string, isCaseSensitive bool, isRegularExpression bool) (bool, error) {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return false, nil
+	}
+	textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+	textbox.setSearch(textboxEntry, searchText, isCaseSensitive, isRegularExpression)
+	return textbox.find(shared.layerAlias, shared.controlAlias, textboxEntry, false)
+}
+
+/*
+ReplaceAll is a method which allows you to replace every occurrence of some text in a textbox. If the textbox
+instance no longer exists, then no operation takes place. In addition, the following should be noted:
+
+  - If regular expressions are enabled, the replacement text may refer to submatches using '$1' or '${name}'.
+    Otherwise, the replacement text is inserted exactly as given.
+
+- A replacement containing '\n' splits the line at that point.
+
+- All replacements are undone together in a single step.
+
+- The number of replacements made is returned. An invalid regular expression returns an error instead of replacing.
+
+Example:
+
+	numberOfReplacements, err := textbox.ReplaceAll("colour", "color", false, false)
+*/
+func (shared *TextboxInstanceType) ReplaceAll(searchText string, replacementText string, isCaseSensitive bool, isRegularExpression bool) (int, error) {
+	if !Textboxes.IsExists(shared.layerAlias, shared.controlAlias) {
+		return 0, nil
+	}
+	textboxEntry := Textboxes.Get(shared.layerAlias, shared.controlAlias)
+	textbox.setSearch(textboxEntry, searchText, isCaseSensitive, isRegularExpression)
+	return textbox.replaceAll(shared.layerAlias, shared.controlAlias, textboxEntry, replacementText)
+}
+
+/*
+setSearch is a method which allows you to remember the search last performed on a textbox, so that it can be
+repeated later.
+
+Example:
+
+	textbox.setSearch(entry, "hello", false, false)
+*/
+func (shared *textboxType) setSearch(textboxEntry *types.TextboxEntryType, searchText string, isCaseSensitive bool, isRegularExpression bool) {
+	textboxEntry.SearchText = searchText
+	textboxEntry.IsSearchCaseSensitive = isCaseSensitive
+	textboxEntry.IsSearchRegularExpression = isRegularExpression
+}
+
+/*
+find is a method which allows you to search a textbox for the next or previous match of the search last set on it,
+and highlight the match found. In addition, the following should be noted:
+
+  - When a match is already highlighted, searching forward begins just after it. Searching backward only considers
+    matches which end before the cursor.
+
+- If the search text is empty or no match is found, false is returned and the textbox is left unchanged.
+
+- If the search text is an invalid regular expression, an error is returned and the textbox is left unchanged.
+
+Example:
+
+	isFound, err := textbox.find("layer1", "textbox1", entry, true)
+*/
+func (shared *textboxType) find(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType, isForward bool) (bool, error) {
+	if textboxEntry.SearchText == "" || len(textboxEntry.TextData) == 0 {
+		return false, nil
+	}
+	searchExpression, err := getSearchExpression(textboxEntry.SearchText, textboxEntry.IsSearchCaseSensitive, textboxEntry.IsSearchRegularExpression)
+	if err != nil {
+		return false, err
+	}
+	numberOfLines := len(textboxEntry.TextData)
+	cursorYLocation := textboxEntry.CursorYLocation
+	if cursorYLocation < 0 || cursorYLocation >= numberOfLines {
+		cursorYLocation = 0
+	}
+	if isForward {
+		startXLocation := textboxEntry.CursorXLocation
+		if textboxEntry.IsHighlightActive {
+			startXLocation++
+		}
+		// The cursor line is checked a second time at the end, so that matches before the cursor are found once the
+		// search wraps around.
+		for lineOffset := 0; lineOffset <= numberOfLines; lineOffset++ {
+			yLocation := (cursorYLocation + lineOffset) % numberOfLines
+			for _, currentMatch := range shared.getSearchMatches(textboxEntry.TextData[yLocation], searchExpression) {
+				if lineOffset == 0 && currentMatch[0] < startXLocation {
+					continue
+				}
+				shared.selectSearchMatch(layerAlias, textboxAlias, textboxEntry, yLocation, currentMatch)
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	for lineOffset := 0; lineOffset <= numberOfLines; lineOffset++ {
+		yLocation := (cursorYLocation - lineOffset%numberOfLines + numberOfLines) % numberOfLines
+		searchMatches := shared.getSearchMatches(textboxEntry.TextData[yLocation], searchExpression)
+		for currentIndex := len(searchMatches) - 1; currentIndex >= 0; currentIndex-- {
+			// Matches which end after the cursor include the one currently highlighted, so they are skipped.
+			if lineOffset == 0 && searchMatches[currentIndex][1] > textboxEntry.CursorXLocation {
+				continue
+			}
+			shared.selectSearchMatch(layerAlias, textboxAlias, textboxEntry, yLocation, searchMatches[currentIndex])
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+/*
+replaceAll is a method which allows you to replace every match of the search last set on a textbox. In addition, the
+following should be noted:
+
+- The edit is recorded in the undo history as a single transaction.
+
+- Any active highlight is cleared, and the number of replacements made is returned.
+
+  - The space which ends every line is left out of the search, and added back to each line produced, so that lines
+    split or emptied by a replacement can still be edited and joined like any other.
+
+- If the search text is an invalid regular expression, an error is returned and the textbox is left unchanged.
+
+Example:
+
+	numberOfReplacements, err := textbox.replaceAll("layer1", "textbox1", entry, "color")
+*/
+func (shared *textboxType) replaceAll(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType, replacementText string) (int, error) {
+	if textboxEntry.SearchText == "" {
+		return 0, nil
+	}
+	searchExpression, err := getSearchExpression(textboxEntry.SearchText, textboxEntry.IsSearchCaseSensitive, textboxEntry.IsSearchRegularExpression)
+	if err != nil {
+		return 0, err
+	}
+	snapshot := shared.getSnapshot(textboxEntry)
+	numberOfReplacements := 0
+	var textData [][]rune
+	for _, currentLine := range textboxEntry.TextData {
+		lineText := strings.TrimSuffix(string(currentLine), " ")
+		numberOfMatches := len(searchExpression.FindAllStringIndex(lineText, -1))
+		if numberOfMatches == 0 {
+			textData = append(textData, currentLine)
+			continue
+		}
+		numberOfReplacements += numberOfMatches
+		if textboxEntry.IsSearchRegularExpression {
+			lineText = searchExpression.ReplaceAllString(lineText, replacementText)
+		} else {
+			lineText = searchExpression.ReplaceAllLiteralString(lineText, replacementText)
+		}
+		for _, currentText := range strings.Split(lineText, "\n") {
+			textData = append(textData, []rune(currentText+" "))
+		}
+	}
+	if numberOfReplacements == 0 {
+		return 0, nil
+	}
+	textboxEntry.TextData = textData
+	textboxEntry.IsHighlightActive = false
+	textboxEntry.IsHighlightModeToggled = false
+	textboxEntry.EditHistory.AddEntry(snapshot, constants.EditTypeReplace, constants.DefaultUndoHistoryLimit)
+	textboxEntry.EditHistory.EndTransaction()
+	shared.updateAfterTextChange(layerAlias, textboxAlias, textboxEntry)
+	return numberOfReplacements, nil
+}
+
+/*
+getSearchMatches is a method which allows you to obtain the location of every match on a single line of a textbox.
+In addition, the following should be noted:
+
+- Each match is returned as a start and end character index, with the end index being exclusive.
+
+- Empty matches, which some regular expressions allow, are ignored since there would be nothing to highlight.
+
+Example:
+
+	searchMatches := textbox.getSearchMatches(line, searchExpression)
+*/
+func (shared *textboxType) getSearchMatches(line []rune, searchExpression *regexp.Regexp) [][2]int {
+	var searchMatches [][2]int
+	lineText := string(line)
+	for _, currentMatch := range searchExpression.FindAllStringIndex(lineText, -1) {
+		if currentMatch[1] == currentMatch[0] {
+			continue
+		}
+		// Regular expressions report byte offsets, so they need to be converted to character offsets.
+		startIndex := utf8.RuneCountInString(lineText[:currentMatch[0]])
+		endIndex := startIndex + utf8.RuneCountInString(lineText[currentMatch[0]:currentMatch[1]])
+		searchMatches = append(searchMatches, [2]int{startIndex, endIndex})
+	}
+	return searchMatches
+}
+
+/*
+selectSearchMatch is a method which allows you to highlight a search match and move the cursor to it. In addition,
+the following should be noted:
+
+  - The highlight begins at the start of the match, and the cursor is placed on its last character, so that typing
+    replaces the match just like any other highlighted text.
+
+- The viewport is scrolled so that the match is visible.
+
+Example:
+
+	textbox.selectSearchMatch("layer1", "textbox1", entry, 3, [2]int{4, 9})
+*/
+func (shared *textboxType) selectSearchMatch(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType, yLocation int, searchMatch [2]int) {
+	textboxEntry.EditHistory.EndTransaction()
+	textboxEntry.IsHighlightActive = true
+	textboxEntry.IsHighlightModeToggled = false
+	textboxEntry.HighlightStartX = searchMatch[0]
+	textboxEntry.HighlightStartY = yLocation
+	textboxEntry.CursorXLocation = searchMatch[1] - 1
+	textboxEntry.CursorYLocation = yLocation
+	textboxEntry.HighlightEndX = textboxEntry.CursorXLocation
+	textboxEntry.HighlightEndY = textboxEntry.CursorYLocation
+	shared.updateAfterTextChange(layerAlias, textboxAlias, textboxEntry)
+}
+
+/*
+showFindPrompt is a method which allows you to ask the user what to search for in a textbox, and then search for
+it. In addition, the following should be noted:
+
+- The prompt is filled in with the last search made on the textbox.
+
+- The case sensitivity and regular expression settings of the last search are kept.
+
+- If the prompt is cancelled or left empty, no search is made.
+
+  - The prompt does not wait for the user, since it is opened from an event handler. The search is made once the
+    prompt is answered.
+
+- If the search text is an invalid regular expression, the user is told so with a message box.
+
+Example:
+
+	textbox.showFindPrompt("layer1", "textbox1")
+*/
+func (shared *textboxType) showFindPrompt(layerAlias string, textboxAlias string) {
+	textboxEntry := Textboxes.Get(layerAlias, textboxAlias)
+	ShowInputPrompt("Find", "Find what:", textboxEntry.SearchText, textboxEntry.StyleEntry, func(searchText string, isAccepted bool) {
+		if !isAccepted || searchText == "" || !Textboxes.IsExists(layerAlias, textboxAlias) {
+			return
+		}
+		textboxEntry := Textboxes.Get(layerAlias, textboxAlias)
+		textboxEntry.SearchText = searchText
+		shared.findAndReportError(layerAlias, textboxAlias, textboxEntry, true)
+	})
+}
+
+/*
+findAndReportError is a method which allows you to repeat the search last set on a textbox for the user, and tell
+them with a message box if the search could not be made.
+
+Example:
+
+	textbox.findAndReportError("layer1", "textbox1", entry, true)
+*/
+func (shared *textboxType) findAndReportError(layerAlias string, textboxAlias string, textboxEntry *types.TextboxEntryType, isForward bool) {
+	if _, err := shared.find(layerAlias, textboxAlias, textboxEntry, isForward); err != nil {
+		ShowMessageBox("Find", err.Error(), textboxEntry.StyleEntry, nil)
+	}
+}
+
+/*
+getSearchExpression is a method which allows you to build the regular expression used to search a textbox. In
+addition, the following should be noted:
+
+- Plain search text is escaped so that it matches literally.
+
+- If the search text is an invalid regular expression, an error is returned.
+
+Example:
+
+	searchExpression, err := getSearchExpression("hello", false, false)
+*/
+func getSearchExpression(searchText string, isCaseSensitive bool, isRegularExpression bool) (*regexp.Regexp, error) {
+	pattern := searchText
+	if !isRegularExpression {
+		pattern = regexp.QuoteMeta(searchText)
+	}
+	if !isCaseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	searchExpression, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("The search expression '%s' is invalid: %s", searchText, err.Error()))
+	}
+	return searchExpression, nil
+}
@@ -0,0 +1,213 @@
+package consolizer
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+TestTextboxFind is a test which verifies that text can be found in a textbox in both directions, and that matches
+are highlighted and scrolled into view.
+
+Example:
+
+	Expected Inputs:
+	    A textbox with more lines than it can show, searched forwards and backwards with plain text, case sensitive
+	    text, and a regular expression, and then with F3 and shift+F3.
+
+	Expected Outputs:
+	    Each match is highlighted with the cursor on its last character, searches wrap around at either end, the
+	    viewport follows the match, and a search with no matches or an invalid regular expression leaves the textbox
+	    unchanged.
+*/
+func TestTextboxFind(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textboxInstance := layer1.AddTextbox(styleEntry, 2, 2, 20, 3, false)
+	textboxInstance.SetText("Apple pie\nbanana\ncherry apple\ndate\nelderberry\nAPPLE juice")
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textboxInstance.controlAlias)
+
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("apple", false, false)), "A match was not found!")
+	assert.Equalf(test, [4]int{0, 1, 4, 1}, getTextboxSearchTestHighlight(textboxEntry), "The first match was not highlighted!")
+	assert.Truef(test, textboxEntry.IsHighlightActive, "The match found was not highlighted!")
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("apple", false, false)), "The next match was not found!")
+	assert.Equalf(test, [4]int{7, 3, 11, 3}, getTextboxSearchTestHighlight(textboxEntry), "The second match was not highlighted!")
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("apple", false, false)), "The last match was not found!")
+	assert.Equalf(test, [4]int{0, 6, 4, 6}, getTextboxSearchTestHighlight(textboxEntry), "The last match was not highlighted!")
+	assert.Equalf(test, 4, textboxEntry.ViewportYLocation, "The viewport did not scroll to the match!")
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("apple", false, false)), "The search did not wrap around to the start!")
+	assert.Equalf(test, [4]int{0, 1, 4, 1}, getTextboxSearchTestHighlight(textboxEntry), "The search did not wrap around to the first match!")
+	assert.Equalf(test, 1, textboxEntry.ViewportYLocation, "The viewport did not scroll back to the match!")
+
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindPrevious("apple", false, false)), "A previous match was not found!")
+	assert.Equalf(test, [4]int{0, 6, 4, 6}, getTextboxSearchTestHighlight(textboxEntry), "The backwards search did not wrap around to the end!")
+	textbox.UpdateKeyboardEventManually(layer1.layerAlias, textboxInstance.controlAlias, []rune("shift+f3"))
+	assert.Equalf(test, [4]int{7, 3, 11, 3}, getTextboxSearchTestHighlight(textboxEntry), "Shift+F3 did not repeat the search backwards!")
+	textbox.UpdateKeyboardEventManually(layer1.layerAlias, textboxInstance.controlAlias, []rune("f3"))
+	assert.Equalf(test, [4]int{0, 6, 4, 6}, getTextboxSearchTestHighlight(textboxEntry), "F3 did not repeat the search forwards!")
+
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("apple", true, false)), "A case sensitive match was not found!")
+	assert.Equalf(test, [4]int{7, 3, 11, 3}, getTextboxSearchTestHighlight(textboxEntry), "The case sensitive search matched the wrong case!")
+	assert.Truef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("[a-z]+berry", false, true)), "A regular expression match was not found!")
+	assert.Equalf(test, [4]int{0, 5, 9, 5}, getTextboxSearchTestHighlight(textboxEntry), "The regular expression did not match the whole word!")
+	assert.Falsef(test, isTextboxSearchTestMatchFound(textboxInstance.FindNext("kiwi", false, false)), "A match was reported for text which does not exist!")
+	assert.Equalf(test, [4]int{0, 5, 9, 5}, getTextboxSearchTestHighlight(textboxEntry), "A failed search changed the highlight!")
+	_, err := textboxInstance.FindNext("(", false, true)
+	assert.Errorf(test, err, "An invalid regular expression did not return an error!")
+	_, err = textboxInstance.ReplaceAll("(", "x", false, true)
+	assert.Errorf(test, err, "An invalid regular expression did not return an error when replacing!")
+	assert.Equalf(test, [4]int{0, 5, 9, 5}, getTextboxSearchTestHighlight(textboxEntry), "An invalid regular expression changed the highlight!")
+}
+
+/*
+TestTextboxFindPrompt is a test which verifies that the user can search a textbox by pressing ctrl+f, repeat the
+search with F3 and shift+F3, and is told when the search is an invalid regular expression.
+
+Example:
+
+	Expected Inputs:
+	    Ctrl+f pressed on a focused textbox and answered with some text, followed by F3 and shift+F3, and then F3 and
+	    ctrl+f pressed after an invalid regular expression was searched for.
+
+	Expected Outputs:
+	    The text typed into the prompt is found, F3 and shift+F3 move between matches, and an invalid regular
+	    expression shows a message box instead of searching.
+*/
+func TestTextboxFindPrompt(test *testing.T) {
+	screen := InitializeTerminalWithSimulationScreen(40, 20)
+	styleEntry := NewTuiStyleEntry()
+	layer1 := AddLayer(0, 0, 40, 20, 1, nil)
+	textboxInstance := layer1.AddTextbox(styleEntry, 1, 1, 30, 5, false)
+	textboxInstance.SetText("Apple pie\nbanana\ncherry apple")
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textboxInstance.controlAlias)
+	numberOfLayers := len(Layers.GetAllEntries())
+	SetFocus(&textboxInstance)
+
+	pressTextboxSearchTestKey(screen, tcell.KeyCtrlF, 0, tcell.ModCtrl)
+	assert.Truef(test, len(Layers.GetAllEntries()) > numberOfLayers, "Ctrl+f did not show the find prompt!")
+	for _, currentRune := range "apple" {
+		pressTextboxSearchTestKey(screen, tcell.KeyRune, currentRune, tcell.ModNone)
+	}
+	pressTextboxSearchTestKey(screen, tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, numberOfLayers, len(Layers.GetAllEntries()), "The find prompt was not closed once it was answered!")
+	assert.Equalf(test, [4]int{0, 1, 4, 1}, getTextboxSearchTestHighlight(textboxEntry), "The text typed into the find prompt was not found!")
+	pressTextboxSearchTestKey(screen, tcell.KeyF3, 0, tcell.ModNone)
+	assert.Equalf(test, [4]int{7, 3, 11, 3}, getTextboxSearchTestHighlight(textboxEntry), "F3 did not find the next match!")
+	pressTextboxSearchTestKey(screen, tcell.KeyF3, 0, tcell.ModShift)
+	assert.Equalf(test, [4]int{0, 1, 4, 1}, getTextboxSearchTestHighlight(textboxEntry), "Shift+F3 did not find the previous match!")
+
+	_, err := textboxInstance.FindNext("(", false, true)
+	assert.Errorf(test, err, "An invalid regular expression did not return an error!")
+	pressTextboxSearchTestKey(screen, tcell.KeyF3, 0, tcell.ModNone)
+	assert.Truef(test, len(Layers.GetAllEntries()) > numberOfLayers, "F3 did not report the invalid regular expression!")
+	pressTextboxSearchTestKey(screen, tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, numberOfLayers, len(Layers.GetAllEntries()), "The message box was not closed once it was dismissed!")
+	pressTextboxSearchTestKey(screen, tcell.KeyCtrlF, 0, tcell.ModCtrl)
+	pressTextboxSearchTestKey(screen, tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Truef(test, len(Layers.GetAllEntries()) > numberOfLayers, "The find prompt did not report the invalid regular expression!")
+	pressTextboxSearchTestKey(screen, tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, numberOfLayers, len(Layers.GetAllEntries()), "The message box was not closed once it was dismissed!")
+	assert.Equalf(test, [4]int{0, 1, 4, 1}, getTextboxSearchTestHighlight(textboxEntry), "An invalid regular expression changed the highlight!")
+}
+
+/*
+TestTextboxReplaceAll is a test which verifies that every match in a textbox can be replaced at once, and undone in
+a single step.
+
+Example:
+
+	Expected Inputs:
+	    A textbox whose text is replaced using plain text, a regular expression with a submatch, and replacements
+	    which insert new lines, followed by backspace at the start of a line which was split.
+
+	Expected Outputs:
+	    The number of replacements is returned, the text is updated, a single undo restores the original text, and
+	    the split lines are joined back together without losing a character.
+*/
+func TestTextboxReplaceAll(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textboxInstance := layer1.AddTextbox(styleEntry, 2, 2, 20, 4, false)
+	setFocusedControl(layer1.layerAlias, textboxInstance.controlAlias, constants.CellTypeTextbox)
+	textbox.UpdateKeyboardEventTextboxWithString("Colour the colour")
+	originalText := textboxInstance.GetText()
+
+	assert.Equalf(test, 2, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("colour", "color", false, false)), "The wrong number of replacements was reported!")
+	assert.Equalf(test, "color the color ", textboxInstance.GetText(), "The matches were not replaced!")
+	assert.Truef(test, textboxInstance.Undo(), "The replacements could not be undone!")
+	assert.Equalf(test, originalText, textboxInstance.GetText(), "The replacements were not undone in a single step!")
+
+	assert.Equalf(test, 1, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("colour", "$1x", true, false)), "The case sensitive replacement matched the wrong case!")
+	assert.Equalf(test, "Colour the $1x ", textboxInstance.GetText(), "A plain text replacement was not inserted exactly as given!")
+	textboxInstance.Undo()
+	assert.Equalf(test, 2, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("(c)olour", "${1}olor", false, true)), "The regular expression replacement was not made!")
+	assert.Equalf(test, "Color the color ", textboxInstance.GetText(), "The submatch was not used in the replacement!")
+	assert.Equalf(test, 1, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll(" the ", "\n", false, false)), "The line break replacement was not made!")
+	assert.Equalf(test, "Color \ncolor ", textboxInstance.GetText(), "The replacement did not split the line!")
+	assert.Equalf(test, 0, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("kiwi", "fruit", false, false)), "Replacements were reported for text which does not exist!")
+
+	textboxInstance = layer1.AddTextbox(styleEntry, 2, 8, 20, 4, false)
+	setFocusedControl(layer1.layerAlias, textboxInstance.controlAlias, constants.CellTypeTextbox)
+	textbox.UpdateKeyboardEventTextboxWithString("qxyz")
+	assert.Equalf(test, 1, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("y", "\n", false, false)), "The line break replacement was not made!")
+	assert.Equalf(test, "qx \nz ", textboxInstance.GetText(), "The lines produced by the replacement did not end with a space!")
+	textbox.UpdateKeyboardEvent([]rune("ctrl+home"))
+	textbox.UpdateKeyboardEvent([]rune("down"))
+	textbox.UpdateKeyboardEvent([]rune("home"))
+	textbox.UpdateKeyboardEvent([]rune("backspace"))
+	assert.Equalf(test, "qxz ", textboxInstance.GetText(), "Joining the lines produced by the replacement lost a character!")
+	assert.Equalf(test, 1, getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("qxz", "\n", false, false)), "The replacement emptying the line was not made!")
+	assert.Equalf(test, " \n ", textboxInstance.GetText(), "The lines emptied by the replacement were not left with a space!")
+}
+
+/*
+getTextboxSearchTestHighlight is a method which allows you to obtain the start of the highlight and the cursor
+location of a textbox, in the order start x, start y, cursor x, and cursor y.
+
+Example:
+
+	highlight := getTextboxSearchTestHighlight(textboxEntry)
+*/
+func getTextboxSearchTestHighlight(textboxEntry *types.TextboxEntryType) [4]int {
+	return [4]int{textboxEntry.HighlightStartX, textboxEntry.HighlightStartY, textboxEntry.CursorXLocation, textboxEntry.CursorYLocation}
+}
+
+/*
+isTextboxSearchTestMatchFound is a method which allows you to obtain whether a search made by the textbox search
+tests found a match without any error.
+
+Example:
+
+	isFound := isTextboxSearchTestMatchFound(textboxInstance.FindNext("hello", false, false))
+*/
+func isTextboxSearchTestMatchFound(isFound bool, err error) bool {
+	return isFound && err == nil
+}
+
+/*
+getTextboxSearchTestReplacements is a method which allows you to obtain the number of replacements made by the
+textbox search tests, or -1 if an error was returned.
+
+Example:
+
+	numberOfReplacements := getTextboxSearchTestReplacements(textboxInstance.ReplaceAll("a", "b", false, false))
+*/
+func getTextboxSearchTestReplacements(numberOfReplacements int, err error) int {
+	if err != nil {
+		return -1
+	}
+	return numberOfReplacements
+}
+
+/*
+pressTextboxSearchTestKey is a method which allows you to press a key on a simulation screen and dispatch it.
+
+Example:
+
+	pressTextboxSearchTestKey(screen, tcell.KeyF3, 0, tcell.ModNone)
+*/
+func pressTextboxSearchTestKey(screen tcell.SimulationScreen, key tcell.Key, character rune, modifiers tcell.ModMask) {
+	screen.InjectKey(key, character, modifiers)
+	UpdateEventQueues()
+}
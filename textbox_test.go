@@ -258,3 +258,53 @@ func TestTextboxHome(test *testing.T) {
 		fmt.Println("Obtained:\n", obtainedValueBase64)
 	}
 }
+
+/*
+TestTextboxUndoRedo is a test which verifies that edits made to a textbox can be undone and redone.
+
+Example:
+
+	Expected Inputs:
+	    Two words typed into a textbox, followed by a group of backspaces and a character typed over highlighted
+	    text, with ctrl+z and ctrl+y pressed in between.
+
+	Expected Outputs:
+	    Typing is undone a word at a time, consecutive backspaces are undone together, highlighted text which was
+	    typed over is restored in one step, redo reapplies each change, and keystrokes which change nothing are not
+	    recorded.
+*/
+func TestTextboxUndoRedo(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	textboxInstance := layer1.AddTextbox(styleEntry, 2, 2, 20, 4, false)
+	setFocusedControl(layer1.layerAlias, textboxInstance.controlAlias, constants.CellTypeTextbox)
+	textbox.UpdateKeyboardEventTextboxWithString("hello")
+	textbox.UpdateKeyboardEvent([]rune("enter"))
+	textbox.UpdateKeyboardEventTextboxWithString("big world")
+	assert.Equalf(test, "hello \nbig world ", textboxInstance.GetText(), "The text typed was not entered correctly!")
+	textbox.UpdateKeyboardEvent([]rune("ctrl+z"))
+	assert.Equalf(test, "hello \nbig  ", textboxInstance.GetText(), "Undo did not remove the last word typed!")
+	textbox.UpdateKeyboardEvent([]rune("ctrl+z"))
+	assert.Equalf(test, "hello \n ", textboxInstance.GetText(), "Undo did not remove the second last word typed!")
+	textbox.UpdateKeyboardEvent([]rune("ctrl+y"))
+	textbox.UpdateKeyboardEvent([]rune("ctrl+y"))
+	assert.Equalf(test, "hello \nbig world ", textboxInstance.GetText(), "Redo did not restore the words undone!")
+	assert.Falsef(test, textboxInstance.Redo(), "Redo reported success when there was nothing to redo!")
+
+	textbox.UpdateKeyboardEventTextboxWithCommands("backspace", "backspace", "backspace")
+	assert.Equalf(test, "hello \nbig wo ", textboxInstance.GetText(), "Backspace did not remove the expected characters!")
+	assert.Truef(test, textboxInstance.Undo(), "Undo reported failure when there was something to undo!")
+	assert.Equalf(test, "hello \nbig world ", textboxInstance.GetText(), "Consecutive backspaces were not undone together!")
+
+	textboxEntry := Textboxes.Get(layer1.layerAlias, textboxInstance.controlAlias)
+	textboxEntry.IsHighlightActive = true
+	textboxEntry.HighlightStartX, textboxEntry.HighlightStartY = 2, 0
+	textboxEntry.HighlightEndX, textboxEntry.HighlightEndY = 3, 1
+	textbox.UpdateKeyboardEvent([]rune("x"))
+	assert.NotEqualf(test, "hello \nbig world ", textboxInstance.GetText(), "The highlighted text was not replaced!")
+	textbox.UpdateKeyboardEvent([]rune("ctrl+z"))
+	assert.Equalf(test, "hello \nbig world ", textboxInstance.GetText(), "Undo did not restore the highlighted text which was replaced!")
+	textboxInstance.ClearUndoHistory()
+	assert.Falsef(test, textboxInstance.Undo(), "Undo reported success after the history was cleared!")
+	textbox.UpdateKeyboardEventTextboxWithCommands("ctrl+home", "backspace")
+	assert.Equalf(test, 0, len(textboxEntry.EditHistory.UndoEntries), "A backspace which changed nothing was recorded in the undo history!")
+}
@@ -0,0 +1,888 @@
+package consolizer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+themeFieldType is a structure which represents a single style value as it appears in a theme file.
+*/
+type themeFieldType struct {
+	name  string
+	value interface{}
+}
+
+/*
+themeSectionType is a structure which represents a group of style values, such as all button styles, as it appears
+in a theme file.
+*/
+type themeSectionType struct {
+	name   string
+	fields []themeFieldType
+}
+
+/*
+themeColorType is a variable which holds the reflected type of a color, so that colors can be told apart from other
+numeric style values.
+*/
+var themeColorType = reflect.TypeOf(constants.ColorType(0))
+
+/*
+themeRuneType is a variable which holds the reflected type of a rune, so that characters can be told apart from
+other numeric style values.
+*/
+var themeRuneType = reflect.TypeOf(rune(0))
+
+/*
+LoadTheme is a method which allows you to load a TUI style from a theme file, so that colors and characters can be
+changed without recompiling your application. In addition, the following should be noted:
+
+  - If a virtual file system is mounted, the theme will be read from it instead of your local file system.
+
+  - Files ending in '.toml' are read as TOML. All other files are read as JSON.
+
+  - Any style values missing from the theme file are left at the defaults provided by 'types.NewTuiStyleEntry'. This
+    means a theme only needs to specify the values it wishes to change.
+
+  - Colors are written as hex strings such as "#0000aa", or "default" for the terminal default color. A hex value
+    which matches one of the 16 standard ANSI colors is loaded as that ANSI color.
+
+- Characters are written as strings containing a single character.
+
+- If the theme cannot be read, or contains an unknown or invalid value, an error is returned.
+
+Example:
+
+	styleEntry, err := LoadTheme("themes/ocean.toml")
+*/
+func LoadTheme(fileName string) (types.TuiStyleEntryType, error) {
+	styleEntry := types.NewTuiStyleEntry()
+	themeData, err := getFileDataFromFileSystem(fileName)
+	if err != nil {
+		return styleEntry, err
+	}
+	var themeValues map[string]map[string]interface{}
+	if isThemeFileToml(fileName) {
+		themeValues, err = getThemeValuesFromToml(string(themeData))
+	} else {
+		err = json.Unmarshal(themeData, &themeValues)
+	}
+	if err != nil {
+		return styleEntry, errors.New(fmt.Sprintf("Could not decode the theme '%s': %s", fileName, err.Error()))
+	}
+	err = applyThemeValues(&styleEntry, themeValues)
+	if err != nil {
+		return styleEntry, errors.New(fmt.Sprintf("Could not load the theme '%s': %s", fileName, err.Error()))
+	}
+	return styleEntry, nil
+}
+
+/*
+SaveTheme is a method which allows you to save a TUI style to a theme file, so that it can be edited and loaded back
+later with 'LoadTheme'. In addition, the following should be noted:
+
+- Themes are always written to the local file system, since virtual file systems are read-only.
+
+- Files ending in '.toml' are written as TOML. All other files are written as JSON.
+
+- Every style value is written, in the same order as it is declared.
+
+- If the file already exists, it will be overwritten.
+
+Example:
+
+	err := SaveTheme("themes/ocean.json", styleEntry)
+*/
+func SaveTheme(fileName string, styleEntry types.TuiStyleEntryType) error {
+	themeSections := getThemeSections(styleEntry)
+	var themeData []byte
+	if isThemeFileToml(fileName) {
+		themeData = getThemeSectionsAsToml(themeSections)
+	} else {
+		themeData = getThemeSectionsAsJson(themeSections)
+	}
+	return writeFileDataToFileSystem(fileName, themeData, 0)
+}
+
+/*
+GetBundledTheme is a method which allows you to obtain one of the themes which are included with this library. In
+addition, the following should be noted:
+
+  - The themes available are 'constants.ThemeClassicDosBlue', 'constants.ThemeDark', and
+    'constants.ThemeHighContrast'.
+
+  - Bundled themes make a good starting point for your own themes. Simply save one with 'SaveTheme' and edit the
+    file produced.
+
+- If you specify a theme that does not exist, a panic will be generated to fail as fast as possible.
+
+Example:
+
+	styleEntry := GetBundledTheme(constants.ThemeClassicDosBlue)
+*/
+func GetBundledTheme(themeName string) types.TuiStyleEntryType {
+	switch themeName {
+	case constants.ThemeClassicDosBlue:
+		return getClassicDosBlueTheme()
+	case constants.ThemeDark:
+		return getDarkTheme()
+	case constants.ThemeHighContrast:
+		return getHighContrastTheme()
+	}
+	safeSttyPanic(fmt.Sprintf("The requested theme '%s' could not be returned since it does not exist.", themeName))
+	return types.NewTuiStyleEntry()
+}
+
+/*
+getClassicDosBlueTheme is a method which allows you to obtain a theme resembling classic DOS applications, with light
+text on a blue background.
+
+Example:
+
+	styleEntry := getClassicDosBlueTheme()
+*/
+func getClassicDosBlueTheme() types.TuiStyleEntryType {
+	blue := constants.TdfToRgbMap[1]
+	cyan := constants.TdfToRgbMap[3]
+	lightGray := constants.TdfToRgbMap[7]
+	darkGray := constants.TdfToRgbMap[8]
+	yellow := constants.TdfToRgbMap[14]
+	black := constants.AnsiColorByIndex[constants.ColorBlack]
+	white := constants.AnsiColorByIndex[constants.ColorBrightWhite]
+	styleEntry := types.NewTuiStyleEntry()
+	styleEntry.Text.ForegroundColor = lightGray
+	styleEntry.Text.BackgroundColor = blue
+	styleEntry.Frame.ForegroundColor = lightGray
+	styleEntry.Frame.BackgroundColor = blue
+	styleEntry.Label.ForegroundColor = lightGray
+	styleEntry.Label.BackgroundColor = blue
+	styleEntry.Checkbox.ForegroundColor = lightGray
+	styleEntry.Checkbox.BackgroundColor = blue
+	styleEntry.RadioButton.ForegroundColor = lightGray
+	styleEntry.RadioButton.BackgroundColor = blue
+	styleEntry.Scrollbar.ForegroundColor = cyan
+	styleEntry.Scrollbar.BackgroundColor = blue
+	styleEntry.Scrollbar.HandleColor = white
+	styleEntry.ProgressBar.UnfilledForegroundColor = blue
+	styleEntry.ProgressBar.UnfilledBackgroundColor = blue
+	styleEntry.ProgressBar.FilledForegroundColor = cyan
+	styleEntry.ProgressBar.FilledBackgroundColor = cyan
+	styleEntry.ProgressBar.TextForegroundColor = white
+	styleEntry.ProgressBar.TextBackgroundColor = blue
+	styleEntry.TextField.ForegroundColor = black
+	styleEntry.TextField.BackgroundColor = cyan
+	styleEntry.TextField.HighlightForegroundColor = white
+	styleEntry.TextField.HighlightBackgroundColor = black
+	styleEntry.TextField.CursorForegroundColor = cyan
+	styleEntry.TextField.CursorBackgroundColor = black
+	styleEntry.TextField.ErrorForegroundColor = white
+	styleEntry.TextField.ErrorBackgroundColor = constants.TdfToRgbMap[4]
+	styleEntry.Textbox.ForegroundColor = lightGray
+	styleEntry.Textbox.BackgroundColor = blue
+	styleEntry.Textbox.HighlightForegroundColor = blue
+	styleEntry.Textbox.HighlightBackgroundColor = lightGray
+	styleEntry.Textbox.CursorForegroundColor = blue
+	styleEntry.Textbox.CursorBackgroundColor = yellow
+	styleEntry.Textbox.SyntaxKeywordColor = white
+	styleEntry.Textbox.SyntaxStringColor = cyan
+	styleEntry.Textbox.SyntaxNumberColor = yellow
+	styleEntry.Textbox.SyntaxCommentColor = darkGray
+	styleEntry.Textbox.SyntaxKeyColor = yellow
+	styleEntry.Textbox.SyntaxVariableColor = cyan
+	styleEntry.Textbox.GutterForegroundColor = cyan
+	styleEntry.Textbox.GutterBackgroundColor = blue
+	styleEntry.Textbox.GutterCurrentLineColor = white
+	styleEntry.Textbox.ErrorMarkerColor = constants.TdfToRgbMap[12]
+	styleEntry.Textbox.WarningMarkerColor = yellow
+	styleEntry.Textbox.BookmarkMarkerColor = white
+	styleEntry.Selector.ForegroundColor = black
+	styleEntry.Selector.BackgroundColor = lightGray
+	styleEntry.Selector.HighlightForegroundColor = white
+	styleEntry.Selector.HighlightBackgroundColor = black
+	styleEntry.Button.RaisedColor = white
+	styleEntry.Button.ForegroundColor = black
+	styleEntry.Button.BackgroundColor = lightGray
+	styleEntry.Button.LabelDisabledColor = darkGray
+	styleEntry.Tooltip.ForegroundColor = black
+	styleEntry.Tooltip.BackgroundColor = cyan
+	styleEntry.Tooltip.TextForegroundColor = black
+	styleEntry.Tooltip.TextBackgroundColor = cyan
+	styleEntry.Window.LineDrawingTextForegroundColor = white
+	styleEntry.Window.LineDrawingTextBackgroundColor = blue
+	styleEntry.Window.LineDrawingTextLabelColor = yellow
+	styleEntry.Window.LineDrawingTextLabelForegroundColor = yellow
+	styleEntry.Window.LineDrawingTextLabelBackgroundColor = blue
+	styleEntry.Window.LineDrawingRaisedColor = white
+	styleEntry.Window.LineDrawingSunkenColor = darkGray
+	styleEntry.Bar.ForegroundColor = black
+	styleEntry.Bar.BackgroundColor = cyan
+	styleEntry.FileMenu.ForegroundColor = black
+	styleEntry.FileMenu.BackgroundColor = lightGray
+	styleEntry.FileMenu.HighlightForegroundColor = white
+	styleEntry.FileMenu.HighlightBackgroundColor = black
+	styleEntry.Dropdown.ForegroundColor = black
+	styleEntry.Dropdown.BackgroundColor = cyan
+	styleEntry.Slider.ForegroundColor = cyan
+	styleEntry.Slider.BackgroundColor = blue
+	styleEntry.Slider.FilledColor = cyan
+	styleEntry.Slider.HandleColor = white
+	styleEntry.Table.HeaderForegroundColor = white
+	styleEntry.Table.HeaderBackgroundColor = cyan
+	styleEntry.TabControl.ForegroundColor = lightGray
+	styleEntry.TabControl.BackgroundColor = blue
+	styleEntry.TabControl.SelectedForegroundColor = black
+	styleEntry.TabControl.SelectedBackgroundColor = cyan
+	styleEntry.Menu.DisabledForegroundColor = darkGray
+	return styleEntry
+}
+
+/*
+getDarkTheme is a method which allows you to obtain a theme with muted light text on dark gray backgrounds.
+
+Example:
+
+	styleEntry := getDarkTheme()
+*/
+func getDarkTheme() types.TuiStyleEntryType {
+	background := GetRGBColor(30, 30, 30)
+	panel := GetRGBColor(45, 45, 48)
+	border := GetRGBColor(80, 80, 80)
+	text := GetRGBColor(212, 212, 212)
+	mutedText := GetRGBColor(128, 128, 128)
+	accent := GetRGBColor(0, 122, 204)
+	highlightText := GetRGBColor(255, 255, 255)
+	styleEntry := types.NewTuiStyleEntry()
+	styleEntry.Text.ForegroundColor = text
+	styleEntry.Text.BackgroundColor = background
+	styleEntry.Frame.ForegroundColor = border
+	styleEntry.Frame.BackgroundColor = background
+	styleEntry.Label.ForegroundColor = text
+	styleEntry.Label.BackgroundColor = background
+	styleEntry.Checkbox.ForegroundColor = text
+	styleEntry.Checkbox.BackgroundColor = background
+	styleEntry.RadioButton.ForegroundColor = text
+	styleEntry.RadioButton.BackgroundColor = background
+	styleEntry.Scrollbar.ForegroundColor = border
+	styleEntry.Scrollbar.BackgroundColor = panel
+	styleEntry.Scrollbar.HandleColor = mutedText
+	styleEntry.ProgressBar.UnfilledForegroundColor = panel
+	styleEntry.ProgressBar.UnfilledBackgroundColor = panel
+	styleEntry.ProgressBar.FilledForegroundColor = accent
+	styleEntry.ProgressBar.FilledBackgroundColor = accent
+	styleEntry.ProgressBar.TextForegroundColor = highlightText
+	styleEntry.ProgressBar.TextBackgroundColor = panel
+	styleEntry.TextField.ForegroundColor = text
+	styleEntry.TextField.BackgroundColor = panel
+	styleEntry.TextField.HighlightForegroundColor = highlightText
+	styleEntry.TextField.HighlightBackgroundColor = accent
+	styleEntry.TextField.CursorForegroundColor = background
+	styleEntry.TextField.CursorBackgroundColor = text
+	styleEntry.TextField.ErrorForegroundColor = highlightText
+	styleEntry.TextField.ErrorBackgroundColor = GetRGBColor(161, 38, 38)
+	styleEntry.Textbox.ForegroundColor = text
+	styleEntry.Textbox.BackgroundColor = panel
+	styleEntry.Textbox.HighlightForegroundColor = highlightText
+	styleEntry.Textbox.HighlightBackgroundColor = accent
+	styleEntry.Textbox.CursorForegroundColor = background
+	styleEntry.Textbox.CursorBackgroundColor = text
+	styleEntry.Textbox.SyntaxKeywordColor = GetRGBColor(86, 156, 214)
+	styleEntry.Textbox.SyntaxStringColor = GetRGBColor(206, 145, 120)
+	styleEntry.Textbox.SyntaxNumberColor = GetRGBColor(181, 206, 168)
+	styleEntry.Textbox.SyntaxCommentColor = GetRGBColor(106, 153, 85)
+	styleEntry.Textbox.SyntaxKeyColor = GetRGBColor(156, 220, 254)
+	styleEntry.Textbox.SyntaxVariableColor = GetRGBColor(78, 201, 176)
+	styleEntry.Textbox.GutterForegroundColor = mutedText
+	styleEntry.Textbox.GutterBackgroundColor = background
+	styleEntry.Textbox.GutterCurrentLineColor = text
+	styleEntry.Textbox.ErrorMarkerColor = GetRGBColor(244, 71, 71)
+	styleEntry.Textbox.WarningMarkerColor = GetRGBColor(205, 173, 0)
+	styleEntry.Textbox.BookmarkMarkerColor = accent
+	styleEntry.Selector.ForegroundColor = text
+	styleEntry.Selector.BackgroundColor = panel
+	styleEntry.Selector.HighlightForegroundColor = highlightText
+	styleEntry.Selector.HighlightBackgroundColor = accent
+	styleEntry.Button.RaisedColor = border
+	styleEntry.Button.ForegroundColor = text
+	styleEntry.Button.BackgroundColor = panel
+	styleEntry.Button.LabelDisabledColor = mutedText
+	styleEntry.Tooltip.ForegroundColor = text
+	styleEntry.Tooltip.BackgroundColor = panel
+	styleEntry.Tooltip.TextForegroundColor = text
+	styleEntry.Tooltip.TextBackgroundColor = panel
+	styleEntry.Window.LineDrawingTextForegroundColor = border
+	styleEntry.Window.LineDrawingTextBackgroundColor = background
+	styleEntry.Window.LineDrawingTextLabelColor = text
+	styleEntry.Window.LineDrawingTextLabelForegroundColor = text
+	styleEntry.Window.LineDrawingTextLabelBackgroundColor = background
+	styleEntry.Window.LineDrawingRaisedColor = border
+	styleEntry.Window.LineDrawingSunkenColor = background
+	styleEntry.Bar.ForegroundColor = text
+	styleEntry.Bar.BackgroundColor = panel
+	styleEntry.FileMenu.ForegroundColor = text
+	styleEntry.FileMenu.BackgroundColor = panel
+	styleEntry.FileMenu.HighlightForegroundColor = highlightText
+	styleEntry.FileMenu.HighlightBackgroundColor = accent
+	styleEntry.Dropdown.ForegroundColor = text
+	styleEntry.Dropdown.BackgroundColor = panel
+	styleEntry.Slider.ForegroundColor = border
+	styleEntry.Slider.BackgroundColor = background
+	styleEntry.Slider.FilledColor = accent
+	styleEntry.Slider.HandleColor = highlightText
+	styleEntry.Table.HeaderForegroundColor = highlightText
+	styleEntry.Table.HeaderBackgroundColor = border
+	styleEntry.TabControl.ForegroundColor = mutedText
+	styleEntry.TabControl.BackgroundColor = background
+	styleEntry.TabControl.SelectedForegroundColor = highlightText
+	styleEntry.TabControl.SelectedBackgroundColor = accent
+	styleEntry.Menu.DisabledForegroundColor = mutedText
+	return styleEntry
+}
+
+/*
+getHighContrastTheme is a method which allows you to obtain a theme using only black, white, and bright yellow, for
+users who need as much contrast as possible.
+
+Example:
+
+	styleEntry := getHighContrastTheme()
+*/
+func getHighContrastTheme() types.TuiStyleEntryType {
+	black := constants.AnsiColorByIndex[constants.ColorBlack]
+	white := constants.AnsiColorByIndex[constants.ColorBrightWhite]
+	yellow := constants.AnsiColorByIndex[constants.ColorBrightYellow]
+	styleEntry := types.NewTuiStyleEntry()
+	styleEntry.Text.ForegroundColor = white
+	styleEntry.Text.BackgroundColor = black
+	styleEntry.Frame.ForegroundColor = white
+	styleEntry.Frame.BackgroundColor = black
+	styleEntry.Label.ForegroundColor = white
+	styleEntry.Label.BackgroundColor = black
+	styleEntry.Checkbox.ForegroundColor = white
+	styleEntry.Checkbox.BackgroundColor = black
+	styleEntry.RadioButton.ForegroundColor = white
+	styleEntry.RadioButton.BackgroundColor = black
+	styleEntry.Scrollbar.ForegroundColor = white
+	styleEntry.Scrollbar.BackgroundColor = black
+	styleEntry.Scrollbar.HandleColor = yellow
+	styleEntry.ProgressBar.UnfilledForegroundColor = black
+	styleEntry.ProgressBar.UnfilledBackgroundColor = black
+	styleEntry.ProgressBar.FilledForegroundColor = yellow
+	styleEntry.ProgressBar.FilledBackgroundColor = yellow
+	styleEntry.ProgressBar.TextForegroundColor = white
+	styleEntry.ProgressBar.TextBackgroundColor = black
+	styleEntry.TextField.ForegroundColor = white
+	styleEntry.TextField.BackgroundColor = black
+	styleEntry.TextField.HighlightForegroundColor = black
+	styleEntry.TextField.HighlightBackgroundColor = yellow
+	styleEntry.TextField.CursorForegroundColor = black
+	styleEntry.TextField.CursorBackgroundColor = white
+	styleEntry.TextField.ErrorForegroundColor = white
+	styleEntry.TextField.ErrorBackgroundColor = constants.AnsiColorByIndex[constants.ColorRed]
+	styleEntry.Textbox.ForegroundColor = white
+	styleEntry.Textbox.BackgroundColor = black
+	styleEntry.Textbox.HighlightForegroundColor = black
+	styleEntry.Textbox.HighlightBackgroundColor = yellow
+	styleEntry.Textbox.CursorForegroundColor = black
+	styleEntry.Textbox.CursorBackgroundColor = white
+	styleEntry.Textbox.SyntaxKeywordColor = yellow
+	styleEntry.Textbox.SyntaxStringColor = constants.AnsiColorByIndex[constants.ColorBrightGreen]
+	styleEntry.Textbox.SyntaxNumberColor = constants.AnsiColorByIndex[constants.ColorBrightCyan]
+	styleEntry.Textbox.SyntaxCommentColor = constants.AnsiColorByIndex[constants.ColorWhite]
+	styleEntry.Textbox.SyntaxKeyColor = constants.AnsiColorByIndex[constants.ColorBrightMagenta]
+	styleEntry.Textbox.SyntaxVariableColor = constants.AnsiColorByIndex[constants.ColorBrightCyan]
+	styleEntry.Textbox.GutterForegroundColor = white
+	styleEntry.Textbox.GutterBackgroundColor = black
+	styleEntry.Textbox.GutterCurrentLineColor = yellow
+	styleEntry.Textbox.ErrorMarkerColor = constants.AnsiColorByIndex[constants.ColorBrightRed]
+	styleEntry.Textbox.WarningMarkerColor = yellow
+	styleEntry.Textbox.BookmarkMarkerColor = constants.AnsiColorByIndex[constants.ColorBrightCyan]
+	styleEntry.Selector.ForegroundColor = white
+	styleEntry.Selector.BackgroundColor = black
+	styleEntry.Selector.HighlightForegroundColor = black
+	styleEntry.Selector.HighlightBackgroundColor = yellow
+	styleEntry.Button.RaisedColor = white
+	styleEntry.Button.ForegroundColor = black
+	styleEntry.Button.BackgroundColor = white
+	styleEntry.Button.LabelDisabledColor = black
+	styleEntry.Tooltip.ForegroundColor = black
+	styleEntry.Tooltip.BackgroundColor = yellow
+	styleEntry.Tooltip.TextForegroundColor = black
+	styleEntry.Tooltip.TextBackgroundColor = yellow
+	styleEntry.Window.LineDrawingTextForegroundColor = white
+	styleEntry.Window.LineDrawingTextBackgroundColor = black
+	styleEntry.Window.LineDrawingTextLabelColor = yellow
+	styleEntry.Window.LineDrawingTextLabelForegroundColor = yellow
+	styleEntry.Window.LineDrawingTextLabelBackgroundColor = black
+	styleEntry.Window.LineDrawingRaisedColor = white
+	styleEntry.Window.LineDrawingSunkenColor = white
+	styleEntry.Bar.ForegroundColor = black
+	styleEntry.Bar.BackgroundColor = white
+	styleEntry.FileMenu.ForegroundColor = white
+	styleEntry.FileMenu.BackgroundColor = black
+	styleEntry.FileMenu.HighlightForegroundColor = black
+	styleEntry.FileMenu.HighlightBackgroundColor = yellow
+	styleEntry.Dropdown.ForegroundColor = white
+	styleEntry.Dropdown.BackgroundColor = black
+	styleEntry.Slider.ForegroundColor = white
+	styleEntry.Slider.BackgroundColor = black
+	styleEntry.Slider.FilledColor = yellow
+	styleEntry.Slider.HandleColor = yellow
+	styleEntry.Table.HeaderForegroundColor = black
+	styleEntry.Table.HeaderBackgroundColor = white
+	styleEntry.TabControl.ForegroundColor = white
+	styleEntry.TabControl.BackgroundColor = black
+	styleEntry.TabControl.SelectedForegroundColor = black
+	styleEntry.TabControl.SelectedBackgroundColor = yellow
+	styleEntry.Menu.DisabledForegroundColor = constants.AnsiColorByIndex[constants.ColorBrightBlack]
+	return styleEntry
+}
+
+/*
+isThemeFileToml is a method which allows you to detect if a theme file should be read or written as TOML, based on
+its file extension.
+
+Example:
+
+	isToml := isThemeFileToml("theme.toml")
+*/
+func isThemeFileToml(fileName string) bool {
+	return strings.ToLower(filepath.Ext(fileName)) == ".toml"
+}
+
+/*
+getThemeSections is a method which allows you to convert every value of a TUI style into the sections written to a
+theme file. In addition, the following should be noted:
+
+- Colors are converted to hex strings, and characters are converted to single character strings.
+
+Example:
+
+	themeSections := getThemeSections(styleEntry)
+*/
+func getThemeSections(styleEntry types.TuiStyleEntryType) []themeSectionType {
+	var themeSections []themeSectionType
+	styleValue := reflect.ValueOf(styleEntry)
+	for currentSectionIndex := 0; currentSectionIndex < styleValue.NumField(); currentSectionIndex++ {
+		sectionValue := styleValue.Field(currentSectionIndex)
+		themeSection := themeSectionType{name: styleValue.Type().Field(currentSectionIndex).Name}
+		for currentFieldIndex := 0; currentFieldIndex < sectionValue.NumField(); currentFieldIndex++ {
+			fieldValue := sectionValue.Field(currentFieldIndex)
+			themeField := themeFieldType{name: sectionValue.Type().Field(currentFieldIndex).Name}
+			switch {
+			case fieldValue.Type() == themeColorType:
+				themeField.value = getThemeColorAsString(constants.ColorType(fieldValue.Uint()))
+			case fieldValue.Type() == themeRuneType:
+				themeField.value = getThemeRuneAsString(rune(fieldValue.Int()))
+			case fieldValue.Kind() == reflect.Bool:
+				themeField.value = fieldValue.Bool()
+			default:
+				themeField.value = fieldValue.Int()
+			}
+			themeSection.fields = append(themeSection.fields, themeField)
+		}
+		themeSections = append(themeSections, themeSection)
+	}
+	return themeSections
+}
+
+/*
+applyThemeValues is a method which allows you to copy values read from a theme file into a TUI style. In addition,
+the following should be noted:
+
+  - If a section or value is not part of the TUI style, or a value is of the wrong type, an error is returned so that
+    typing mistakes in theme files are not silently ignored.
+
+Example:
+
+	err := applyThemeValues(&styleEntry, themeValues)
+*/
+func applyThemeValues(styleEntry *types.TuiStyleEntryType, themeValues map[string]map[string]interface{}) error {
+	styleValue := reflect.ValueOf(styleEntry).Elem()
+	for sectionName, sectionValues := range themeValues {
+		sectionValue := styleValue.FieldByName(sectionName)
+		if !sectionValue.IsValid() {
+			return errors.New(fmt.Sprintf("The section '%s' is not a valid style section.", sectionName))
+		}
+		for fieldName, themeValue := range sectionValues {
+			fieldValue := sectionValue.FieldByName(fieldName)
+			if !fieldValue.IsValid() {
+				return errors.New(fmt.Sprintf("The value '%s.%s' is not a valid style value.", sectionName, fieldName))
+			}
+			if err := applyThemeValue(fieldValue, themeValue); err != nil {
+				return errors.New(fmt.Sprintf("The value '%s.%s' is invalid: %s", sectionName, fieldName, err.Error()))
+			}
+		}
+	}
+	return nil
+}
+
+/*
+applyThemeValue is a method which allows you to store a single value read from a theme file into the style field
+provided.
+
+Example:
+
+	err := applyThemeValue(fieldValue, "#0000aa")
+*/
+func applyThemeValue(fieldValue reflect.Value, themeValue interface{}) error {
+	switch {
+	case fieldValue.Type() == themeColorType:
+		colorAsString, isString := themeValue.(string)
+		if !isString {
+			return errors.New("a color must be a hex string")
+		}
+		color, err := getThemeColorFromString(colorAsString)
+		if err != nil {
+			return err
+		}
+		fieldValue.SetUint(uint64(color))
+	case fieldValue.Type() == themeRuneType:
+		runeAsString, isString := themeValue.(string)
+		if !isString || utf8.RuneCountInString(runeAsString) > 1 {
+			return errors.New("a character must be a string containing a single character")
+		}
+		characterValue, _ := utf8.DecodeRuneInString(runeAsString)
+		if runeAsString == "" {
+			characterValue = constants.NullRune
+		}
+		fieldValue.SetInt(int64(characterValue))
+	case fieldValue.Kind() == reflect.Bool:
+		boolValue, isBool := themeValue.(bool)
+		if !isBool {
+			return errors.New("a true or false value is required")
+		}
+		fieldValue.SetBool(boolValue)
+	default:
+		switch numericValue := themeValue.(type) {
+		case int64:
+			fieldValue.SetInt(numericValue)
+		case float64:
+			if numericValue != float64(int64(numericValue)) {
+				return errors.New("a whole number is required")
+			}
+			fieldValue.SetInt(int64(numericValue))
+		default:
+			return errors.New("a whole number is required")
+		}
+	}
+	return nil
+}
+
+/*
+getThemeColorAsString is a method which allows you to convert a color into the hex string written to theme files.
+
+Example:
+
+	colorAsString := getThemeColorAsString(GetRGBColor(0, 0, 170))
+*/
+func getThemeColorAsString(color constants.ColorType) string {
+	if color == 0 {
+		return constants.ThemeColorDefault
+	}
+	redColorIndex, greenColorIndex, blueColorIndex := GetRGBColorComponents(color)
+	return fmt.Sprintf("#%02x%02x%02x", redColorIndex, greenColorIndex, blueColorIndex)
+}
+
+/*
+getThemeColorFromString is a method which allows you to convert a hex string read from a theme file into a color. In
+addition, the following should be noted:
+
+- The leading '#' is optional.
+
+- If the color matches one of the 16 standard ANSI colors, that ANSI color is returned instead of an RGB color.
+
+Example:
+
+	color, err := getThemeColorFromString("#0000aa")
+*/
+func getThemeColorFromString(colorAsString string) (constants.ColorType, error) {
+	colorAsString = strings.ToLower(strings.TrimSpace(colorAsString))
+	if colorAsString == constants.ThemeColorDefault {
+		return 0, nil
+	}
+	hexValue := strings.TrimPrefix(colorAsString, "#")
+	colorValue, err := strconv.ParseUint(hexValue, 16, 32)
+	if len(hexValue) != 6 || err != nil {
+		return 0, errors.New(fmt.Sprintf("the color '%s' is not a valid hex color", colorAsString))
+	}
+	for currentColorIndex := constants.ColorBlack; currentColorIndex <= constants.ColorBrightWhite; currentColorIndex++ {
+		ansiColor := constants.AnsiColorByIndex[currentColorIndex]
+		if getThemeColorAsString(ansiColor) == "#"+hexValue {
+			return ansiColor, nil
+		}
+	}
+	return GetRGBColor(int32(colorValue>>16&0xff), int32(colorValue>>8&0xff), int32(colorValue&0xff)), nil
+}
+
+/*
+getThemeRuneAsString is a method which allows you to convert a character into the string written to theme files.
+
+Example:
+
+	runeAsString := getThemeRuneAsString(constants.CharBlockSolid)
+*/
+func getThemeRuneAsString(characterValue rune) string {
+	if characterValue == constants.NullRune {
+		return ""
+	}
+	return string(characterValue)
+}
+
+/*
+getThemeSectionsAsJson is a method which allows you to write theme sections as JSON. In addition, the following
+should be noted:
+
+- Sections and values are written in the order provided, rather than sorted, so the file is easier to edit.
+
+Example:
+
+	themeData := getThemeSectionsAsJson(themeSections)
+*/
+func getThemeSectionsAsJson(themeSections []themeSectionType) []byte {
+	var themeData bytes.Buffer
+	themeData.WriteString("{\n")
+	for currentSectionIndex, currentSection := range themeSections {
+		themeData.WriteString(fmt.Sprintf("\t%s: {\n", getThemeValueAsJson(currentSection.name)))
+		for currentFieldIndex, currentField := range currentSection.fields {
+			themeData.WriteString(fmt.Sprintf("\t\t%s: %s", getThemeValueAsJson(currentField.name), getThemeValueAsJson(currentField.value)))
+			if currentFieldIndex < len(currentSection.fields)-1 {
+				themeData.WriteString(",")
+			}
+			themeData.WriteString("\n")
+		}
+		themeData.WriteString("\t}")
+		if currentSectionIndex < len(themeSections)-1 {
+			themeData.WriteString(",")
+		}
+		themeData.WriteString("\n")
+	}
+	themeData.WriteString("}\n")
+	return themeData.Bytes()
+}
+
+/*
+getThemeValueAsJson is a method which allows you to encode a single theme value as JSON.
+
+Example:
+
+	valueAsJson := getThemeValueAsJson("#0000aa")
+*/
+func getThemeValueAsJson(themeValue interface{}) string {
+	j, err := json.Marshal(themeValue)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+getThemeSectionsAsToml is a method which allows you to write theme sections as TOML, with one table per section.
+
+Example:
+
+	themeData := getThemeSectionsAsToml(themeSections)
+*/
+func getThemeSectionsAsToml(themeSections []themeSectionType) []byte {
+	var themeData bytes.Buffer
+	for currentSectionIndex, currentSection := range themeSections {
+		if currentSectionIndex > 0 {
+			themeData.WriteString("\n")
+		}
+		themeData.WriteString(fmt.Sprintf("[%s]\n", currentSection.name))
+		for _, currentField := range currentSection.fields {
+			switch fieldValue := currentField.value.(type) {
+			case string:
+				themeData.WriteString(fmt.Sprintf("%s = %s\n", currentField.name, getTomlQuotedString(fieldValue)))
+			default:
+				themeData.WriteString(fmt.Sprintf("%s = %v\n", currentField.name, fieldValue))
+			}
+		}
+	}
+	return themeData.Bytes()
+}
+
+/*
+getTomlQuotedString is a method which allows you to quote a string so that it can be written as a TOML basic string.
+
+Example:
+
+	quotedString := getTomlQuotedString("say \"hi\"")
+*/
+func getTomlQuotedString(stringToQuote string) string {
+	var quotedString strings.Builder
+	quotedString.WriteString("\"")
+	for _, currentCharacter := range stringToQuote {
+		switch {
+		case currentCharacter == '"' || currentCharacter == '\\':
+			quotedString.WriteRune('\\')
+			quotedString.WriteRune(currentCharacter)
+		case currentCharacter < 0x20 || currentCharacter == 0x7f:
+			quotedString.WriteString(fmt.Sprintf("\\u%04X", currentCharacter))
+		default:
+			quotedString.WriteRune(currentCharacter)
+		}
+	}
+	quotedString.WriteString("\"")
+	return quotedString.String()
+}
+
+/*
+getThemeValuesFromToml is a method which allows you to read theme values from TOML text. In addition, the following
+should be noted:
+
+  - Only the subset of TOML needed by theme files is supported. That is, tables containing bare keys with string,
+    integer, or boolean values, along with comments and blank lines.
+
+- If a line cannot be understood, an error is returned which includes the line number.
+
+Example:
+
+	themeValues, err := getThemeValuesFromToml(themeText)
+*/
+func getThemeValuesFromToml(themeText string) (map[string]map[string]interface{}, error) {
+	themeValues := make(map[string]map[string]interface{})
+	currentSectionName := ""
+	for currentLineIndex, currentLine := range strings.Split(themeText, "\n") {
+		currentLine = strings.TrimSpace(currentLine)
+		if currentLine == "" || strings.HasPrefix(currentLine, "#") {
+			continue
+		}
+		if strings.HasPrefix(currentLine, "[") {
+			closingIndex := strings.Index(currentLine, "]")
+			if closingIndex == -1 || !isTomlRemainderEmpty(currentLine[closingIndex+1:]) {
+				return nil, errors.New(fmt.Sprintf("line %d has an invalid table header", currentLineIndex+1))
+			}
+			currentSectionName = strings.TrimSpace(currentLine[1:closingIndex])
+			if _, isExists := themeValues[currentSectionName]; !isExists {
+				themeValues[currentSectionName] = make(map[string]interface{})
+			}
+			continue
+		}
+		separatorIndex := strings.Index(currentLine, "=")
+		if separatorIndex == -1 || currentSectionName == "" {
+			return nil, errors.New(fmt.Sprintf("line %d is not a key and value within a table", currentLineIndex+1))
+		}
+		key := strings.TrimSpace(currentLine[:separatorIndex])
+		value, err := getTomlValue(strings.TrimSpace(currentLine[separatorIndex+1:]))
+		if key == "" || err != nil {
+			return nil, errors.New(fmt.Sprintf("line %d has an invalid value for '%s'", currentLineIndex+1, key))
+		}
+		themeValues[currentSectionName][key] = value
+	}
+	return themeValues, nil
+}
+
+/*
+getTomlValue is a method which allows you to decode a single TOML string, integer, or boolean value, ignoring any
+comment which follows it.
+
+Example:
+
+	value, err := getTomlValue("\"#0000aa\" # Blue")
+*/
+func getTomlValue(valueText string) (interface{}, error) {
+	if strings.HasPrefix(valueText, "'") {
+		closingIndex := strings.Index(valueText[1:], "'")
+		if closingIndex == -1 || !isTomlRemainderEmpty(valueText[closingIndex+2:]) {
+			return nil, errors.New("unterminated literal string")
+		}
+		return valueText[1 : closingIndex+1], nil
+	}
+	if strings.HasPrefix(valueText, "\"") {
+		return getTomlBasicString(valueText)
+	}
+	if commentIndex := strings.Index(valueText, "#"); commentIndex != -1 {
+		valueText = strings.TrimSpace(valueText[:commentIndex])
+	}
+	switch valueText {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return strconv.ParseInt(strings.ReplaceAll(valueText, "_", ""), 10, 64)
+}
+
+/*
+getTomlBasicString is a method which allows you to decode a double quoted TOML string, including any escape
+sequences it contains.
+
+Example:
+
+	value, err := getTomlBasicString("\"\\u2588\"")
+*/
+func getTomlBasicString(valueText string) (string, error) {
+	var decodedString strings.Builder
+	valueRunes := []rune(valueText)
+	for currentIndex := 1; currentIndex < len(valueRunes); currentIndex++ {
+		currentCharacter := valueRunes[currentIndex]
+		if currentCharacter == '"' {
+			if !isTomlRemainderEmpty(string(valueRunes[currentIndex+1:])) {
+				return "", errors.New("unexpected text after string")
+			}
+			return decodedString.String(), nil
+		}
+		if currentCharacter != '\\' {
+			decodedString.WriteRune(currentCharacter)
+			continue
+		}
+		currentIndex++
+		if currentIndex >= len(valueRunes) {
+			break
+		}
+		switch valueRunes[currentIndex] {
+		case 'b':
+			decodedString.WriteRune('\b')
+		case 't':
+			decodedString.WriteRune('\t')
+		case 'n':
+			decodedString.WriteRune('\n')
+		case 'f':
+			decodedString.WriteRune('\f')
+		case 'r':
+			decodedString.WriteRune('\r')
+		case '"', '\\':
+			decodedString.WriteRune(valueRunes[currentIndex])
+		case 'u', 'U':
+			numberOfDigits := 4
+			if valueRunes[currentIndex] == 'U' {
+				numberOfDigits = 8
+			}
+			if currentIndex+numberOfDigits >= len(valueRunes) {
+				return "", errors.New("incomplete unicode escape")
+			}
+			codePoint, err := strconv.ParseUint(string(valueRunes[currentIndex+1:currentIndex+1+numberOfDigits]), 16, 32)
+			if err != nil {
+				return "", errors.New("invalid unicode escape")
+			}
+			decodedString.WriteRune(rune(codePoint))
+			currentIndex += numberOfDigits
+		default:
+			return "", errors.New("invalid escape sequence")
+		}
+	}
+	return "", errors.New("unterminated string")
+}
+
+/*
+isTomlRemainderEmpty is a method which allows you to detect if the text following a TOML value is empty or only a
+comment.
+
+Example:
+
+	isEmpty := isTomlRemainderEmpty("  # comment")
+*/
+func isTomlRemainderEmpty(remainingText string) bool {
+	remainingText = strings.TrimSpace(remainingText)
+	return remainingText == "" || strings.HasPrefix(remainingText, "#")
+}
@@ -0,0 +1,87 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"os"
+	"testing"
+)
+
+/*
+TestThemeSaveAndLoad is a test which verifies that TUI styles survive being saved to and loaded from theme files in
+both supported formats.
+
+Example:
+
+	Expected Inputs:
+	    The default style and every bundled theme, saved as both JSON and TOML.
+
+	Expected Outputs:
+	    The default style is loaded back exactly, and every bundled theme is loaded back with the same colors and
+	    characters it was saved with.
+*/
+func TestThemeSaveAndLoad(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	for _, currentExtension := range []string{".json", ".toml"} {
+		themeFileName := os.TempDir() + "/consolizer_theme" + currentExtension
+		defaultStyleEntry := types.NewTuiStyleEntry()
+		assert.NoErrorf(test, SaveTheme(themeFileName, defaultStyleEntry), "The default theme could not be saved as '%s'!", currentExtension)
+		obtainedStyleEntry, err := LoadTheme(themeFileName)
+		assert.NoErrorf(test, err, "The default theme could not be loaded as '%s'!", currentExtension)
+		assert.Equalf(test, defaultStyleEntry, obtainedStyleEntry, "The default theme did not survive being saved as '%s'!", currentExtension)
+		for _, currentThemeName := range []string{constants.ThemeClassicDosBlue, constants.ThemeDark, constants.ThemeHighContrast} {
+			bundledStyleEntry := GetBundledTheme(currentThemeName)
+			assert.NoErrorf(test, SaveTheme(themeFileName, bundledStyleEntry), "The theme '%s' could not be saved!", currentThemeName)
+			obtainedStyleEntry, err = LoadTheme(themeFileName)
+			assert.NoErrorf(test, err, "The theme '%s' could not be loaded!", currentThemeName)
+			assert.Equalf(test, getThemeSections(bundledStyleEntry), getThemeSections(obtainedStyleEntry), "The theme '%s' did not survive being saved as '%s'!", currentThemeName, currentExtension)
+		}
+		os.Remove(themeFileName)
+	}
+	assert.Panicsf(test, func() { GetBundledTheme("missing") }, "Requesting a theme which does not exist should panic!")
+}
+
+/*
+TestThemeLoadPartialAndInvalid is a test which verifies that hand written theme files only need to specify the
+values they change, and that mistakes in them are reported.
+
+Example:
+
+	Expected Inputs:
+	    A TOML theme with comments, escapes, and a few values, followed by themes with unknown or invalid values.
+
+	Expected Outputs:
+	    Values specified are applied over the defaults, while unknown or invalid values produce an error.
+*/
+func TestThemeLoadPartialAndInvalid(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	themeFileName := os.TempDir() + "/consolizer_partial_theme.toml"
+	themeText := "# Partial theme\n[Button]\nBackgroundColor = \"#0000AA\" # Blue\nForegroundColor = \"#000080\"\n\n[Scrollbar]\nHandle = \"\\u2588\"\nTrackPattern = '.'\n\n[Selector]\nTextAlignment = 2\nIsShadowDrawn = true\n"
+	assert.NoErrorf(test, os.WriteFile(themeFileName, []byte(themeText), 0644), "The theme file could not be written!")
+	styleEntry, err := LoadTheme(themeFileName)
+	assert.NoErrorf(test, err, "The partial theme could not be loaded!")
+	expectedStyleEntry := types.NewTuiStyleEntry()
+	expectedStyleEntry.Button.BackgroundColor = GetRGBColor(0, 0, 170)
+	expectedStyleEntry.Button.ForegroundColor = GetColor(constants.ColorBlue)
+	expectedStyleEntry.Scrollbar.Handle = constants.CharBlockSolid
+	expectedStyleEntry.Scrollbar.TrackPattern = '.'
+	expectedStyleEntry.Selector.TextAlignment = constants.AlignmentCenter
+	expectedStyleEntry.Selector.IsShadowDrawn = true
+	assert.Equalf(test, expectedStyleEntry, styleEntry, "The partial theme was not applied over the default values!")
+
+	invalidThemes := []string{
+		"[Buttons]\nBackgroundColor = \"#000000\"\n",
+		"[Button]\nBackgroundColour = \"#000000\"\n",
+		"[Button]\nBackgroundColor = \"blue\"\n",
+		"[Scrollbar]\nHandle = \"ab\"\n",
+		"[Selector]\nTextAlignment = \"left\"\n",
+		"BackgroundColor = \"#000000\"\n",
+	}
+	for currentIndex, currentThemeText := range invalidThemes {
+		assert.NoErrorf(test, os.WriteFile(themeFileName, []byte(currentThemeText), 0644), "The theme file could not be written!")
+		_, err = LoadTheme(themeFileName)
+		assert.Errorf(test, err, "The invalid theme %d did not produce an error!", currentIndex)
+	}
+	os.Remove(themeFileName)
+}
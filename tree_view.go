@@ -0,0 +1,927 @@
+package consolizer
+
+import (
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/memory"
+	"github.com/supercom32/consolizer/stringformat"
+	"github.com/supercom32/consolizer/types"
+)
+
+/*
+TreeViewInstanceType is a structure which represents an instance of a tree view control.
+
+Example:
+
+	var treeViewInstance TreeViewInstanceType
+*/
+type TreeViewInstanceType struct {
+	BaseControlInstanceType
+}
+
+type treeViewType struct{}
+
+/*
+TreeViewChildProviderType is a type which represents a callback that returns the children of a tree view node. It is
+called the first time a node which is expandable but has no children loaded is expanded.
+*/
+type TreeViewChildProviderType func(nodeAlias string) []types.TreeNodeEntryType
+
+/*
+treeViewChildProviderEntryType is a structure which holds the child provider assigned to a tree view.
+*/
+type treeViewChildProviderEntryType struct {
+	provider TreeViewChildProviderType
+}
+
+/*
+treeViewRowType is a structure which represents a node of a tree view as it appears on screen. The isLastSibling
+slice holds, for the node and each of its ancestors, whether it is the last child of its parent. This decides which
+indentation guides are drawn to its left.
+*/
+type treeViewRowType struct {
+	node          *types.TreeNodeEntryType
+	depth         int
+	isLastSibling []bool
+}
+
+var TreeView treeViewType
+
+var TreeViews = memory.NewControlMemoryManager[types.TreeViewEntryType]()
+
+/*
+treeViewChildProviders is a variable which holds the child provider of every tree view which has one assigned,
+grouped by layer.
+*/
+var treeViewChildProviders = memory.NewControlMemoryManager[treeViewChildProviderEntryType]()
+
+/*
+Delete is a method which removes a tree view instance, along with its scroll bar.
+
+Example:
+
+	treeView.Delete()
+*/
+func (shared *TreeViewInstanceType) Delete() *TreeViewInstanceType {
+	shared.BaseControlInstanceType.Delete()
+	return nil
+}
+
+/*
+AddToTabIndex is a method which adds the tree view to the tab index of its associated layer. When the tree view
+receives focus, the user can move through its nodes with the arrow keys.
+
+Example:
+
+	treeView.AddToTabIndex()
+*/
+func (shared *TreeViewInstanceType) AddToTabIndex() {
+	addTabIndex(shared.layerAlias, shared.controlAlias, constants.CellTypeTreeView)
+}
+
+/*
+AddNode is a method which allows you to add a node to a tree view. If the tree view instance no longer exists, then
+no operation takes place. In addition, the following should be noted:
+
+- A parent alias of "" adds the node at the top level of the tree.
+
+  - An expandable node shows an expand glyph even if it has no children yet. Its children are requested from the
+    child provider the first time it is expanded.
+
+  - Adding a child to a node marks that node as loaded, so the child provider is not asked for its children. Use
+    ReloadNode to discard them and ask again.
+
+  - If the parent node does not exist, or a node with the same alias is already in the tree, a panic will be
+    generated.
+
+Example:
+
+	treeView.AddNode("", "documents", "Documents", true)
+	treeView.AddNode("documents", "report", "report.txt", false)
+*/
+func (shared *TreeViewInstanceType) AddNode(parentAlias string, nodeAlias string, label string, isExpandable bool) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		treeViewEntry := TreeViews.Get(shared.layerAlias, shared.controlAlias)
+		validateTreeNodeAlias(treeViewEntry, nodeAlias)
+		validateTreeNodeParent(treeViewEntry, parentAlias)
+		node := &types.TreeNodeEntryType{Alias: nodeAlias, Label: label, IsExpandable: isExpandable}
+		if parentAlias == "" {
+			treeViewEntry.Nodes = append(treeViewEntry.Nodes, node)
+		} else {
+			parentNode, _ := TreeView.findNode(treeViewEntry.Nodes, nil, parentAlias)
+			parentNode.Children = append(parentNode.Children, node)
+			parentNode.IsExpandable = true
+			parentNode.IsLoaded = true
+		}
+		TreeView.updateAfterNodeChange(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+RemoveNode is a method which allows you to remove a node from a tree view, along with all of its children. If the
+tree view instance no longer exists, or the node cannot be found, then no operation takes place. In addition, the
+following should be noted:
+
+- If the selected node is removed, the tree view is left with no node selected.
+
+Example:
+
+	treeView.RemoveNode("report")
+*/
+func (shared *TreeViewInstanceType) RemoveNode(nodeAlias string) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		treeViewEntry := TreeViews.Get(shared.layerAlias, shared.controlAlias)
+		node, parentNode := TreeView.findNode(treeViewEntry.Nodes, nil, nodeAlias)
+		if node == nil {
+			return shared
+		}
+		if parentNode == nil {
+			treeViewEntry.Nodes = TreeView.removeNodeFromList(treeViewEntry.Nodes, node)
+		} else {
+			parentNode.Children = TreeView.removeNodeFromList(parentNode.Children, node)
+		}
+		TreeView.updateAfterNodeChange(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+ClearNodes is a method which allows you to remove every node from a tree view. If the tree view instance no longer
+exists, then no operation takes place.
+
+Example:
+
+	treeView.ClearNodes()
+*/
+func (shared *TreeViewInstanceType) ClearNodes() *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		treeViewEntry := TreeViews.Get(shared.layerAlias, shared.controlAlias)
+		treeViewEntry.Nodes = nil
+		TreeView.updateAfterNodeChange(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+SetChildProvider is a method which allows you to load the children of nodes only when they are first expanded, so
+that large or expensive hierarchies do not have to be built up front. If the tree view instance no longer exists,
+then no operation takes place. In addition, the following should be noted:
+
+  - The provider is given the alias of the node being expanded, and should return its children. If it returns none,
+    the node is no longer shown as expandable.
+
+- Registering a new provider replaces any provider registered previously. Passing in nil removes it.
+
+Example:
+
+	treeView.SetChildProvider(func(nodeAlias string) []types.TreeNodeEntryType {
+		return listDirectory(nodeAlias)
+	})
+*/
+func (shared *TreeViewInstanceType) SetChildProvider(provider TreeViewChildProviderType) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		deleteTreeViewChildProvider(shared.layerAlias, shared.controlAlias)
+		if provider != nil {
+			providerEntry := treeViewChildProviderEntryType{provider: provider}
+			treeViewChildProviders.Add(shared.layerAlias, shared.controlAlias, &providerEntry)
+		}
+	}
+	return shared
+}
+
+/*
+ExpandNode is a method which allows you to expand a node of a tree view so that its children are shown. If the tree
+view instance no longer exists, or the node cannot be found, then no operation takes place. In addition, the
+following should be noted:
+
+- If the children of the node have not been loaded yet, they are requested from the child provider first.
+
+Example:
+
+	treeView.ExpandNode("documents")
+*/
+func (shared *TreeViewInstanceType) ExpandNode(nodeAlias string) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		TreeView.setNodeExpanded(shared.layerAlias, shared.controlAlias, nodeAlias, true)
+	}
+	return shared
+}
+
+/*
+CollapseNode is a method which allows you to collapse a node of a tree view so that its children are hidden. If the
+tree view instance no longer exists, or the node cannot be found, then no operation takes place. In addition, the
+following should be noted:
+
+- The children keep their own expanded state, and are shown as they were when the node is expanded again.
+
+Example:
+
+	treeView.CollapseNode("documents")
+*/
+func (shared *TreeViewInstanceType) CollapseNode(nodeAlias string) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		TreeView.setNodeExpanded(shared.layerAlias, shared.controlAlias, nodeAlias, false)
+	}
+	return shared
+}
+
+/*
+IsNodeExpanded is a method which allows you to check whether a node of a tree view is expanded. If the tree view
+instance no longer exists, or the node cannot be found, then false is always returned.
+
+Example:
+
+	isExpanded := treeView.IsNodeExpanded("documents")
+*/
+func (shared *TreeViewInstanceType) IsNodeExpanded(nodeAlias string) bool {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		treeViewEntry := TreeViews.Get(shared.layerAlias, shared.controlAlias)
+		node, _ := TreeView.findNode(treeViewEntry.Nodes, nil, nodeAlias)
+		return node != nil && node.IsExpanded
+	}
+	return false
+}
+
+/*
+ReloadNode is a method which allows you to discard the children of a node, so that they are requested from the
+child provider again the next time it is expanded. If the tree view instance no longer exists, or the node cannot be
+found, then no operation takes place. In addition, the following should be noted:
+
+- The node is collapsed, and is shown as expandable until its children have been requested again.
+
+Example:
+
+	treeView.ReloadNode("documents")
+*/
+func (shared *TreeViewInstanceType) ReloadNode(nodeAlias string) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		treeViewEntry := TreeViews.Get(shared.layerAlias, shared.controlAlias)
+		node, _ := TreeView.findNode(treeViewEntry.Nodes, nil, nodeAlias)
+		if node == nil {
+			return shared
+		}
+		node.Children = nil
+		node.IsExpanded = false
+		node.IsExpandable = true
+		node.IsLoaded = false
+		TreeView.updateAfterNodeChange(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+GetSelectedNode is a method which allows you to obtain the alias of the node last selected in a tree view, either by
+clicking it or by pressing enter on it. If the tree view instance no longer exists, or no node is selected, then an
+empty string is returned.
+
+Example:
+
+	nodeAlias := treeView.GetSelectedNode()
+*/
+func (shared *TreeViewInstanceType) GetSelectedNode() string {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		return TreeViews.Get(shared.layerAlias, shared.controlAlias).SelectedNodeAlias
+	}
+	return ""
+}
+
+/*
+SelectNode is a method which allows you to select a node of a tree view. If the tree view instance no longer exists,
+or the node cannot be found, then no operation takes place. In addition, the following should be noted:
+
+- Every ancestor of the node is expanded, and the tree view is scrolled so that the node can be seen.
+
+Example:
+
+	treeView.SelectNode("report")
+*/
+func (shared *TreeViewInstanceType) SelectNode(nodeAlias string) *TreeViewInstanceType {
+	if TreeViews.IsExists(shared.layerAlias, shared.controlAlias) {
+		treeViewEntry := TreeViews.Get(shared.layerAlias, shared.controlAlias)
+		if !TreeView.expandAncestors(treeViewEntry.Nodes, nodeAlias) {
+			return shared
+		}
+		treeViewEntry.HighlightedNodeAlias = nodeAlias
+		treeViewEntry.SelectedNodeAlias = nodeAlias
+		TreeView.updateAfterNodeChange(shared.layerAlias, shared.controlAlias)
+		TreeView.scrollToHighlightedNode(shared.layerAlias, shared.controlAlias)
+	}
+	return shared
+}
+
+/*
+Add is a method which adds a tree view to a given text layer. A tree view shows a hierarchy of nodes which can be
+expanded and collapsed, with indentation guides drawn using the connector characters of the frame style. Once called,
+an instance of your control is returned which will allow you to read or manipulate the properties for it. In
+addition, the following should be noted:
+
+- The tree view is drawn in the colors of the selector style.
+
+  - A vertical scroll bar is drawn to the right of the tree view, and is only shown when there are more visible nodes
+    than will fit.
+
+  - Up and down move between nodes, right expands a node or moves to its first child, left collapses a node or moves
+    to its parent, space expands or collapses a node, and enter selects it. Clicking the expand glyph of a node
+    expands or collapses it, while clicking anywhere else on it selects it.
+
+  - If the width is less than 1, or the height is less than 3 (the smallest height a scroll bar can be drawn at), a
+    panic will be generated.
+
+Example:
+
+	treeViewInstance := TreeView.Add("layer1", "treeView1", style, 2, 2, 30, 10)
+*/
+func (shared *treeViewType) Add(layerAlias string, treeViewAlias string, styleEntry types.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) TreeViewInstanceType {
+	validateTreeViewSize(width, height)
+	treeViewEntry := types.NewTreeViewEntry()
+	treeViewEntry.Alias = treeViewAlias
+	treeViewEntry.StyleEntry = styleEntry
+	treeViewEntry.XLocation = xLocation
+	treeViewEntry.YLocation = yLocation
+	treeViewEntry.Width = width
+	treeViewEntry.Height = height
+	treeViewEntry.ScrollbarAlias = stringformat.GetLastSortedUUID()
+	TreeViews.Add(layerAlias, treeViewAlias, &treeViewEntry)
+
+	scrollbar.Add(layerAlias, treeViewEntry.ScrollbarAlias, styleEntry, xLocation+width, yLocation, height, 0, 0, 1, false)
+	scrollbarEntry := ScrollBars.Get(layerAlias, treeViewEntry.ScrollbarAlias)
+	scrollbarEntry.ParentControlAlias = treeViewAlias
+	scrollbarEntry.ParentControlType = constants.CellTypeTreeView
+	shared.updateScrollbar(layerAlias, treeViewAlias)
+
+	var treeViewInstance TreeViewInstanceType
+	treeViewInstance.layerAlias = layerAlias
+	treeViewInstance.controlAlias = treeViewAlias
+	treeViewInstance.controlType = constants.TYPE_TREEVIEW
+	return treeViewInstance
+}
+
+/*
+Delete is a method which removes a tree view from a text layer, along with its scroll bar. In addition, the
+following should be noted:
+
+- If you attempt to delete a tree view which does not exist, then the request will simply be ignored.
+
+Example:
+
+	TreeView.Delete("layer1", "treeView1")
+*/
+func (shared *treeViewType) Delete(layerAlias string, treeViewAlias string) {
+	if TreeViews.IsExists(layerAlias, treeViewAlias) {
+		treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+		if ScrollBars.IsExists(layerAlias, treeViewEntry.ScrollbarAlias) {
+			ScrollBars.Remove(layerAlias, treeViewEntry.ScrollbarAlias)
+		}
+		TreeViews.Remove(layerAlias, treeViewAlias)
+	}
+	deleteTreeViewChildProvider(layerAlias, treeViewAlias)
+}
+
+/*
+DeleteAll is a method which deletes all tree views on a given text layer, along with their scroll bars.
+
+Example:
+
+	TreeView.DeleteAll("layer1")
+*/
+func (shared *treeViewType) DeleteAll(layerAlias string) {
+	for _, treeViewEntry := range TreeViews.GetAllEntries(layerAlias) {
+		if ScrollBars.IsExists(layerAlias, treeViewEntry.ScrollbarAlias) {
+			ScrollBars.Remove(layerAlias, treeViewEntry.ScrollbarAlias)
+		}
+	}
+	TreeViews.RemoveAll(layerAlias)
+	treeViewChildProviders.RemoveAll(layerAlias)
+}
+
+/*
+deleteTreeViewChildProvider is a method which allows you to remove the child provider assigned to a tree view, if
+any.
+
+Example:
+
+	deleteTreeViewChildProvider("layer1", "treeView1")
+*/
+func deleteTreeViewChildProvider(layerAlias string, treeViewAlias string) {
+	if treeViewChildProviders.IsExists(layerAlias, treeViewAlias) {
+		treeViewChildProviders.Remove(layerAlias, treeViewAlias)
+	}
+}
+
+/*
+drawOnLayer is a method which draws all tree views on a given text layer.
+
+Example:
+
+	TreeView.drawOnLayer(myLayer)
+*/
+func (shared *treeViewType) drawOnLayer(layerEntry types.LayerEntryType) {
+	layerAlias := layerEntry.LayerAlias
+	for _, treeViewEntry := range TreeViews.GetAllEntries(layerAlias) {
+		shared.draw(&layerEntry, treeViewEntry)
+	}
+}
+
+/*
+draw is a method which draws a tree view on a given text layer, along with its scroll bar. In addition, the
+following should be noted:
+
+  - Each cell of a row stores the index of the row in its control ID and its column in its control location, so that
+    mouse clicks can tell which node was clicked and whether its expand glyph was hit.
+
+Example:
+
+	TreeView.draw(&myLayer, treeViewEntry)
+*/
+func (shared *treeViewType) draw(layerEntry *types.LayerEntryType, treeViewEntry *types.TreeViewEntryType) {
+	localStyleEntry := types.NewTuiStyleEntry(&treeViewEntry.StyleEntry)
+	rows := shared.getVisibleRows(treeViewEntry)
+	attributeEntry := types.NewAttributeEntry()
+	attributeEntry.CellType = constants.CellTypeTreeView
+	attributeEntry.CellControlAlias = treeViewEntry.Alias
+	for currentRow := 0; currentRow < treeViewEntry.Height; currentRow++ {
+		rowIndex := treeViewEntry.ViewportPosition + currentRow
+		attributeEntry.ForegroundColor = localStyleEntry.Selector.ForegroundColor
+		attributeEntry.BackgroundColor = localStyleEntry.Selector.BackgroundColor
+		attributeEntry.CellControlId = constants.NullCellId
+		rowText := []rune{}
+		if rowIndex < len(rows) {
+			attributeEntry.CellControlId = rowIndex
+			rowText = shared.getRowText(localStyleEntry, rows[rowIndex])
+			if rows[rowIndex].node.Alias == treeViewEntry.HighlightedNodeAlias {
+				attributeEntry.ForegroundColor = localStyleEntry.Selector.HighlightForegroundColor
+				attributeEntry.BackgroundColor = localStyleEntry.Selector.HighlightBackgroundColor
+			}
+		}
+		rowText = stringformat.GetFormattedRuneArray(rowText, treeViewEntry.Width, constants.AlignmentLeft)
+		for currentColumn, character := range rowText {
+			attributeEntry.CellControlLocation = currentColumn
+			printLayer(layerEntry, attributeEntry, treeViewEntry.XLocation+currentColumn, treeViewEntry.YLocation+currentRow, []rune{character})
+		}
+	}
+	scrollbar.drawOnLayerByAlias(layerEntry, treeViewEntry.ScrollbarAlias)
+}
+
+/*
+getRowText is a method which allows you to obtain the text of a tree view row, made up of its indentation guides,
+its expand glyph, and its label. In addition, the following should be noted:
+
+  - Each level of indentation is two cells wide, so the expand glyph of a node is always at twice its depth. Nodes
+    which cannot be expanded show a horizontal line in place of the glyph, continuing their connector.
+
+Example:
+
+	rowText := TreeView.getRowText(styleEntry, row)
+*/
+func (shared *treeViewType) getRowText(styleEntry types.TuiStyleEntryType, row treeViewRowType) []rune {
+	var rowText []rune
+	for currentDepth := 1; currentDepth < row.depth; currentDepth++ {
+		if row.isLastSibling[currentDepth] {
+			rowText = append(rowText, ' ', ' ')
+		} else {
+			rowText = append(rowText, styleEntry.Frame.VerticalLine, ' ')
+		}
+	}
+	if row.depth > 0 {
+		if row.isLastSibling[row.depth] {
+			rowText = append(rowText, styleEntry.Frame.LowerLeftCorner, styleEntry.Frame.HorizontalLine)
+		} else {
+			rowText = append(rowText, styleEntry.Frame.RightSideTConnector, styleEntry.Frame.HorizontalLine)
+		}
+	}
+	if row.node.IsExpandable && row.node.IsExpanded {
+		rowText = append(rowText, styleEntry.TreeView.ExpandedGlyph)
+	} else if row.node.IsExpandable {
+		rowText = append(rowText, styleEntry.TreeView.CollapsedGlyph)
+	} else if row.depth > 0 {
+		rowText = append(rowText, styleEntry.Frame.HorizontalLine)
+	} else {
+		rowText = append(rowText, ' ')
+	}
+	rowText = append(rowText, ' ')
+	return append(rowText, stringformat.GetRunesFromString(row.node.Label)...)
+}
+
+/*
+getVisibleRows is a method which allows you to obtain every node of a tree view which can currently be seen, in the
+order they are drawn. The children of collapsed nodes are skipped.
+
+Example:
+
+	rows := TreeView.getVisibleRows(treeViewEntry)
+*/
+func (shared *treeViewType) getVisibleRows(treeViewEntry *types.TreeViewEntryType) []treeViewRowType {
+	var rows []treeViewRowType
+	var addRows func(nodes []*types.TreeNodeEntryType, depth int, isLastSibling []bool)
+	addRows = func(nodes []*types.TreeNodeEntryType, depth int, isLastSibling []bool) {
+		for index, node := range nodes {
+			nodeIsLastSibling := append(append([]bool{}, isLastSibling...), index == len(nodes)-1)
+			rows = append(rows, treeViewRowType{node: node, depth: depth, isLastSibling: nodeIsLastSibling})
+			if node.IsExpanded {
+				addRows(node.Children, depth+1, nodeIsLastSibling)
+			}
+		}
+	}
+	addRows(treeViewEntry.Nodes, 0, nil)
+	return rows
+}
+
+/*
+getRowIndex is a method which allows you to find the row a node of a tree view is drawn on. If the node cannot be
+seen, -1 is returned.
+
+Example:
+
+	rowIndex := TreeView.getRowIndex(rows, "report")
+*/
+func (shared *treeViewType) getRowIndex(rows []treeViewRowType, nodeAlias string) int {
+	for index, row := range rows {
+		if row.node.Alias == nodeAlias {
+			return index
+		}
+	}
+	return -1
+}
+
+/*
+findNode is a method which allows you to search a list of tree view nodes, and all of their descendants, for the node
+with a given alias. The node found is returned along with its parent, which is nil for nodes at the top level. If no
+node is found, nil is returned for both.
+
+Example:
+
+	node, parentNode := TreeView.findNode(treeViewEntry.Nodes, nil, "report")
+*/
+func (shared *treeViewType) findNode(nodes []*types.TreeNodeEntryType, parentNode *types.TreeNodeEntryType, nodeAlias string) (*types.TreeNodeEntryType, *types.TreeNodeEntryType) {
+	for _, node := range nodes {
+		if node.Alias == nodeAlias {
+			return node, parentNode
+		}
+		if foundNode, foundParentNode := shared.findNode(node.Children, node, nodeAlias); foundNode != nil {
+			return foundNode, foundParentNode
+		}
+	}
+	return nil, nil
+}
+
+/*
+removeNodeFromList is a method which allows you to obtain a list of tree view nodes with one node taken out of it.
+
+Example:
+
+	treeViewEntry.Nodes = TreeView.removeNodeFromList(treeViewEntry.Nodes, node)
+*/
+func (shared *treeViewType) removeNodeFromList(nodes []*types.TreeNodeEntryType, nodeToRemove *types.TreeNodeEntryType) []*types.TreeNodeEntryType {
+	var remainingNodes []*types.TreeNodeEntryType
+	for _, node := range nodes {
+		if node != nodeToRemove {
+			remainingNodes = append(remainingNodes, node)
+		}
+	}
+	return remainingNodes
+}
+
+/*
+expandAncestors is a method which allows you to expand every ancestor of a tree view node, so that the node itself
+can be seen. If the node cannot be found, false is returned and nothing is expanded.
+
+Example:
+
+	isFound := TreeView.expandAncestors(treeViewEntry.Nodes, "report")
+*/
+func (shared *treeViewType) expandAncestors(nodes []*types.TreeNodeEntryType, nodeAlias string) bool {
+	for _, node := range nodes {
+		if node.Alias == nodeAlias {
+			return true
+		}
+		if shared.expandAncestors(node.Children, nodeAlias) {
+			node.IsExpanded = true
+			return true
+		}
+	}
+	return false
+}
+
+/*
+loadChildren is a method which allows you to request the children of a tree view node from the child provider of
+the tree view, if they have not already been loaded. If the provider returns no children, the node is no longer
+treated as expandable.
+
+Example:
+
+	TreeView.loadChildren("layer1", "treeView1", node)
+*/
+func (shared *treeViewType) loadChildren(layerAlias string, treeViewAlias string, node *types.TreeNodeEntryType) {
+	if node.IsLoaded {
+		return
+	}
+	node.IsLoaded = true
+	if treeViewChildProviders.IsExists(layerAlias, treeViewAlias) {
+		for _, childNode := range treeViewChildProviders.Get(layerAlias, treeViewAlias).provider(node.Alias) {
+			newChildNode := childNode
+			node.Children = append(node.Children, &newChildNode)
+		}
+	}
+	if len(node.Children) == 0 {
+		node.IsExpandable = false
+	}
+}
+
+/*
+setNodeExpanded is a method which allows you to expand or collapse a node of a tree view. If the node cannot be
+found, or cannot be expanded, false is returned. In addition, the following should be noted:
+
+- If the highlighted node is hidden by collapsing one of its ancestors, the highlight moves to that ancestor.
+
+Example:
+
+	isChanged := TreeView.setNodeExpanded("layer1", "treeView1", "documents", true)
+*/
+func (shared *treeViewType) setNodeExpanded(layerAlias string, treeViewAlias string, nodeAlias string, isExpanded bool) bool {
+	treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+	node, _ := shared.findNode(treeViewEntry.Nodes, nil, nodeAlias)
+	if node == nil || !node.IsExpandable {
+		return false
+	}
+	if isExpanded {
+		shared.loadChildren(layerAlias, treeViewAlias, node)
+		node.IsExpanded = node.IsExpandable
+	} else {
+		if highlightedNode, _ := shared.findNode(node.Children, node, treeViewEntry.HighlightedNodeAlias); highlightedNode != nil {
+			treeViewEntry.HighlightedNodeAlias = node.Alias
+		}
+		node.IsExpanded = false
+	}
+	shared.updateAfterNodeChange(layerAlias, treeViewAlias)
+	return true
+}
+
+/*
+updateAfterNodeChange is a method which allows you to bring the state of a tree view back in line with its nodes
+after they have been added, removed, expanded, or collapsed. In addition, the following should be noted:
+
+  - If the highlighted node can no longer be seen, the first node is highlighted instead. If the selected node has
+    been removed, no node is left selected.
+
+- The viewport is kept within the rows available, and the scroll bar is resized to match.
+
+Example:
+
+	TreeView.updateAfterNodeChange("layer1", "treeView1")
+*/
+func (shared *treeViewType) updateAfterNodeChange(layerAlias string, treeViewAlias string) {
+	treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+	rows := shared.getVisibleRows(treeViewEntry)
+	if shared.getRowIndex(rows, treeViewEntry.HighlightedNodeAlias) == -1 {
+		treeViewEntry.HighlightedNodeAlias = ""
+		if len(rows) > 0 {
+			treeViewEntry.HighlightedNodeAlias = rows[0].node.Alias
+		}
+	}
+	if selectedNode, _ := shared.findNode(treeViewEntry.Nodes, nil, treeViewEntry.SelectedNodeAlias); selectedNode == nil {
+		treeViewEntry.SelectedNodeAlias = ""
+	}
+	shared.setViewport(layerAlias, treeViewAlias, treeViewEntry.ViewportPosition)
+}
+
+/*
+setViewport is a method which allows you to scroll a tree view so that a given row is at its top. The row is kept
+within the range that still fills the tree view, and the scroll bar is updated to match.
+
+Example:
+
+	TreeView.setViewport("layer1", "treeView1", 10)
+*/
+func (shared *treeViewType) setViewport(layerAlias string, treeViewAlias string, viewportPosition int) {
+	treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+	maximumViewportPosition := len(shared.getVisibleRows(treeViewEntry)) - treeViewEntry.Height
+	if viewportPosition > maximumViewportPosition {
+		viewportPosition = maximumViewportPosition
+	}
+	if viewportPosition < 0 {
+		viewportPosition = 0
+	}
+	treeViewEntry.ViewportPosition = viewportPosition
+	shared.updateScrollbar(layerAlias, treeViewAlias)
+}
+
+/*
+updateScrollbar is a method which allows you to resize the scroll bar of a tree view to match the number of rows it
+has, and move its handle to match the viewport. The scroll bar is hidden when every row fits in the tree view.
+
+Example:
+
+	TreeView.updateScrollbar("layer1", "treeView1")
+*/
+func (shared *treeViewType) updateScrollbar(layerAlias string, treeViewAlias string) {
+	treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+	if !ScrollBars.IsExists(layerAlias, treeViewEntry.ScrollbarAlias) {
+		return
+	}
+	scrollbarEntry := ScrollBars.Get(layerAlias, treeViewEntry.ScrollbarAlias)
+	maxScrollValue := len(shared.getVisibleRows(treeViewEntry)) - treeViewEntry.Height
+	scrollbarEntry.IsEnabled = maxScrollValue > 0
+	scrollbarEntry.IsVisible = maxScrollValue > 0
+	if maxScrollValue < 0 {
+		maxScrollValue = 0
+	}
+	scrollbarEntry.MaxScrollValue = maxScrollValue
+	scrollbarEntry.ScrollValue = treeViewEntry.ViewportPosition
+	scrollbar.computeHandlePositionByScrollValue(layerAlias, treeViewEntry.ScrollbarAlias)
+}
+
+/*
+scrollToHighlightedNode is a method which allows you to scroll a tree view just far enough that its highlighted node
+can be seen.
+
+Example:
+
+	TreeView.scrollToHighlightedNode("layer1", "treeView1")
+*/
+func (shared *treeViewType) scrollToHighlightedNode(layerAlias string, treeViewAlias string) {
+	treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+	rowIndex := shared.getRowIndex(shared.getVisibleRows(treeViewEntry), treeViewEntry.HighlightedNodeAlias)
+	if rowIndex == -1 {
+		return
+	}
+	if rowIndex < treeViewEntry.ViewportPosition {
+		shared.setViewport(layerAlias, treeViewAlias, rowIndex)
+	} else if rowIndex >= treeViewEntry.ViewportPosition+treeViewEntry.Height {
+		shared.setViewport(layerAlias, treeViewAlias, rowIndex-treeViewEntry.Height+1)
+	}
+}
+
+/*
+moveHighlight is a method which allows you to move the highlight of a tree view to another row, keeping it within the
+rows available and scrolling the tree view if needed.
+
+Example:
+
+	TreeView.moveHighlight("layer1", "treeView1", rows, 5)
+*/
+func (shared *treeViewType) moveHighlight(layerAlias string, treeViewAlias string, rows []treeViewRowType, rowIndex int) {
+	if len(rows) == 0 {
+		return
+	}
+	if rowIndex >= len(rows) {
+		rowIndex = len(rows) - 1
+	}
+	if rowIndex < 0 {
+		rowIndex = 0
+	}
+	TreeViews.Get(layerAlias, treeViewAlias).HighlightedNodeAlias = rows[rowIndex].node.Alias
+	shared.scrollToHighlightedNode(layerAlias, treeViewAlias)
+}
+
+/*
+updateViewportFromFocusedScrollbar is a method which allows you to scroll a tree view to match its scroll bar, when
+that scroll bar is the control currently in focus. If the viewport was changed, true is returned.
+
+Example:
+
+	isUpdateRequired := TreeView.updateViewportFromFocusedScrollbar()
+*/
+func (shared *treeViewType) updateViewportFromFocusedScrollbar() bool {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	if eventStateMemory.currentlyFocusedControl.controlType != constants.CellTypeScrollbar || !ScrollBars.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false
+	}
+	scrollbarEntry := ScrollBars.Get(focusedLayerAlias, focusedControlAlias)
+	for _, treeViewEntry := range TreeViews.GetAllEntries(focusedLayerAlias) {
+		if treeViewEntry.ScrollbarAlias == focusedControlAlias && treeViewEntry.ViewportPosition != scrollbarEntry.ScrollValue {
+			treeViewEntry.ViewportPosition = scrollbarEntry.ScrollValue
+			return true
+		}
+	}
+	return false
+}
+
+/*
+updateKeyboardEvent is a method which updates the state of the focused tree view according to the current keyboard
+event. In addition, the following should be noted:
+
+- Up, down, page up, page down, home, and end move the highlight between nodes.
+
+  - Right expands the highlighted node, or moves to its first child if it is already expanded. Left collapses the
+    highlighted node, or moves to its parent if it is already collapsed.
+
+- Space expands or collapses the highlighted node.
+
+- Enter selects the highlighted node and calls the submit handler of the tree view.
+
+Example:
+
+	isUpdate, isConsumed := TreeView.updateKeyboardEvent(keystroke)
+*/
+func (shared *treeViewType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	// A focused scroll bar handles the keystroke itself, so we only need to move its tree view to match.
+	if focusedControlType == constants.CellTypeScrollbar {
+		return shared.updateViewportFromFocusedScrollbar(), false
+	}
+	if focusedControlType != constants.CellTypeTreeView || !TreeViews.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false, false
+	}
+	treeViewEntry := TreeViews.Get(focusedLayerAlias, focusedControlAlias)
+	if !treeViewEntry.IsEnabled {
+		return false, false
+	}
+	rows := shared.getVisibleRows(treeViewEntry)
+	rowIndex := shared.getRowIndex(rows, treeViewEntry.HighlightedNodeAlias)
+	if rowIndex == -1 {
+		return false, false
+	}
+	row := rows[rowIndex]
+	switch string(keystroke) {
+	case "up":
+		shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, rowIndex-1)
+	case "down":
+		shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, rowIndex+1)
+	case "pgup":
+		shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, rowIndex-treeViewEntry.Height)
+	case "pgdn":
+		shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, rowIndex+treeViewEntry.Height)
+	case "home":
+		shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, 0)
+	case "end":
+		shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, len(rows)-1)
+	case "right":
+		if row.node.IsExpandable && !row.node.IsExpanded {
+			shared.setNodeExpanded(focusedLayerAlias, focusedControlAlias, row.node.Alias, true)
+		} else if row.node.IsExpanded && len(row.node.Children) > 0 {
+			shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, rowIndex+1)
+		}
+	case "left":
+		if row.node.IsExpanded {
+			shared.setNodeExpanded(focusedLayerAlias, focusedControlAlias, row.node.Alias, false)
+		} else if _, parentNode := shared.findNode(treeViewEntry.Nodes, nil, row.node.Alias); parentNode != nil {
+			shared.moveHighlight(focusedLayerAlias, focusedControlAlias, rows, shared.getRowIndex(rows, parentNode.Alias))
+		}
+	case " ":
+		shared.setNodeExpanded(focusedLayerAlias, focusedControlAlias, row.node.Alias, !row.node.IsExpanded)
+	case "enter":
+		treeViewEntry.SelectedNodeAlias = row.node.Alias
+		fireSubmitHandler(focusedLayerAlias, focusedControlAlias)
+	default:
+		return false, false
+	}
+	return true, true
+}
+
+/*
+updateMouseEvent is a method which updates the state of all tree views according to the current mouse event. In
+addition, the following should be noted:
+
+- Clicking the expand glyph of a node expands or collapses it, while clicking anywhere else on it selects it.
+
+- Scrolling the mouse wheel over a tree view scrolls it by one row.
+
+- Dragging the scroll bar of a tree view scrolls it to match.
+
+Example:
+
+	isUpdateRequired := TreeView.updateMouseEvent()
+*/
+func (shared *treeViewType) updateMouseEvent() bool {
+	mouseXLocation, mouseYLocation, buttonPressed, wheelState := GetMouseStatus()
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+	layerAlias := characterEntry.LayerAlias
+	// If a scroll bar is being clicked or dragged, then move the tree view it belongs to.
+	if buttonPressed != 0 && (eventStateMemory.stateId == constants.EventStateDragAndDropScrollbar ||
+		characterEntry.AttributeEntry.CellType == constants.CellTypeScrollbar) {
+		return shared.updateViewportFromFocusedScrollbar()
+	}
+	treeViewAlias := characterEntry.AttributeEntry.CellControlAlias
+	if characterEntry.AttributeEntry.CellType != constants.CellTypeTreeView || !TreeViews.IsExists(layerAlias, treeViewAlias) {
+		return false
+	}
+	treeViewEntry := TreeViews.Get(layerAlias, treeViewAlias)
+	if wheelState == "Up" {
+		shared.setViewport(layerAlias, treeViewAlias, treeViewEntry.ViewportPosition-1)
+		return true
+	} else if wheelState == "Down" {
+		shared.setViewport(layerAlias, treeViewAlias, treeViewEntry.ViewportPosition+1)
+		return true
+	}
+	if buttonPressed == 0 || previousButtonPressed != 0 {
+		return false
+	}
+	setFocusedControl(layerAlias, treeViewAlias, constants.CellTypeTreeView)
+	rows := shared.getVisibleRows(treeViewEntry)
+	rowIndex := characterEntry.AttributeEntry.CellControlId
+	if !treeViewEntry.IsEnabled || rowIndex < 0 || rowIndex >= len(rows) {
+		return true
+	}
+	row := rows[rowIndex]
+	if characterEntry.AttributeEntry.CellControlLocation == row.depth*2 && row.node.IsExpandable {
+		shared.setNodeExpanded(layerAlias, treeViewAlias, row.node.Alias, !row.node.IsExpanded)
+		return true
+	}
+	treeViewEntry.HighlightedNodeAlias = row.node.Alias
+	treeViewEntry.SelectedNodeAlias = row.node.Alias
+	return true
+}
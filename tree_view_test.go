@@ -0,0 +1,127 @@
+package consolizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"github.com/supercom32/consolizer/types"
+	"testing"
+)
+
+/*
+TestTreeViewKeyboard is a test which verifies that the nodes of a tree view can be navigated, expanded, collapsed,
+and selected with the keyboard, and that children are loaded on demand from the child provider.
+
+Example:
+
+	Expected Inputs:
+	    A tree view with one branch holding two leaves, and one branch whose children come from a child provider,
+	    navigated with the arrow keys, space, and enter.
+
+	Expected Outputs:
+	    The right and left arrows expand, collapse, and move between parents and children, the child provider is only
+	    called once, the tree view scrolls to keep the highlighted node visible, and enter selects the node and calls
+	    the submit handler.
+*/
+func TestTreeViewKeyboard(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	treeViewInstance := layer1.AddTreeView(styleEntry, 2, 2, 20, 4)
+	numberOfProviderCalls := 0
+	treeViewInstance.SetChildProvider(func(nodeAlias string) []types.TreeNodeEntryType {
+		numberOfProviderCalls++
+		return []types.TreeNodeEntryType{{Alias: nodeAlias + "X", Label: "X"}, {Alias: nodeAlias + "Y", Label: "Y"}}
+	})
+	treeViewInstance.AddNode("", "root", "Root", false)
+	treeViewInstance.AddNode("root", "a", "A", false)
+	treeViewInstance.AddNode("root", "b", "B", false)
+	treeViewInstance.AddNode("", "lazy", "Lazy", true)
+	isSubmitted := false
+	treeViewInstance.OnSubmit(func(control *BaseControlInstanceType) { isSubmitted = true })
+	treeViewInstance.GetFocus()
+	assert.Equalf(test, constants.CellTypeTreeView, eventStateMemory.currentlyFocusedControl.controlType, "Focusing the tree view did not focus it!")
+	treeViewEntry := TreeViews.Get(layer1.layerAlias, treeViewInstance.controlAlias)
+	assert.Equalf(test, "root", treeViewEntry.HighlightedNodeAlias, "The first node was not highlighted by default!")
+
+	TreeView.updateKeyboardEvent([]rune("right"))
+	assert.Truef(test, treeViewInstance.IsNodeExpanded("root"), "The right arrow did not expand the node!")
+	TreeView.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, "a", treeViewEntry.HighlightedNodeAlias, "The right arrow did not move to the first child!")
+	TreeView.updateKeyboardEvent([]rune("end"))
+	TreeView.updateKeyboardEvent([]rune("right"))
+	assert.Equalf(test, 1, numberOfProviderCalls, "The child provider was not called when the node was expanded!")
+	TreeView.updateKeyboardEvent([]rune("down"))
+	assert.Equalf(test, "lazyX", treeViewEntry.HighlightedNodeAlias, "The down arrow did not move into the loaded children!")
+	assert.Equalf(test, 1, treeViewEntry.ViewportPosition, "The tree view did not scroll to the highlighted node!")
+	TreeView.updateKeyboardEvent([]rune("left"))
+	assert.Equalf(test, "lazy", treeViewEntry.HighlightedNodeAlias, "The left arrow did not move to the parent!")
+	TreeView.updateKeyboardEvent([]rune("left"))
+	assert.Falsef(test, treeViewInstance.IsNodeExpanded("lazy"), "The left arrow did not collapse the node!")
+	TreeView.updateKeyboardEvent([]rune(" "))
+	TreeView.updateKeyboardEvent([]rune(" "))
+	assert.Equalf(test, 1, numberOfProviderCalls, "The child provider was called again for a loaded node!")
+	TreeView.updateKeyboardEvent([]rune("enter"))
+	assert.Equalf(test, "lazy", treeViewInstance.GetSelectedNode(), "Enter did not select the highlighted node!")
+	assert.Truef(test, isSubmitted, "Enter did not call the submit handler!")
+
+	treeViewInstance.CollapseNode("root")
+	treeViewInstance.SelectNode("b")
+	assert.Truef(test, treeViewInstance.IsNodeExpanded("root"), "Selecting a node did not expand its parent!")
+	treeViewInstance.RemoveNode("root")
+	assert.Equalf(test, "", treeViewInstance.GetSelectedNode(), "Removing a selected node did not clear the selection!")
+	assert.Panicsf(test, func() { treeViewInstance.AddNode("missing", "c", "C", false) }, "A missing parent node did not panic!")
+}
+
+/*
+TestTreeViewMouse is a test which verifies that a tree view draws its indentation guides and expand glyphs, and
+that its nodes can be expanded, collapsed, selected, and scrolled with the mouse.
+
+Example:
+
+	Expected Inputs:
+	    A tree view with two expanded branches which do not fit in its height, clicked on an expand glyph, clicked on
+	    a label, and scrolled with the mouse wheel.
+
+	Expected Outputs:
+	    The connectors and glyphs are drawn at the expected columns, clicking a glyph collapses its node, clicking a
+	    label selects its node, and the mouse wheel scrolls the tree view by one row.
+*/
+func TestTreeViewMouse(test *testing.T) {
+	layer1, _, _, styleEntry := CommonTestSetup()
+	treeViewInstance := layer1.AddTreeView(styleEntry, 2, 2, 20, 4)
+	treeViewInstance.AddNode("", "root", "Root", false)
+	treeViewInstance.AddNode("root", "a", "A", false)
+	treeViewInstance.AddNode("root", "b", "B", false)
+	treeViewInstance.AddNode("", "other", "Other", false)
+	treeViewInstance.AddNode("other", "c", "C", false)
+	treeViewInstance.ExpandNode("root")
+	treeViewInstance.ExpandNode("other")
+	UpdateDisplay(false)
+	layerEntry := commonResource.screenLayer
+	assert.Equalf(test, styleEntry.TreeView.ExpandedGlyph, layerEntry.CharacterMemory[2][2].Character, "The expanded glyph was not drawn!")
+	assert.Equalf(test, styleEntry.Frame.RightSideTConnector, layerEntry.CharacterMemory[3][2].Character, "A connector to a following sibling was not drawn!")
+	assert.Equalf(test, styleEntry.Frame.LowerLeftCorner, layerEntry.CharacterMemory[4][2].Character, "A connector to the last sibling was not drawn!")
+	assert.Equalf(test, 'A', layerEntry.CharacterMemory[3][6].Character, "The label of a child was not indented!")
+	assert.Equalf(test, styleEntry.Scrollbar.Handle, layerEntry.CharacterMemory[3][22].Character, "The scroll bar was not drawn for rows which do not fit!")
+
+	SetMouseStatus(0, 0, 0, "")
+	SetMouseStatus(2, 5, 0, "Down")
+	TreeView.updateMouseEvent()
+	treeViewEntry := TreeViews.Get(layer1.layerAlias, treeViewInstance.controlAlias)
+	assert.Equalf(test, 1, treeViewEntry.ViewportPosition, "The mouse wheel did not scroll the tree view!")
+	treeViewInstance.SelectNode("root")
+	UpdateDisplay(false)
+	SetMouseStatus(2, 2, 0, "")
+	SetMouseStatus(2, 2, 1, "")
+	TreeView.updateMouseEvent()
+	assert.Falsef(test, treeViewInstance.IsNodeExpanded("root"), "Clicking the expand glyph did not collapse the node!")
+	assert.Equalf(test, treeViewInstance.controlAlias, eventStateMemory.currentlyFocusedControl.controlAlias, "Clicking the tree view did not focus it!")
+	UpdateDisplay(false)
+	SetMouseStatus(2, 2, 0, "")
+	SetMouseStatus(6, 3, 1, "")
+	TreeView.updateMouseEvent()
+	assert.Equalf(test, "other", treeViewInstance.GetSelectedNode(), "Clicking a label did not select its node!")
+	treeViewInstance.Delete()
+	assert.Falsef(test, TreeViews.IsExists(layer1.layerAlias, treeViewInstance.controlAlias), "The tree view was not deleted!")
+	treeViewInstance = layer1.AddTreeView(styleEntry, 2, 2, 20, 4)
+	treeViewInstance.AddNode("", "root", "Root", false)
+	assert.Panicsf(test, func() { treeViewInstance.AddNode("", "root", "Root", false) }, "A duplicate node alias did not panic!")
+}
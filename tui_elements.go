@@ -586,6 +586,7 @@ func drawShadow(layerEntry *types.LayerEntryType, attributeEntry types.Attribute
 					cellAttr.CellType = constants.CellTypeShadow
 					// Update the cell with darkened colors but keep the original character
 					cell.AttributeEntry = cellAttr
+					layerEntry.MarkDirtyRegion(x, y, 1, 1)
 				} else {
 					// For empty cells, use the standard shadow approach (null rune with transform values)
 					shadowAttr := types.NewAttributeEntry(&localAttributeEntry)
@@ -643,6 +644,7 @@ Example:
 */
 func fillAreaWithControlAlias(layerEntry *types.LayerEntryType, cellType int, cellControlAlias string, xLocation int, yLocation int, width int, height int, startingControlLocation int) {
 	characterMemory := layerEntry.CharacterMemory
+	layerEntry.MarkDirtyRegion(0, 0, width, height)
 	for currentRow := 0; currentRow < height; currentRow++ {
 		for currentColumn := 0; currentColumn < width; currentColumn++ {
 			if yLocation >= 0 && yLocation < layerEntry.Height && xLocation+currentColumn >= 0 && xLocation+currentColumn < layerEntry.Width {
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+DirtyRegionEntryType is a structure which represents a rectangular area of a text layer which has been modified since
+it was last drawn to the screen. In addition, the following should be noted:
+
+- A region with a width or height of zero or less is considered empty.
+
+Example:
+
+	var dirtyRegion types.DirtyRegionEntryType
+*/
+type DirtyRegionEntryType struct {
+	XLocation int
+	YLocation int
+	Width     int
+	Height    int
+}
+
+/*
+MarshalJSON is a method which allows you to convert a dirty region entry to JSON format. In addition, the following
+should be noted:
+
+- Implements the json.Marshaler interface for DirtyRegionEntryType.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared DirtyRegionEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		XLocation int
+		YLocation int
+		Width     int
+		Height    int
+	}{
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width:     shared.Width,
+		Height:    shared.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which allows you to get a JSON string representation of the dirty region entry.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared DirtyRegionEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewDirtyRegionEntry is a constructor which allows you to create a new dirty region entry. In addition, the following
+should be noted:
+
+- If an existing dirty region entry is provided, its values are copied into the new entry.
+
+- Otherwise, the new region is empty.
+
+Example:
+
+	dirtyRegion := NewDirtyRegionEntry(existingDirtyRegion)
+*/
+func NewDirtyRegionEntry(existingDirtyRegionEntry ...*DirtyRegionEntryType) DirtyRegionEntryType {
+	var dirtyRegionEntry DirtyRegionEntryType
+	if existingDirtyRegionEntry != nil && existingDirtyRegionEntry[0] != nil {
+		dirtyRegionEntry.XLocation = existingDirtyRegionEntry[0].XLocation
+		dirtyRegionEntry.YLocation = existingDirtyRegionEntry[0].YLocation
+		dirtyRegionEntry.Width = existingDirtyRegionEntry[0].Width
+		dirtyRegionEntry.Height = existingDirtyRegionEntry[0].Height
+	}
+	return dirtyRegionEntry
+}
+
+/*
+IsEmpty is a method which allows you to detect if a dirty region covers no cells at all.
+
+Example:
+
+	isEmpty := dirtyRegion.IsEmpty()
+*/
+func (shared DirtyRegionEntryType) IsEmpty() bool {
+	return shared.Width <= 0 || shared.Height <= 0
+}
+
+/*
+GetUnion is a method which allows you to obtain the smallest region which fully contains both the current region and
+the one provided. In addition, the following should be noted:
+
+- Empty regions are ignored, so the union of an empty region with another region is simply the other region.
+
+Example:
+
+	combinedRegion := dirtyRegion.GetUnion(otherRegion)
+*/
+func (shared DirtyRegionEntryType) GetUnion(otherDirtyRegion DirtyRegionEntryType) DirtyRegionEntryType {
+	if otherDirtyRegion.IsEmpty() {
+		return shared
+	}
+	if shared.IsEmpty() {
+		return otherDirtyRegion
+	}
+	startX := min(shared.XLocation, otherDirtyRegion.XLocation)
+	startY := min(shared.YLocation, otherDirtyRegion.YLocation)
+	endX := max(shared.XLocation+shared.Width, otherDirtyRegion.XLocation+otherDirtyRegion.Width)
+	endY := max(shared.YLocation+shared.Height, otherDirtyRegion.YLocation+otherDirtyRegion.Height)
+	return DirtyRegionEntryType{XLocation: startX, YLocation: startY, Width: endX - startX, Height: endY - startY}
+}
+
+/*
+GetIntersection is a method which allows you to obtain the region where the current region and the one provided
+overlap. In addition, the following should be noted:
+
+- If the two regions do not overlap, an empty region is returned.
+
+Example:
+
+	overlappingRegion := dirtyRegion.GetIntersection(otherRegion)
+*/
+func (shared DirtyRegionEntryType) GetIntersection(otherDirtyRegion DirtyRegionEntryType) DirtyRegionEntryType {
+	startX := max(shared.XLocation, otherDirtyRegion.XLocation)
+	startY := max(shared.YLocation, otherDirtyRegion.YLocation)
+	endX := min(shared.XLocation+shared.Width, otherDirtyRegion.XLocation+otherDirtyRegion.Width)
+	endY := min(shared.YLocation+shared.Height, otherDirtyRegion.YLocation+otherDirtyRegion.Height)
+	if endX <= startX || endY <= startY {
+		return DirtyRegionEntryType{}
+	}
+	return DirtyRegionEntryType{XLocation: startX, YLocation: startY, Width: endX - startX, Height: endY - startY}
+}
+
+/*
+IsIntersecting is a method which allows you to detect if the current region overlaps the one provided.
+
+Example:
+
+	isOverlapping := dirtyRegion.IsIntersecting(otherRegion)
+*/
+func (shared DirtyRegionEntryType) IsIntersecting(otherDirtyRegion DirtyRegionEntryType) bool {
+	return !shared.GetIntersection(otherDirtyRegion).IsEmpty()
+}
+
+/*
+GetTranslated is a method which allows you to obtain a copy of the current region moved by the offset provided.
+
+Example:
+
+	screenRegion := dirtyRegion.GetTranslated(layerEntry.ScreenXLocation, layerEntry.ScreenYLocation)
+*/
+func (shared DirtyRegionEntryType) GetTranslated(xOffset int, yOffset int) DirtyRegionEntryType {
+	shared.XLocation += xOffset
+	shared.YLocation += yOffset
+	return shared
+}
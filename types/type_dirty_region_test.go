@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+/*
+TestDirtyRegionUnionAndIntersection is a test which verifies that dirty regions can be combined and overlapped
+correctly.
+
+Example:
+
+	Expected Inputs:
+	    Two overlapping regions, and a region which is empty.
+
+	Expected Outputs:
+	    The union contains both regions, the intersection covers only the overlap, and empty regions are ignored.
+*/
+func TestDirtyRegionUnionAndIntersection(test *testing.T) {
+	firstRegion := DirtyRegionEntryType{XLocation: 2, YLocation: 3, Width: 5, Height: 4}
+	secondRegion := DirtyRegionEntryType{XLocation: 5, YLocation: 1, Width: 6, Height: 3}
+	assert.Equalf(test, DirtyRegionEntryType{XLocation: 2, YLocation: 1, Width: 9, Height: 6}, firstRegion.GetUnion(secondRegion), "The union of the two regions was not correct.")
+	assert.Equalf(test, DirtyRegionEntryType{XLocation: 5, YLocation: 3, Width: 2, Height: 1}, firstRegion.GetIntersection(secondRegion), "The intersection of the two regions was not correct.")
+	assert.Equalf(test, firstRegion, firstRegion.GetUnion(NewDirtyRegionEntry()), "An empty region should not change the union.")
+	farRegion := DirtyRegionEntryType{XLocation: 20, YLocation: 20, Width: 1, Height: 1}
+	assert.Truef(test, firstRegion.GetIntersection(farRegion).IsEmpty(), "Regions which do not overlap should have an empty intersection.")
+	assert.Falsef(test, firstRegion.IsIntersecting(farRegion), "Regions which do not overlap should not be intersecting.")
+}
+
+/*
+TestDirtyRegionLayerMarking is a test which verifies that marking a layer as dirty is clipped to the layer bounds and
+shared with shallow copies of the layer.
+
+Example:
+
+	Expected Inputs:
+	    A new layer, a region which extends past the layer bounds, and a shallow copy of the layer.
+
+	Expected Outputs:
+	    New layers start fully dirty, marked regions are clipped, and marks made through the copy are visible.
+*/
+func TestDirtyRegionLayerMarking(test *testing.T) {
+	layerEntry := NewLayerEntry("layer", "", 10, 5)
+	assert.Equalf(test, DirtyRegionEntryType{Width: 10, Height: 5}, layerEntry.GetDirtyRegion(), "A new layer should be fully dirty.")
+	layerEntry.ClearDirtyRegion()
+	layerEntry.MarkDirtyRegion(8, 3, 5, 5)
+	assert.Equalf(test, DirtyRegionEntryType{XLocation: 8, YLocation: 3, Width: 2, Height: 2}, layerEntry.GetDirtyRegion(), "The dirty region was not clipped to the layer.")
+	layerEntryCopy := layerEntry
+	layerEntryCopy.MarkDirtyRegion(0, 0, 1, 1)
+	assert.Equalf(test, DirtyRegionEntryType{Width: 10, Height: 5}, layerEntry.GetDirtyRegion(), "Marks made through a shallow copy were not recorded.")
+}
@@ -0,0 +1,145 @@
+package types
+
+import "github.com/supercom32/consolizer/constants"
+
+/*
+EditHistoryType is a structure which represents the undo and redo history of an editable control. In addition, the
+following should be noted:
+
+- Each entry is a snapshot of the control taken just before an edit transaction began.
+
+  - Consecutive edits of the same type can be grouped into a single transaction, so that undoing removes them all at
+    once.
+
+Example:
+
+	var editHistory types.EditHistoryType[types.TextFieldSnapshotType]
+*/
+type EditHistoryType[T any] struct {
+	UndoEntries  []T
+	RedoEntries  []T
+	LastEditType int
+}
+
+/*
+NewEditHistory is a constructor which allows you to create a new, empty edit history.
+
+Example:
+
+	editHistory := NewEditHistory[TextFieldSnapshotType]()
+*/
+func NewEditHistory[T any]() EditHistoryType[T] {
+	var editHistory EditHistoryType[T]
+	editHistory.LastEditType = constants.EditTypeNone
+	return editHistory
+}
+
+/*
+IsContinuingTransaction is a method which allows you to detect if an edit of the given type would continue the
+transaction currently open. In addition, the following should be noted:
+
+- Only inserts, deletes, and backspaces can be grouped together. Replacements always begin a new transaction.
+
+Example:
+
+	if !editHistory.IsContinuingTransaction(constants.EditTypeInsert) {
+		editHistory.AddEntry(snapshot, constants.EditTypeInsert, constants.DefaultUndoHistoryLimit)
+	}
+*/
+func (shared *EditHistoryType[T]) IsContinuingTransaction(editType int) bool {
+	if editType == constants.EditTypeNone || editType == constants.EditTypeReplace {
+		return false
+	}
+	return editType == shared.LastEditType
+}
+
+/*
+AddEntry is a method which allows you to begin a new edit transaction by storing the snapshot taken before it. In
+addition, the following should be noted:
+
+- Any redo history is discarded, since it no longer follows from the current state.
+
+- If the number of undo entries exceeds the limit provided, the oldest entries are dropped.
+
+Example:
+
+	editHistory.AddEntry(snapshot, constants.EditTypeInsert, constants.DefaultUndoHistoryLimit)
+*/
+func (shared *EditHistoryType[T]) AddEntry(snapshot T, editType int, maxEntries int) {
+	shared.UndoEntries = append(shared.UndoEntries, snapshot)
+	if maxEntries > 0 && len(shared.UndoEntries) > maxEntries {
+		shared.UndoEntries = shared.UndoEntries[len(shared.UndoEntries)-maxEntries:]
+	}
+	shared.RedoEntries = nil
+	shared.LastEditType = editType
+}
+
+/*
+EndTransaction is a method which allows you to close the edit transaction currently open, so that the next edit is
+recorded separately even if it is of the same type.
+
+Example:
+
+	editHistory.EndTransaction()
+*/
+func (shared *EditHistoryType[T]) EndTransaction() {
+	shared.LastEditType = constants.EditTypeNone
+}
+
+/*
+Undo is a method which allows you to step back one edit transaction. In addition, the following should be noted:
+
+- The current snapshot provided is stored so that the transaction can be redone later.
+
+- If there is nothing to undo, false is returned and the current snapshot is returned unchanged.
+
+Example:
+
+	snapshot, isUndone := editHistory.Undo(currentSnapshot)
+*/
+func (shared *EditHistoryType[T]) Undo(currentSnapshot T) (T, bool) {
+	shared.EndTransaction()
+	if len(shared.UndoEntries) == 0 {
+		return currentSnapshot, false
+	}
+	snapshot := shared.UndoEntries[len(shared.UndoEntries)-1]
+	shared.UndoEntries = shared.UndoEntries[:len(shared.UndoEntries)-1]
+	shared.RedoEntries = append(shared.RedoEntries, currentSnapshot)
+	return snapshot, true
+}
+
+/*
+Redo is a method which allows you to reapply the last edit transaction which was undone. In addition, the following
+should be noted:
+
+- The current snapshot provided is stored so that the transaction can be undone again.
+
+- If there is nothing to redo, false is returned and the current snapshot is returned unchanged.
+
+Example:
+
+	snapshot, isRedone := editHistory.Redo(currentSnapshot)
+*/
+func (shared *EditHistoryType[T]) Redo(currentSnapshot T) (T, bool) {
+	shared.EndTransaction()
+	if len(shared.RedoEntries) == 0 {
+		return currentSnapshot, false
+	}
+	snapshot := shared.RedoEntries[len(shared.RedoEntries)-1]
+	shared.RedoEntries = shared.RedoEntries[:len(shared.RedoEntries)-1]
+	shared.UndoEntries = append(shared.UndoEntries, currentSnapshot)
+	return snapshot, true
+}
+
+/*
+Clear is a method which allows you to discard all undo and redo history.
+
+Example:
+
+	editHistory.Clear()
+*/
+func (shared *EditHistoryType[T]) Clear() {
+	shared.UndoEntries = nil
+	shared.RedoEntries = nil
+	shared.EndTransaction()
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/consolizer/constants"
+	"testing"
+)
+
+/*
+TestEditHistoryUndoRedo is a test which verifies that an edit history steps back and forth through its snapshots,
+groups edits of the same type, and respects its size limit.
+
+Example:
+
+	Expected Inputs:
+	    Snapshots added with various edit types and a limit of three entries.
+
+	Expected Outputs:
+	    Snapshots are returned in reverse order by undo and forward order by redo, and the oldest snapshots are
+	    dropped once the limit is exceeded.
+*/
+func TestEditHistoryUndoRedo(test *testing.T) {
+	editHistory := NewEditHistory[string]()
+	assert.Falsef(test, editHistory.IsContinuingTransaction(constants.EditTypeInsert), "An empty history should not continue a transaction!")
+	editHistory.AddEntry("a", constants.EditTypeInsert, 3)
+	assert.Truef(test, editHistory.IsContinuingTransaction(constants.EditTypeInsert), "Consecutive inserts should continue the same transaction!")
+	assert.Falsef(test, editHistory.IsContinuingTransaction(constants.EditTypeDelete), "A delete should not continue an insert transaction!")
+	editHistory.AddEntry("b", constants.EditTypeReplace, 3)
+	assert.Falsef(test, editHistory.IsContinuingTransaction(constants.EditTypeReplace), "Replacements should never continue a transaction!")
+	editHistory.AddEntry("c", constants.EditTypeDelete, 3)
+	editHistory.AddEntry("d", constants.EditTypeBackspace, 3)
+	assert.Equalf(test, []string{"b", "c", "d"}, editHistory.UndoEntries, "The oldest entry was not dropped when the limit was exceeded!")
+
+	snapshot, isUndone := editHistory.Undo("e")
+	assert.Equalf(test, "d", snapshot, "Undo did not return the most recent snapshot!")
+	assert.Truef(test, isUndone, "Undo did not report success!")
+	snapshot, _ = editHistory.Undo(snapshot)
+	assert.Equalf(test, "c", snapshot, "A second undo did not return the previous snapshot!")
+	snapshot, _ = editHistory.Redo(snapshot)
+	assert.Equalf(test, "d", snapshot, "Redo did not return the snapshot undone!")
+	snapshot, _ = editHistory.Redo(snapshot)
+	assert.Equalf(test, "e", snapshot, "A second redo did not return the original state!")
+	snapshot, isRedone := editHistory.Redo(snapshot)
+	assert.Equalf(test, "e", snapshot, "Redo changed the state when there was nothing to redo!")
+	assert.Falsef(test, isRedone, "Redo reported success when there was nothing to redo!")
+
+	editHistory.Undo(snapshot)
+	editHistory.AddEntry("f", constants.EditTypeInsert, 3)
+	assert.Emptyf(test, editHistory.RedoEntries, "A new edit did not discard the redo history!")
+	editHistory.Clear()
+	_, isUndone = editHistory.Undo("g")
+	assert.Falsef(test, isUndone, "Undo reported success after the history was cleared!")
+}
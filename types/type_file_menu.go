@@ -5,11 +5,14 @@ FileMenuEntryType is a structure which represents a file menu entry in memory. I
 
 - The file menu will be drawn at the specified location with the given style.
 
-- Each heading in the menu can have its own dropdown with selectable items.
+- Each heading in the menu has its own menu entry, whose items can open nested menus of their own.
 
 - The top level headings widths are always dynamic based on how large the heading is.
 
-- The file menu reuses existing selectors for dropdown functionality.
+  - An ampersand in a heading marks the letter after it as the one which opens the heading when pressed along with
+    the alt key.
+
+- The item last selected is kept until it is read, or cleared with Unselect.
 
 Example:
 
@@ -20,7 +23,7 @@ type FileMenuEntryType struct {
 	Alias              string
 	StyleEntry         TuiStyleEntryType
 	MenuHeadings       []string
-	MenuSelections     []SelectionEntryType
+	MenuEntries        []MenuEntryType
 	XLocation          int
 	YLocation          int
 	DynamicWidth       bool
@@ -28,8 +31,10 @@ type FileMenuEntryType struct {
 	ActiveHeadingIndex int
 	IsSubmenuOpen      bool
 	IsEnabled          bool
-	// Selectors for each menu heading
-	SelectorAliases []string
+	// The item last selected, along with the heading it was selected from
+	SelectedHeadingIndex int
+	SelectedItemIndex    int
+	SelectedItem         MenuItemEntryType
 	// Tooltip for the file menu
 	TooltipAlias string
 }
@@ -41,7 +46,7 @@ NewFileMenuEntry is a constructor which creates a new file menu entry. In additi
 
 - Used for managing file menus in the TUI.
 
-- Sets up arrays for selector aliases.
+- No item is selected initially.
 
 Example:
 
@@ -53,6 +58,7 @@ func NewFileMenuEntry() FileMenuEntryType {
 	fileMenuEntry.IsSubmenuOpen = false
 	fileMenuEntry.IsEnabled = true
 	fileMenuEntry.DynamicWidth = true
-	fileMenuEntry.SelectorAliases = []string{}
+	fileMenuEntry.SelectedHeadingIndex = -1
+	fileMenuEntry.SelectedItemIndex = -1
 	return fileMenuEntry
 }
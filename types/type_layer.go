@@ -33,21 +33,26 @@ Example:
 	var layerEntry LayerEntryType
 */
 type LayerEntryType struct {
-	Width            int
-	Height           int
-	ScreenXLocation  int
-	ScreenYLocation  int
-	CursorXLocation  int
-	CursorYLocation  int
-	ZOrder           int
-	IsTopmost        bool
-	IsFocusable      bool
-	IsVisible        bool
-	LayerAlias       string
-	ParentAlias      string
-	IsParent         bool
-	DefaultAttribute AttributeEntryType
-	CharacterMemory  [][]CharacterEntryType
+	Width              int
+	Height             int
+	ScreenXLocation    int
+	ScreenYLocation    int
+	CursorXLocation    int
+	CursorYLocation    int
+	ZOrder             int
+	IsTopmost          bool
+	IsFocusable        bool
+	IsFocusScope       bool
+	IsVisible          bool
+	LayerAlias         string
+	ParentAlias        string
+	IsParent           bool
+	AnchorStyle        int
+	AnchorRightMargin  int
+	AnchorBottomMargin int
+	DefaultAttribute   AttributeEntryType
+	CharacterMemory    [][]CharacterEntryType
+	DirtyRegion        *DirtyRegionEntryType
 }
 
 /*
@@ -59,37 +64,45 @@ Example:
 */
 func (shared LayerEntryType) MarshalJSON() ([]byte, error) {
 	j, err := json.Marshal(struct {
-		Width            int
-		Height           int
-		ScreenXLocation  int
-		ScreenYLocation  int
-		CursorXLocation  int
-		CursorYLocation  int
-		ZOrder           int
-		IsTopmost        bool
-		IsFocusable      bool
-		IsVisible        bool
-		LayerAlias       string
-		ParentAlias      string
-		IsParent         bool
-		DefaultAttribute AttributeEntryType
-		CharacterMemory  [][]CharacterEntryType
+		Width              int
+		Height             int
+		ScreenXLocation    int
+		ScreenYLocation    int
+		CursorXLocation    int
+		CursorYLocation    int
+		ZOrder             int
+		IsTopmost          bool
+		IsFocusable        bool
+		IsFocusScope       bool
+		IsVisible          bool
+		LayerAlias         string
+		ParentAlias        string
+		IsParent           bool
+		AnchorStyle        int
+		AnchorRightMargin  int
+		AnchorBottomMargin int
+		DefaultAttribute   AttributeEntryType
+		CharacterMemory    [][]CharacterEntryType
 	}{
-		Width:            shared.Width,
-		Height:           shared.Height,
-		ScreenXLocation:  shared.ScreenXLocation,
-		ScreenYLocation:  shared.ScreenYLocation,
-		CursorXLocation:  shared.CursorXLocation,
-		CursorYLocation:  shared.CursorYLocation,
-		ZOrder:           shared.ZOrder,
-		IsTopmost:        shared.IsTopmost,
-		IsFocusable:      shared.IsFocusable,
-		IsVisible:        shared.IsVisible,
-		LayerAlias:       shared.LayerAlias,
-		ParentAlias:      shared.ParentAlias,
-		IsParent:         shared.IsParent,
-		DefaultAttribute: shared.DefaultAttribute,
-		CharacterMemory:  shared.CharacterMemory,
+		Width:              shared.Width,
+		Height:             shared.Height,
+		ScreenXLocation:    shared.ScreenXLocation,
+		ScreenYLocation:    shared.ScreenYLocation,
+		CursorXLocation:    shared.CursorXLocation,
+		CursorYLocation:    shared.CursorYLocation,
+		ZOrder:             shared.ZOrder,
+		IsTopmost:          shared.IsTopmost,
+		IsFocusable:        shared.IsFocusable,
+		IsFocusScope:       shared.IsFocusScope,
+		IsVisible:          shared.IsVisible,
+		LayerAlias:         shared.LayerAlias,
+		ParentAlias:        shared.ParentAlias,
+		IsParent:           shared.IsParent,
+		AnchorStyle:        shared.AnchorStyle,
+		AnchorRightMargin:  shared.AnchorRightMargin,
+		AnchorBottomMargin: shared.AnchorBottomMargin,
+		DefaultAttribute:   shared.DefaultAttribute,
+		CharacterMemory:    shared.CharacterMemory,
 	})
 	if err != nil {
 		return nil, err
@@ -289,10 +302,16 @@ func NewLayerEntry(layerAlias string, parentAlias string, width int, height int,
 		layerEntry.IsVisible = existingLayerEntry[0].IsVisible
 		layerEntry.IsTopmost = existingLayerEntry[0].IsTopmost
 		layerEntry.IsFocusable = existingLayerEntry[0].IsFocusable
+		layerEntry.IsFocusScope = existingLayerEntry[0].IsFocusScope
 		layerEntry.LayerAlias = existingLayerEntry[0].LayerAlias
 		layerEntry.ParentAlias = existingLayerEntry[0].ParentAlias
 		layerEntry.IsParent = existingLayerEntry[0].IsParent
+		layerEntry.AnchorStyle = existingLayerEntry[0].AnchorStyle
+		layerEntry.AnchorRightMargin = existingLayerEntry[0].AnchorRightMargin
+		layerEntry.AnchorBottomMargin = existingLayerEntry[0].AnchorBottomMargin
 		layerEntry.DefaultAttribute = existingLayerEntry[0].DefaultAttribute
+		dirtyRegionEntry := NewDirtyRegionEntry(existingLayerEntry[0].DirtyRegion)
+		layerEntry.DirtyRegion = &dirtyRegionEntry
 		for currentRow := 0; currentRow < existingLayerEntry[0].Height; currentRow++ {
 			var characterObjectArray = make([]CharacterEntryType, existingLayerEntry[0].Width)
 			for currentCharacter := 0; currentCharacter < existingLayerEntry[0].Width; currentCharacter++ {
@@ -317,6 +336,8 @@ func NewLayerEntry(layerAlias string, parentAlias string, width int, height int,
 			}
 			layerEntry.CharacterMemory = append(layerEntry.CharacterMemory, characterObjectArray)
 		}
+		layerEntry.DirtyRegion = &DirtyRegionEntryType{}
+		layerEntry.MarkFullyDirty()
 	}
 	return layerEntry
 }
@@ -340,6 +361,74 @@ func InitializeCharacterMemory(layerEntry *LayerEntryType) {
 		}
 		layerEntry.CharacterMemory = append(layerEntry.CharacterMemory, characterObjectArray)
 	}
+	layerEntry.MarkFullyDirty()
+}
+
+/*
+MarkDirtyRegion is a method which allows you to record that an area of the layer has been modified, so that it will be
+redrawn the next time the display is updated. In addition, the following should be noted:
+
+- The area recorded is merged with any area previously marked as dirty, so repeated calls are inexpensive.
+
+- Areas which fall outside the layer are clipped to the layer bounds.
+
+Example:
+
+	layerEntry.MarkDirtyRegion(0, 0, 10, 1)
+*/
+func (shared *LayerEntryType) MarkDirtyRegion(xLocation int, yLocation int, width int, height int) {
+	dirtyRegion := DirtyRegionEntryType{XLocation: xLocation, YLocation: yLocation, Width: width, Height: height}
+	dirtyRegion = dirtyRegion.GetIntersection(DirtyRegionEntryType{Width: shared.Width, Height: shared.Height})
+	if dirtyRegion.IsEmpty() {
+		return
+	}
+	if shared.DirtyRegion == nil {
+		shared.DirtyRegion = &DirtyRegionEntryType{}
+	}
+	*shared.DirtyRegion = shared.DirtyRegion.GetUnion(dirtyRegion)
+}
+
+/*
+MarkFullyDirty is a method which allows you to record that the entire layer has been modified.
+
+Example:
+
+	layerEntry.MarkFullyDirty()
+*/
+func (shared *LayerEntryType) MarkFullyDirty() {
+	shared.MarkDirtyRegion(0, 0, shared.Width, shared.Height)
+}
+
+/*
+ClearDirtyRegion is a method which allows you to reset the dirty region of the layer, indicating that all of its
+modifications have been drawn.
+
+Example:
+
+	layerEntry.ClearDirtyRegion()
+*/
+func (shared *LayerEntryType) ClearDirtyRegion() {
+	if shared.DirtyRegion == nil {
+		shared.DirtyRegion = &DirtyRegionEntryType{}
+	}
+	*shared.DirtyRegion = DirtyRegionEntryType{}
+}
+
+/*
+GetDirtyRegion is a method which allows you to obtain the area of the layer which has been modified since the dirty
+region was last cleared. In addition, the following should be noted:
+
+- If nothing has been modified, an empty region is returned.
+
+Example:
+
+	dirtyRegion := layerEntry.GetDirtyRegion()
+*/
+func (shared *LayerEntryType) GetDirtyRegion() DirtyRegionEntryType {
+	if shared.DirtyRegion == nil {
+		return DirtyRegionEntryType{}
+	}
+	return *shared.DirtyRegion
 }
 
 /*
@@ -525,5 +614,6 @@ func (shared *LayerEntryType) LoadLayerFromBytes(data []byte) error {
 	}
 
 	shared.CharacterMemory = characterMemory
+	shared.MarkFullyDirty()
 	return nil
 }
@@ -0,0 +1,133 @@
+package types
+
+/*
+MenuItemEntryType is a structure which represents a single item of a menu. In addition, the following should be
+noted:
+
+  - An ampersand in the label marks the letter after it as the keyboard accelerator of the item. Use two ampersands
+    to show a literal one.
+
+  - An item with sub menu items opens a nested menu instead of being selected.
+
+- A separator is drawn as a line across the menu, and can never be highlighted or selected.
+
+Example:
+
+	menuItem := types.MenuItemEntryType{Alias: "copy", Label: "&Copy", ShortcutHint: "Ctrl+C"}
+*/
+type MenuItemEntryType struct {
+	Alias        string
+	Label        string
+	ShortcutHint string
+	IsSeparator  bool
+	IsDisabled   bool
+	IsCheckable  bool
+	IsChecked    bool
+	SubMenuItems []MenuItemEntryType
+}
+
+/*
+MenuEntryType is a structure which represents a list of menu items, such as the body of a context menu. In addition,
+the following should be noted:
+
+- The entry can be populated using the AddItem, AddCheckableItem, AddSeparator, and AddSubMenu methods.
+
+Example:
+
+	var menuEntry types.MenuEntryType
+*/
+type MenuEntryType struct {
+	Items []MenuItemEntryType
+}
+
+/*
+NewMenuEntry is a constructor which allows you to create a new menu entry with no items.
+
+Example:
+
+	menuEntry := types.NewMenuEntry()
+*/
+func NewMenuEntry() MenuEntryType {
+	var menuEntry MenuEntryType
+	return menuEntry
+}
+
+/*
+AddItem is a method which allows you to add a new item to the menu. The shortcut hint is drawn at the right of the
+item, and can be left empty.
+
+Example:
+
+	menuEntry.AddItem("copy", "&Copy", "Ctrl+C")
+*/
+func (shared *MenuEntryType) AddItem(itemAlias string, label string, shortcutHint string) {
+	shared.Items = append(shared.Items, MenuItemEntryType{Alias: itemAlias, Label: label, ShortcutHint: shortcutHint})
+}
+
+/*
+AddCheckableItem is a method which allows you to add a new item to the menu which shows a check mark while it is
+checked. Selecting the item toggles its check mark.
+
+Example:
+
+	menuEntry.AddCheckableItem("wordWrap", "&Word Wrap", "", true)
+*/
+func (shared *MenuEntryType) AddCheckableItem(itemAlias string, label string, shortcutHint string, isChecked bool) {
+	shared.Items = append(shared.Items, MenuItemEntryType{Alias: itemAlias, Label: label, ShortcutHint: shortcutHint, IsCheckable: true, IsChecked: isChecked})
+}
+
+/*
+AddSeparator is a method which allows you to add a line to the menu, separating the items above it from the items
+below it.
+
+Example:
+
+	menuEntry.AddSeparator()
+*/
+func (shared *MenuEntryType) AddSeparator() {
+	shared.Items = append(shared.Items, MenuItemEntryType{IsSeparator: true})
+}
+
+/*
+AddSubMenu is a method which allows you to add an item to the menu which opens a nested menu holding the items of
+the sub menu provided.
+
+Example:
+
+	menuEntry.AddSubMenu("&Sort By", sortMenuEntry)
+*/
+func (shared *MenuEntryType) AddSubMenu(label string, subMenuEntry MenuEntryType) {
+	shared.Items = append(shared.Items, MenuItemEntryType{Label: label, SubMenuItems: subMenuEntry.Items})
+}
+
+/*
+GetItem is a method which allows you to obtain an item of the menu, or of any of its nested menus, by its alias. If
+no item has the alias provided, nil is returned.
+
+Example:
+
+	menuEntry.GetItem("paste").IsDisabled = true
+*/
+func (shared *MenuEntryType) GetItem(itemAlias string) *MenuItemEntryType {
+	return getMenuItem(shared.Items, itemAlias)
+}
+
+/*
+getMenuItem is a method which allows you to search a list of menu items, and all of their nested menus, for the item
+with the alias provided. If no item has the alias provided, nil is returned.
+
+Example:
+
+	menuItem := getMenuItem(menuItems, "paste")
+*/
+func getMenuItem(menuItems []MenuItemEntryType, itemAlias string) *MenuItemEntryType {
+	for currentIndex := range menuItems {
+		if !menuItems[currentIndex].IsSeparator && menuItems[currentIndex].Alias == itemAlias {
+			return &menuItems[currentIndex]
+		}
+		if menuItem := getMenuItem(menuItems[currentIndex].SubMenuItems, itemAlias); menuItem != nil {
+			return menuItem
+		}
+	}
+	return nil
+}
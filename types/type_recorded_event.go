@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+RecordedEventEntryType is a structure which represents a single keyboard or mouse event captured during an event
+recording session.
+
+Example:
+
+	var recordedEvent types.RecordedEventEntryType
+*/
+type RecordedEventEntryType struct {
+	ElapsedTime int64
+	EventType   string
+	Key         int
+	Rune        rune
+	Modifiers   int
+	XLocation   int
+	YLocation   int
+	Buttons     int
+}
+
+/*
+MarshalJSON is a method which allows you to convert a recorded event entry to JSON format. In addition, the following
+should be noted:
+
+- Implements the json.Marshaler interface for RecordedEventEntryType.
+
+- Used for saving event recordings to disk.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared RecordedEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		ElapsedTime int64
+		EventType   string
+		Key         int
+		Rune        rune
+		Modifiers   int
+		XLocation   int
+		YLocation   int
+		Buttons     int
+	}{
+		ElapsedTime: shared.ElapsedTime,
+		EventType:   shared.EventType,
+		Key:         shared.Key,
+		Rune:        shared.Rune,
+		Modifiers:   shared.Modifiers,
+		XLocation:   shared.XLocation,
+		YLocation:   shared.YLocation,
+		Buttons:     shared.Buttons,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which allows you to get a JSON string representation of the recorded event entry.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared RecordedEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewRecordedEventEntry is a constructor which allows you to create a new recorded event entry. In addition, the
+following should be noted:
+
+- If an existing recorded event entry is provided, its values are copied into the new entry.
+
+Example:
+
+	recordedEvent := NewRecordedEventEntry(existingRecordedEvent)
+*/
+func NewRecordedEventEntry(existingRecordedEventEntry ...*RecordedEventEntryType) RecordedEventEntryType {
+	var recordedEventEntry RecordedEventEntryType
+	if existingRecordedEventEntry != nil {
+		recordedEventEntry.ElapsedTime = existingRecordedEventEntry[0].ElapsedTime
+		recordedEventEntry.EventType = existingRecordedEventEntry[0].EventType
+		recordedEventEntry.Key = existingRecordedEventEntry[0].Key
+		recordedEventEntry.Rune = existingRecordedEventEntry[0].Rune
+		recordedEventEntry.Modifiers = existingRecordedEventEntry[0].Modifiers
+		recordedEventEntry.XLocation = existingRecordedEventEntry[0].XLocation
+		recordedEventEntry.YLocation = existingRecordedEventEntry[0].YLocation
+		recordedEventEntry.Buttons = existingRecordedEventEntry[0].Buttons
+	}
+	return recordedEventEntry
+}
@@ -0,0 +1,23 @@
+package types
+
+/*
+ShortcutEntryType is a structure which describes a keyboard shortcut registered with the shortcut registry, such as
+when listing shortcuts on a help screen. In addition, the following should be noted:
+
+  - The chord is made up of one or more keystrokes separated by spaces, such as "ctrl+k ctrl+c". Each keystroke is
+    written the way the keyboard reports it, with its modifiers in the order shift, alt, meta, and ctrl.
+
+  - The scope is one of the constants.ShortcutScope constants. The layer alias is empty for global shortcuts, and the
+    control alias is empty for shortcuts which do not belong to a control.
+
+Example:
+
+	shortcutEntry := types.ShortcutEntryType{Chord: "ctrl+s", Description: "Save the file"}
+*/
+type ShortcutEntryType struct {
+	Chord        string
+	Description  string
+	Scope        int
+	LayerAlias   string
+	ControlAlias string
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+SliderEntryType is a structure which represents a slider control. In addition, the following should be noted:
+
+- In range mode, Value holds the lower end of the range and UpperValue holds the upper end.
+
+  - DragPosition holds the position of the handle being dragged with the mouse, so that small mouse movements
+    accumulate just as they do for a scroll bar handle.
+
+Example:
+
+	var slider types.SliderEntryType
+*/
+type SliderEntryType struct {
+	BaseControlType
+	Length           int
+	MinimumValue     int
+	MaximumValue     int
+	Value            int
+	UpperValue       int
+	Step             int
+	IsHorizontal     bool
+	IsRangeMode      bool
+	TickInterval     int
+	IsTickLabelShown bool
+	IsValueShown     bool
+	ActiveHandle     int
+	DragPosition     int
+}
+
+/*
+MarshalJSON is a method which serializes a slider control to JSON. In addition, the following should be noted:
+
+- It converts the slider's range, values, and display options to a JSON representation.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared SliderEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BaseControlType
+		Length           int
+		MinimumValue     int
+		MaximumValue     int
+		Value            int
+		UpperValue       int
+		Step             int
+		IsHorizontal     bool
+		IsRangeMode      bool
+		TickInterval     int
+		IsTickLabelShown bool
+		IsValueShown     bool
+		ActiveHandle     int
+		DragPosition     int
+	}{
+		BaseControlType:  shared.BaseControlType,
+		Length:           shared.Length,
+		MinimumValue:     shared.MinimumValue,
+		MaximumValue:     shared.MaximumValue,
+		Value:            shared.Value,
+		UpperValue:       shared.UpperValue,
+		Step:             shared.Step,
+		IsHorizontal:     shared.IsHorizontal,
+		IsRangeMode:      shared.IsRangeMode,
+		TickInterval:     shared.TickInterval,
+		IsTickLabelShown: shared.IsTickLabelShown,
+		IsValueShown:     shared.IsValueShown,
+		ActiveHandle:     shared.ActiveHandle,
+		DragPosition:     shared.DragPosition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which retrieves a JSON string representation of a slider control. In addition, the
+following should be noted:
+
+- It returns a formatted JSON string of the slider's state.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared SliderEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewSliderEntry is a constructor which creates a new slider control. In addition, the following should be noted:
+
+- It initializes a horizontal slider which counts from 0 to 100 in steps of 1.
+
+- It can optionally copy properties from an existing slider.
+
+Example:
+
+	NewSliderEntry(existingSliderEntry)
+*/
+func NewSliderEntry(existingSliderEntry ...*SliderEntryType) SliderEntryType {
+	var sliderEntry SliderEntryType
+	sliderEntry.BaseControlType = NewBaseControl()
+	sliderEntry.MaximumValue = 100
+	sliderEntry.Step = 1
+	sliderEntry.IsHorizontal = true
+
+	if existingSliderEntry != nil {
+		sliderEntry.BaseControlType = existingSliderEntry[0].BaseControlType
+		sliderEntry.Length = existingSliderEntry[0].Length
+		sliderEntry.MinimumValue = existingSliderEntry[0].MinimumValue
+		sliderEntry.MaximumValue = existingSliderEntry[0].MaximumValue
+		sliderEntry.Value = existingSliderEntry[0].Value
+		sliderEntry.UpperValue = existingSliderEntry[0].UpperValue
+		sliderEntry.Step = existingSliderEntry[0].Step
+		sliderEntry.IsHorizontal = existingSliderEntry[0].IsHorizontal
+		sliderEntry.IsRangeMode = existingSliderEntry[0].IsRangeMode
+		sliderEntry.TickInterval = existingSliderEntry[0].TickInterval
+		sliderEntry.IsTickLabelShown = existingSliderEntry[0].IsTickLabelShown
+		sliderEntry.IsValueShown = existingSliderEntry[0].IsValueShown
+		sliderEntry.ActiveHandle = existingSliderEntry[0].ActiveHandle
+		sliderEntry.DragPosition = existingSliderEntry[0].DragPosition
+	}
+	return sliderEntry
+}
+
+/*
+IsSliderEqual is a method which compares two slider controls for equality. In addition, the following should be
+noted:
+
+- It compares the base control properties, the range, the values, and the display options.
+
+Example:
+
+	IsSliderEqual(sourceSliderEntry, targetSliderEntry)
+*/
+func IsSliderEqual(sourceSliderEntry *SliderEntryType, targetSliderEntry *SliderEntryType) bool {
+	return sourceSliderEntry.BaseControlType.IsEqual(&targetSliderEntry.BaseControlType) &&
+		sourceSliderEntry.Length == targetSliderEntry.Length &&
+		sourceSliderEntry.MinimumValue == targetSliderEntry.MinimumValue &&
+		sourceSliderEntry.MaximumValue == targetSliderEntry.MaximumValue &&
+		sourceSliderEntry.Value == targetSliderEntry.Value &&
+		sourceSliderEntry.UpperValue == targetSliderEntry.UpperValue &&
+		sourceSliderEntry.Step == targetSliderEntry.Step &&
+		sourceSliderEntry.IsHorizontal == targetSliderEntry.IsHorizontal &&
+		sourceSliderEntry.IsRangeMode == targetSliderEntry.IsRangeMode &&
+		sourceSliderEntry.TickInterval == targetSliderEntry.TickInterval &&
+		sourceSliderEntry.IsTickLabelShown == targetSliderEntry.IsTickLabelShown &&
+		sourceSliderEntry.IsValueShown == targetSliderEntry.IsValueShown
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+SpinnerEntryType is a structure which represents a spinner control. In addition, the following should be noted:
+
+- The number being edited is held by a text field, whose alias is stored in TextFieldAlias.
+
+- A spinner with no decimal places only works with whole numbers.
+
+Example:
+
+	var spinner types.SpinnerEntryType
+*/
+type SpinnerEntryType struct {
+	BaseControlType
+	TextFieldAlias string
+	Value          float64
+	MinimumValue   float64
+	MaximumValue   float64
+	Step           float64
+	DecimalPlaces  int
+}
+
+/*
+MarshalJSON is a method which serializes a spinner control to JSON. In addition, the following should be noted:
+
+- It converts the spinner's range and current value to a JSON representation.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared SpinnerEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BaseControlType
+		TextFieldAlias string
+		Value          float64
+		MinimumValue   float64
+		MaximumValue   float64
+		Step           float64
+		DecimalPlaces  int
+	}{
+		BaseControlType: shared.BaseControlType,
+		TextFieldAlias:  shared.TextFieldAlias,
+		Value:           shared.Value,
+		MinimumValue:    shared.MinimumValue,
+		MaximumValue:    shared.MaximumValue,
+		Step:            shared.Step,
+		DecimalPlaces:   shared.DecimalPlaces,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which retrieves a JSON string representation of a spinner control. In addition, the
+following should be noted:
+
+- It returns a formatted JSON string of the spinner's state.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared SpinnerEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewSpinnerEntry is a constructor which creates a new spinner control. In addition, the following should be noted:
+
+- It initializes a spinner which counts from 0 to 100 in steps of 1.
+
+- It can optionally copy properties from an existing spinner.
+
+Example:
+
+	NewSpinnerEntry(existingSpinnerEntry)
+*/
+func NewSpinnerEntry(existingSpinnerEntry ...*SpinnerEntryType) SpinnerEntryType {
+	var spinnerEntry SpinnerEntryType
+	spinnerEntry.BaseControlType = NewBaseControl()
+	spinnerEntry.MaximumValue = 100
+	spinnerEntry.Step = 1
+
+	if existingSpinnerEntry != nil {
+		spinnerEntry.BaseControlType = existingSpinnerEntry[0].BaseControlType
+		spinnerEntry.TextFieldAlias = existingSpinnerEntry[0].TextFieldAlias
+		spinnerEntry.Value = existingSpinnerEntry[0].Value
+		spinnerEntry.MinimumValue = existingSpinnerEntry[0].MinimumValue
+		spinnerEntry.MaximumValue = existingSpinnerEntry[0].MaximumValue
+		spinnerEntry.Step = existingSpinnerEntry[0].Step
+		spinnerEntry.DecimalPlaces = existingSpinnerEntry[0].DecimalPlaces
+	}
+	return spinnerEntry
+}
+
+/*
+IsSpinnerEqual is a method which compares two spinner controls for equality. In addition, the following should be
+noted:
+
+- It compares the base control properties, the range, and the current value.
+
+Example:
+
+	IsSpinnerEqual(sourceSpinnerEntry, targetSpinnerEntry)
+*/
+func IsSpinnerEqual(sourceSpinnerEntry *SpinnerEntryType, targetSpinnerEntry *SpinnerEntryType) bool {
+	return sourceSpinnerEntry.BaseControlType.IsEqual(&targetSpinnerEntry.BaseControlType) &&
+		sourceSpinnerEntry.TextFieldAlias == targetSpinnerEntry.TextFieldAlias &&
+		sourceSpinnerEntry.Value == targetSpinnerEntry.Value &&
+		sourceSpinnerEntry.MinimumValue == targetSpinnerEntry.MinimumValue &&
+		sourceSpinnerEntry.MaximumValue == targetSpinnerEntry.MaximumValue &&
+		sourceSpinnerEntry.Step == targetSpinnerEntry.Step &&
+		sourceSpinnerEntry.DecimalPlaces == targetSpinnerEntry.DecimalPlaces
+}
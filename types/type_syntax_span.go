@@ -0,0 +1,34 @@
+package types
+
+/*
+SyntaxSpanType is a structure which represents a run of characters on a single line of text that should be drawn
+with different attributes, such as a keyword or a comment found by a syntax tokenizer. In addition, the following
+should be noted:
+
+  - Only the colors and text styles set on the attribute entry are applied. Colors left at their zero value keep the
+    color the control would normally use.
+
+Example:
+
+	var syntaxSpan types.SyntaxSpanType
+*/
+type SyntaxSpanType struct {
+	StartIndex     int
+	Length         int
+	AttributeEntry AttributeEntryType
+}
+
+/*
+NewSyntaxSpan is a constructor which allows you to create a new syntax span.
+
+Example:
+
+	syntaxSpan := NewSyntaxSpan(0, 4, attributeEntry)
+*/
+func NewSyntaxSpan(startIndex int, length int, attributeEntry AttributeEntryType) SyntaxSpanType {
+	var syntaxSpan SyntaxSpanType
+	syntaxSpan.StartIndex = startIndex
+	syntaxSpan.Length = length
+	syntaxSpan.AttributeEntry = attributeEntry
+	return syntaxSpan
+}
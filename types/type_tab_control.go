@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+TabEntryType is a structure which represents a single tab of a tab control, along with the layer which holds its
+page.
+
+Example:
+
+	tab := types.TabEntryType{Label: "General", LayerAlias: "generalPage"}
+*/
+type TabEntryType struct {
+	Label      string
+	LayerAlias string
+	IsClosable bool
+}
+
+/*
+TabControlEntryType is a structure which represents a tab control. In addition, the following should be noted:
+
+  - The first visible tab is the leftmost tab drawn in the tab strip. When there are more tabs than will fit, the
+    strip is scrolled so that the selected tab can always be seen.
+
+Example:
+
+	var tabControl types.TabControlEntryType
+*/
+type TabControlEntryType struct {
+	BaseControlType
+	Tabs            []TabEntryType
+	SelectedTab     int
+	FirstVisibleTab int
+}
+
+/*
+MarshalJSON is a method which serializes a tab control to JSON. In addition, the following should be noted:
+
+- It converts the tab control's tabs and selection to a JSON representation.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared TabControlEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BaseControlType
+		Tabs            []TabEntryType
+		SelectedTab     int
+		FirstVisibleTab int
+	}{
+		BaseControlType: shared.BaseControlType,
+		Tabs:            shared.Tabs,
+		SelectedTab:     shared.SelectedTab,
+		FirstVisibleTab: shared.FirstVisibleTab,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which retrieves a JSON string representation of a tab control. In addition, the
+following should be noted:
+
+- It returns a formatted JSON string of the tab control's state.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared TabControlEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewTabControlEntry is a constructor which creates a new tab control. In addition, the following should be noted:
+
+- It initializes a tab control with no tabs and no tab selected.
+
+- It can optionally copy properties from an existing tab control.
+
+Example:
+
+	NewTabControlEntry(existingTabControlEntry)
+*/
+func NewTabControlEntry(existingTabControlEntry ...*TabControlEntryType) TabControlEntryType {
+	var tabControlEntry TabControlEntryType
+	tabControlEntry.BaseControlType = NewBaseControl()
+	tabControlEntry.SelectedTab = -1
+
+	if existingTabControlEntry != nil {
+		tabControlEntry.BaseControlType = existingTabControlEntry[0].BaseControlType
+		tabControlEntry.Tabs = append([]TabEntryType{}, existingTabControlEntry[0].Tabs...)
+		tabControlEntry.SelectedTab = existingTabControlEntry[0].SelectedTab
+		tabControlEntry.FirstVisibleTab = existingTabControlEntry[0].FirstVisibleTab
+	}
+	return tabControlEntry
+}
+
+/*
+IsTabControlEqual is a method which compares two tab controls for equality. In addition, the following should be
+noted:
+
+- It compares the base control properties, the tabs, and which tab is selected.
+
+Example:
+
+	IsTabControlEqual(sourceTabControlEntry, targetTabControlEntry)
+*/
+func IsTabControlEqual(sourceTabControlEntry *TabControlEntryType, targetTabControlEntry *TabControlEntryType) bool {
+	if len(sourceTabControlEntry.Tabs) != len(targetTabControlEntry.Tabs) {
+		return false
+	}
+	for index := range sourceTabControlEntry.Tabs {
+		if sourceTabControlEntry.Tabs[index] != targetTabControlEntry.Tabs[index] {
+			return false
+		}
+	}
+	return sourceTabControlEntry.BaseControlType.IsEqual(&targetTabControlEntry.BaseControlType) &&
+		sourceTabControlEntry.SelectedTab == targetTabControlEntry.SelectedTab &&
+		sourceTabControlEntry.FirstVisibleTab == targetTabControlEntry.FirstVisibleTab
+}
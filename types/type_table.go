@@ -0,0 +1,166 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+TableColumnEntryType is a structure which represents a single column of a table. In addition, the following should
+be noted:
+
+  - The alignment controls how the values of the column and its header are placed within its width, and should be
+    one of constants.AlignmentLeft, constants.AlignmentRight, or constants.AlignmentCenter.
+
+Example:
+
+	column := types.TableColumnEntryType{Header: "Name", Width: 20, Alignment: constants.AlignmentLeft}
+*/
+type TableColumnEntryType struct {
+	Header    string
+	Width     int
+	Alignment int
+}
+
+/*
+TableEntryType is a structure which represents a table control. In addition, the following should be noted:
+
+  - The rows of a table are not stored here. They are read from the data source of the table as they are drawn.
+
+  - The selected row and the viewport are tracked by their position on screen, which differs from the row index of the
+    data source once the table has been sorted.
+
+Example:
+
+	var table types.TableEntryType
+*/
+type TableEntryType struct {
+	BaseControlType
+	Columns                  []TableColumnEntryType
+	VerticalScrollbarAlias   string
+	HorizontalScrollbarAlias string
+	ViewportRow              int
+	ViewportColumn           int
+	SelectedRow              int
+	FrozenRowCount           int
+	SortColumn               int
+	IsSortDescending         bool
+}
+
+/*
+MarshalJSON is a method which serializes a table control to JSON. In addition, the following should be noted:
+
+- It converts the table's columns, viewport, and sort state to a JSON representation.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared TableEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BaseControlType
+		Columns                  []TableColumnEntryType
+		VerticalScrollbarAlias   string
+		HorizontalScrollbarAlias string
+		ViewportRow              int
+		ViewportColumn           int
+		SelectedRow              int
+		FrozenRowCount           int
+		SortColumn               int
+		IsSortDescending         bool
+	}{
+		BaseControlType:          shared.BaseControlType,
+		Columns:                  shared.Columns,
+		VerticalScrollbarAlias:   shared.VerticalScrollbarAlias,
+		HorizontalScrollbarAlias: shared.HorizontalScrollbarAlias,
+		ViewportRow:              shared.ViewportRow,
+		ViewportColumn:           shared.ViewportColumn,
+		SelectedRow:              shared.SelectedRow,
+		FrozenRowCount:           shared.FrozenRowCount,
+		SortColumn:               shared.SortColumn,
+		IsSortDescending:         shared.IsSortDescending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which retrieves a JSON string representation of a table control. In addition, the
+following should be noted:
+
+- It returns a formatted JSON string of the table's state.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared TableEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewTableEntry is a constructor which creates a new table control. In addition, the following should be noted:
+
+- It initializes a table with no columns, no row selected, and no sort applied.
+
+- It can optionally copy properties from an existing table.
+
+Example:
+
+	NewTableEntry(existingTableEntry)
+*/
+func NewTableEntry(existingTableEntry ...*TableEntryType) TableEntryType {
+	var tableEntry TableEntryType
+	tableEntry.BaseControlType = NewBaseControl()
+	tableEntry.SelectedRow = -1
+	tableEntry.SortColumn = -1
+
+	if existingTableEntry != nil {
+		tableEntry.BaseControlType = existingTableEntry[0].BaseControlType
+		tableEntry.Columns = append([]TableColumnEntryType{}, existingTableEntry[0].Columns...)
+		tableEntry.VerticalScrollbarAlias = existingTableEntry[0].VerticalScrollbarAlias
+		tableEntry.HorizontalScrollbarAlias = existingTableEntry[0].HorizontalScrollbarAlias
+		tableEntry.ViewportRow = existingTableEntry[0].ViewportRow
+		tableEntry.ViewportColumn = existingTableEntry[0].ViewportColumn
+		tableEntry.SelectedRow = existingTableEntry[0].SelectedRow
+		tableEntry.FrozenRowCount = existingTableEntry[0].FrozenRowCount
+		tableEntry.SortColumn = existingTableEntry[0].SortColumn
+		tableEntry.IsSortDescending = existingTableEntry[0].IsSortDescending
+	}
+	return tableEntry
+}
+
+/*
+IsTableEqual is a method which compares two table controls for equality. In addition, the following should be
+noted:
+
+- It compares the base control properties, the columns, the viewport, the selected row, and the sort state.
+
+Example:
+
+	IsTableEqual(sourceTableEntry, targetTableEntry)
+*/
+func IsTableEqual(sourceTableEntry *TableEntryType, targetTableEntry *TableEntryType) bool {
+	if len(sourceTableEntry.Columns) != len(targetTableEntry.Columns) {
+		return false
+	}
+	for index := range sourceTableEntry.Columns {
+		if sourceTableEntry.Columns[index] != targetTableEntry.Columns[index] {
+			return false
+		}
+	}
+	return sourceTableEntry.BaseControlType.IsEqual(&targetTableEntry.BaseControlType) &&
+		sourceTableEntry.VerticalScrollbarAlias == targetTableEntry.VerticalScrollbarAlias &&
+		sourceTableEntry.HorizontalScrollbarAlias == targetTableEntry.HorizontalScrollbarAlias &&
+		sourceTableEntry.ViewportRow == targetTableEntry.ViewportRow &&
+		sourceTableEntry.ViewportColumn == targetTableEntry.ViewportColumn &&
+		sourceTableEntry.SelectedRow == targetTableEntry.SelectedRow &&
+		sourceTableEntry.FrozenRowCount == targetTableEntry.FrozenRowCount &&
+		sourceTableEntry.SortColumn == targetTableEntry.SortColumn &&
+		sourceTableEntry.IsSortDescending == targetTableEntry.IsSortDescending
+}
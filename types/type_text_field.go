@@ -27,6 +27,28 @@ type TextFieldEntryType struct {
 	HighlightEnd           int
 	IsHighlightActive      bool
 	IsHighlightModeToggled bool
+	// Undo and redo
+	EditHistory EditHistoryType[TextFieldSnapshotType]
+	// Input masks and validation
+	InputMask         []rune
+	CharacterFilter   int
+	ValidationMessage string
+	// Suggestions
+	SuggestionSelectorAlias string
+	IsSuggestionTrayOpen    bool
+}
+
+/*
+TextFieldSnapshotType is a structure which represents the value and cursor position of a text field at a point in
+its edit history.
+
+Example:
+
+	var snapshot types.TextFieldSnapshotType
+*/
+type TextFieldSnapshotType struct {
+	CurrentValue   []rune
+	CursorPosition int
 }
 
 /*
@@ -62,28 +84,38 @@ Example:
 func (shared TextFieldEntryType) MarshalJSON() ([]byte, error) {
 	j, err := json.Marshal(struct {
 		BaseControlType
-		MaxLengthAllowed       int
-		DefaultValue           string
-		CursorPosition         int
-		ViewportPosition       int
-		IsPasswordProtected    bool
-		CurrentValue           []rune
-		HighlightStart         int
-		HighlightEnd           int
-		IsHighlightActive      bool
-		IsHighlightModeToggled bool
+		MaxLengthAllowed        int
+		DefaultValue            string
+		CursorPosition          int
+		ViewportPosition        int
+		IsPasswordProtected     bool
+		CurrentValue            []rune
+		HighlightStart          int
+		HighlightEnd            int
+		IsHighlightActive       bool
+		IsHighlightModeToggled  bool
+		InputMask               string
+		CharacterFilter         int
+		ValidationMessage       string
+		SuggestionSelectorAlias string
+		IsSuggestionTrayOpen    bool
 	}{
-		BaseControlType:        shared.BaseControlType,
-		MaxLengthAllowed:       shared.MaxLengthAllowed,
-		DefaultValue:           shared.DefaultValue,
-		CursorPosition:         shared.CursorPosition,
-		ViewportPosition:       shared.ViewportPosition,
-		IsPasswordProtected:    shared.IsPasswordProtected,
-		CurrentValue:           shared.CurrentValue,
-		HighlightStart:         shared.HighlightStart,
-		HighlightEnd:           shared.HighlightEnd,
-		IsHighlightActive:      shared.IsHighlightActive,
-		IsHighlightModeToggled: shared.IsHighlightModeToggled,
+		BaseControlType:         shared.BaseControlType,
+		MaxLengthAllowed:        shared.MaxLengthAllowed,
+		DefaultValue:            shared.DefaultValue,
+		CursorPosition:          shared.CursorPosition,
+		ViewportPosition:        shared.ViewportPosition,
+		IsPasswordProtected:     shared.IsPasswordProtected,
+		CurrentValue:            shared.CurrentValue,
+		HighlightStart:          shared.HighlightStart,
+		HighlightEnd:            shared.HighlightEnd,
+		IsHighlightActive:       shared.IsHighlightActive,
+		IsHighlightModeToggled:  shared.IsHighlightModeToggled,
+		InputMask:               string(shared.InputMask),
+		CharacterFilter:         shared.CharacterFilter,
+		ValidationMessage:       shared.ValidationMessage,
+		SuggestionSelectorAlias: shared.SuggestionSelectorAlias,
+		IsSuggestionTrayOpen:    shared.IsSuggestionTrayOpen,
 	})
 	if err != nil {
 		return nil, err
@@ -128,6 +160,7 @@ Example:
 func NewTextFieldEntry(existingTextFieldEntry ...*TextFieldEntryType) TextFieldEntryType {
 	var textFieldEntry TextFieldEntryType
 	textFieldEntry.BaseControlType = NewBaseControl()
+	textFieldEntry.EditHistory = NewEditHistory[TextFieldSnapshotType]()
 
 	if existingTextFieldEntry != nil {
 		textFieldEntry.BaseControlType = existingTextFieldEntry[0].BaseControlType
@@ -137,6 +170,11 @@ func NewTextFieldEntry(existingTextFieldEntry ...*TextFieldEntryType) TextFieldE
 		textFieldEntry.ViewportPosition = existingTextFieldEntry[0].ViewportPosition
 		textFieldEntry.IsPasswordProtected = existingTextFieldEntry[0].IsPasswordProtected
 		textFieldEntry.CurrentValue = existingTextFieldEntry[0].CurrentValue
+		textFieldEntry.InputMask = existingTextFieldEntry[0].InputMask
+		textFieldEntry.CharacterFilter = existingTextFieldEntry[0].CharacterFilter
+		textFieldEntry.ValidationMessage = existingTextFieldEntry[0].ValidationMessage
+		textFieldEntry.SuggestionSelectorAlias = existingTextFieldEntry[0].SuggestionSelectorAlias
+		textFieldEntry.IsSuggestionTrayOpen = existingTextFieldEntry[0].IsSuggestionTrayOpen
 	}
 	textFieldEntry.CurrentValue = []rune{' '}
 	return textFieldEntry
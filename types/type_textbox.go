@@ -32,6 +32,30 @@ type TextboxEntryType struct {
 	IsWordWrapEnabled bool
 	// Auto-indent
 	IsAutoIndentEnabled bool
+	// Undo and redo
+	EditHistory   EditHistoryType[TextboxSnapshotType]
+	IsTextChanged bool
+	// Search
+	SearchText                string
+	IsSearchCaseSensitive     bool
+	IsSearchRegularExpression bool
+	// Line number gutter
+	IsLineNumbersEnabled bool
+	LineMarkers          map[int]int
+}
+
+/*
+TextboxSnapshotType is a structure which represents the text and cursor position of a textbox at a point in its
+edit history.
+
+Example:
+
+	var snapshot types.TextboxSnapshotType
+*/
+type TextboxSnapshotType struct {
+	TextData        [][]rune
+	CursorXLocation int
+	CursorYLocation int
 }
 
 /*
@@ -147,6 +171,8 @@ func NewTexboxEntry(existingTextboxEntry ...*TextboxEntryType) TextboxEntryType
 	// Initialize new fields with default values
 	textboxEntry.IsWordWrapEnabled = false
 	textboxEntry.IsAutoIndentEnabled = false
+	textboxEntry.EditHistory = NewEditHistory[TextboxSnapshotType]()
+	textboxEntry.LineMarkers = make(map[int]int)
 
 	if existingTextboxEntry != nil {
 		textboxEntry.BaseControlType = existingTextboxEntry[0].BaseControlType
@@ -166,6 +192,13 @@ func NewTexboxEntry(existingTextboxEntry ...*TextboxEntryType) TextboxEntryType
 
 		textboxEntry.IsWordWrapEnabled = existingTextboxEntry[0].IsWordWrapEnabled
 		textboxEntry.IsAutoIndentEnabled = existingTextboxEntry[0].IsAutoIndentEnabled
+		textboxEntry.SearchText = existingTextboxEntry[0].SearchText
+		textboxEntry.IsSearchCaseSensitive = existingTextboxEntry[0].IsSearchCaseSensitive
+		textboxEntry.IsSearchRegularExpression = existingTextboxEntry[0].IsSearchRegularExpression
+		textboxEntry.IsLineNumbersEnabled = existingTextboxEntry[0].IsLineNumbersEnabled
+		for lineIndex, markerType := range existingTextboxEntry[0].LineMarkers {
+			textboxEntry.LineMarkers[lineIndex] = markerType
+		}
 	}
 	return textboxEntry
 }
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+/*
+TreeNodeEntryType is a structure which represents a single node of a tree view. In addition, the following should be
+noted:
+
+  - A node which is expandable but not yet loaded has its children requested from the child provider of the tree view
+    the first time it is expanded.
+
+Example:
+
+	node := types.TreeNodeEntryType{Alias: "documents", Label: "Documents", IsExpandable: true}
+*/
+type TreeNodeEntryType struct {
+	Alias        string
+	Label        string
+	IsExpandable bool
+	IsExpanded   bool
+	IsLoaded     bool
+	Children     []*TreeNodeEntryType
+}
+
+/*
+TreeViewEntryType is a structure which represents a tree view control. In addition, the following should be noted:
+
+- Nodes are tracked by alias rather than by row, so that they keep their state as branches are expanded or collapsed.
+
+Example:
+
+	var treeView types.TreeViewEntryType
+*/
+type TreeViewEntryType struct {
+	BaseControlType
+	ScrollbarAlias       string
+	Nodes                []*TreeNodeEntryType
+	ViewportPosition     int
+	HighlightedNodeAlias string
+	SelectedNodeAlias    string
+}
+
+/*
+MarshalJSON is a method which serializes a tree view control to JSON. In addition, the following should be noted:
+
+- It converts the tree view's nodes and viewport state to a JSON representation.
+
+Example:
+
+	instance.MarshalJSON()
+*/
+func (shared TreeViewEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BaseControlType
+		ScrollbarAlias       string
+		Nodes                []*TreeNodeEntryType
+		ViewportPosition     int
+		HighlightedNodeAlias string
+		SelectedNodeAlias    string
+	}{
+		BaseControlType:      shared.BaseControlType,
+		ScrollbarAlias:       shared.ScrollbarAlias,
+		Nodes:                shared.Nodes,
+		ViewportPosition:     shared.ViewportPosition,
+		HighlightedNodeAlias: shared.HighlightedNodeAlias,
+		SelectedNodeAlias:    shared.SelectedNodeAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+/*
+GetEntryAsJsonDump is a method which retrieves a JSON string representation of a tree view control. In addition, the
+following should be noted:
+
+- It returns a formatted JSON string of the tree view's state.
+
+Example:
+
+	instance.GetEntryAsJsonDump()
+*/
+func (shared TreeViewEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+NewTreeViewEntry is a constructor which creates a new tree view control. In addition, the following should be noted:
+
+- It initializes a tree view with no nodes.
+
+- It can optionally copy properties from an existing tree view. The nodes themselves are shared, not copied.
+
+Example:
+
+	NewTreeViewEntry(existingTreeViewEntry)
+*/
+func NewTreeViewEntry(existingTreeViewEntry ...*TreeViewEntryType) TreeViewEntryType {
+	var treeViewEntry TreeViewEntryType
+	treeViewEntry.BaseControlType = NewBaseControl()
+
+	if existingTreeViewEntry != nil {
+		treeViewEntry.BaseControlType = existingTreeViewEntry[0].BaseControlType
+		treeViewEntry.ScrollbarAlias = existingTreeViewEntry[0].ScrollbarAlias
+		treeViewEntry.Nodes = existingTreeViewEntry[0].Nodes
+		treeViewEntry.ViewportPosition = existingTreeViewEntry[0].ViewportPosition
+		treeViewEntry.HighlightedNodeAlias = existingTreeViewEntry[0].HighlightedNodeAlias
+		treeViewEntry.SelectedNodeAlias = existingTreeViewEntry[0].SelectedNodeAlias
+	}
+	return treeViewEntry
+}
+
+/*
+IsTreeViewEqual is a method which compares two tree view controls for equality. In addition, the following should be
+noted:
+
+- It compares the base control properties, the viewport, and which nodes are highlighted and selected.
+
+Example:
+
+	IsTreeViewEqual(sourceTreeViewEntry, targetTreeViewEntry)
+*/
+func IsTreeViewEqual(sourceTreeViewEntry *TreeViewEntryType, targetTreeViewEntry *TreeViewEntryType) bool {
+	return sourceTreeViewEntry.BaseControlType.IsEqual(&targetTreeViewEntry.BaseControlType) &&
+		sourceTreeViewEntry.ScrollbarAlias == targetTreeViewEntry.ScrollbarAlias &&
+		len(sourceTreeViewEntry.Nodes) == len(targetTreeViewEntry.Nodes) &&
+		sourceTreeViewEntry.ViewportPosition == targetTreeViewEntry.ViewportPosition &&
+		sourceTreeViewEntry.HighlightedNodeAlias == targetTreeViewEntry.HighlightedNodeAlias &&
+		sourceTreeViewEntry.SelectedNodeAlias == targetTreeViewEntry.SelectedNodeAlias
+}
@@ -122,6 +122,9 @@ type TextFieldStyle struct {
 	HighlightBackgroundColor constants.ColorType
 	CursorForegroundColor    constants.ColorType
 	CursorBackgroundColor    constants.ColorType
+	// Validation
+	ErrorForegroundColor constants.ColorType
+	ErrorBackgroundColor constants.ColorType
 }
 
 /*
@@ -138,6 +141,23 @@ type TextboxStyle struct {
 	HighlightBackgroundColor constants.ColorType
 	CursorForegroundColor    constants.ColorType
 	CursorBackgroundColor    constants.ColorType
+	// Syntax highlighting
+	SyntaxKeywordColor  constants.ColorType
+	SyntaxStringColor   constants.ColorType
+	SyntaxNumberColor   constants.ColorType
+	SyntaxCommentColor  constants.ColorType
+	SyntaxKeyColor      constants.ColorType
+	SyntaxVariableColor constants.ColorType
+	// Line number gutter
+	GutterForegroundColor  constants.ColorType
+	GutterBackgroundColor  constants.ColorType
+	GutterCurrentLineColor constants.ColorType
+	ErrorMarkerColor       constants.ColorType
+	WarningMarkerColor     constants.ColorType
+	BookmarkMarkerColor    constants.ColorType
+	ErrorMarker            rune
+	WarningMarker          rune
+	BookmarkMarker         rune
 }
 
 /*
@@ -244,6 +264,90 @@ type DropdownStyle struct {
 	TextAlignment   int
 }
 
+/*
+SliderStyle is a structure which contains styles for sliders.
+
+Example:
+
+	var sliderStyle SliderStyle
+*/
+type SliderStyle struct {
+	TrackPattern    rune
+	FilledPattern   rune
+	Handle          rune
+	TickMark        rune
+	ForegroundColor constants.ColorType
+	BackgroundColor constants.ColorType
+	FilledColor     constants.ColorType
+	HandleColor     constants.ColorType
+}
+
+/*
+TreeViewStyle is a structure which contains styles for tree views. In addition, the following should be noted:
+
+  - Tree views are drawn in the colors of the selector style, and their indentation guides use the connector
+    characters of the frame style.
+
+Example:
+
+	var treeViewStyle TreeViewStyle
+*/
+type TreeViewStyle struct {
+	CollapsedGlyph rune
+	ExpandedGlyph  rune
+}
+
+/*
+TableStyle is a structure which contains styles for tables. In addition, the following should be noted:
+
+  - The rows of a table are drawn in the colors of the selector style, and its columns are separated by the vertical
+    line of the frame style.
+
+Example:
+
+	var tableStyle TableStyle
+*/
+type TableStyle struct {
+	HeaderForegroundColor constants.ColorType
+	HeaderBackgroundColor constants.ColorType
+	SortAscendingGlyph    rune
+	SortDescendingGlyph   rune
+}
+
+/*
+TabControlStyle is a structure which contains styles for tab controls. In addition, the following should be noted:
+
+- The tab strip and the border around the tab pages are drawn with the connector characters of the frame style.
+
+Example:
+
+	var tabControlStyle TabControlStyle
+*/
+type TabControlStyle struct {
+	ForegroundColor         constants.ColorType
+	BackgroundColor         constants.ColorType
+	SelectedForegroundColor constants.ColorType
+	SelectedBackgroundColor constants.ColorType
+	CloseButton             rune
+}
+
+/*
+MenuStyle is a structure which contains styles for menus, such as context menus. In addition, the following should be
+noted:
+
+  - Menus are drawn in the colors of the selector style, and their separators use the connector characters of the
+    frame style.
+
+Example:
+
+	var menuStyle MenuStyle
+*/
+type MenuStyle struct {
+	DisabledForegroundColor constants.ColorType
+	CheckMark               rune
+	SubMenuIndicator        rune
+}
+
 /*
 TextStyle is a structure which represents styles for text.
 
@@ -280,6 +384,11 @@ type TuiStyleEntryType struct {
 	Bar         BarStyle
 	FileMenu    FileMenuStyle
 	Dropdown    DropdownStyle
+	Slider      SliderStyle
+	TreeView    TreeViewStyle
+	Table       TableStyle
+	TabControl  TabControlStyle
+	Menu        MenuStyle
 }
 
 /*
@@ -364,11 +473,28 @@ func NewTuiStyleEntry(existingStyleEntry ...*TuiStyleEntryType) TuiStyleEntryTyp
 		styleEntry.TextField.BackgroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.TextField.CursorForegroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.TextField.CursorBackgroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.TextField.ErrorForegroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.TextField.ErrorBackgroundColor = constants.AnsiColorByIndex[1]
 
 		styleEntry.Textbox.ForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.Textbox.BackgroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.Textbox.CursorForegroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.Textbox.CursorBackgroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.Textbox.SyntaxKeywordColor = constants.AnsiColorByIndex[14]
+		styleEntry.Textbox.SyntaxStringColor = constants.AnsiColorByIndex[10]
+		styleEntry.Textbox.SyntaxNumberColor = constants.AnsiColorByIndex[13]
+		styleEntry.Textbox.SyntaxCommentColor = constants.AnsiColorByIndex[8]
+		styleEntry.Textbox.SyntaxKeyColor = constants.AnsiColorByIndex[11]
+		styleEntry.Textbox.SyntaxVariableColor = constants.AnsiColorByIndex[12]
+		styleEntry.Textbox.GutterForegroundColor = constants.AnsiColorByIndex[8]
+		styleEntry.Textbox.GutterBackgroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.Textbox.GutterCurrentLineColor = constants.AnsiColorByIndex[15]
+		styleEntry.Textbox.ErrorMarkerColor = constants.AnsiColorByIndex[9]
+		styleEntry.Textbox.WarningMarkerColor = constants.AnsiColorByIndex[11]
+		styleEntry.Textbox.BookmarkMarkerColor = constants.AnsiColorByIndex[12]
+		styleEntry.Textbox.ErrorMarker = 'E'
+		styleEntry.Textbox.WarningMarker = 'W'
+		styleEntry.Textbox.BookmarkMarker = constants.CharDot
 
 		styleEntry.Selector.ForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.Selector.BackgroundColor = constants.AnsiColorByIndex[0]
@@ -407,6 +533,33 @@ func NewTuiStyleEntry(existingStyleEntry ...*TuiStyleEntryType) TuiStyleEntryTyp
 		styleEntry.Dropdown.ForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.Dropdown.BackgroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.Dropdown.TextAlignment = constants.AlignmentLeft
+
+		styleEntry.Slider.TrackPattern = constants.CharBlockSparce
+		styleEntry.Slider.FilledPattern = constants.CharBlockMedium
+		styleEntry.Slider.Handle = constants.CharBlockSolid
+		styleEntry.Slider.TickMark = constants.CharDot
+		styleEntry.Slider.ForegroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.Slider.BackgroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.Slider.FilledColor = constants.AnsiColorByIndex[3]
+		styleEntry.Slider.HandleColor = constants.AnsiColorByIndex[15]
+
+		styleEntry.TreeView.CollapsedGlyph = constants.CharTriangleRight
+		styleEntry.TreeView.ExpandedGlyph = constants.CharTriangleDown
+
+		styleEntry.Table.HeaderForegroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.Table.HeaderBackgroundColor = constants.AnsiColorByIndex[7]
+		styleEntry.Table.SortAscendingGlyph = constants.CharTriangleUp
+		styleEntry.Table.SortDescendingGlyph = constants.CharTriangleDown
+
+		styleEntry.TabControl.ForegroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.TabControl.BackgroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.TabControl.SelectedForegroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.TabControl.SelectedBackgroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.TabControl.CloseButton = constants.CharMultiplicationSign
+
+		styleEntry.Menu.DisabledForegroundColor = constants.AnsiColorByIndex[8]
+		styleEntry.Menu.CheckMark = constants.CharCheckMark
+		styleEntry.Menu.SubMenuIndicator = constants.CharTriangleRight
 	}
 
 	return styleEntry
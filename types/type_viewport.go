@@ -24,6 +24,12 @@ type ViewportEntryType struct {
 	IsHistoryEnabled         bool // Whether to keep history beyond what's visible
 	IsTransparent            bool // Whether the viewport background is transparent
 	IsLinesWrapped           bool // Whether text is wrapped to fit the viewport width
+	// Selection positions. The start is where the selection was anchored, while the end follows the mouse.
+	SelectionStartX   int
+	SelectionStartY   int
+	SelectionEndX     int
+	SelectionEndY     int
+	IsSelectionActive bool
 }
 
 /*
@@ -56,6 +62,11 @@ func (shared ViewportEntryType) MarshalJSON() ([]byte, error) {
 		IsHistoryEnabled         bool
 		IsTransparent            bool
 		IsLinesWrapped           bool
+		SelectionStartX          int
+		SelectionStartY          int
+		SelectionEndX            int
+		SelectionEndY            int
+		IsSelectionActive        bool
 	}{
 		BaseControlType:          shared.BaseControlType,
 		HorizontalScrollbarAlias: shared.HorizontalScrollbarAlias,
@@ -67,6 +78,11 @@ func (shared ViewportEntryType) MarshalJSON() ([]byte, error) {
 		IsHistoryEnabled:         shared.IsHistoryEnabled,
 		IsTransparent:            shared.IsTransparent,
 		IsLinesWrapped:           shared.IsLinesWrapped,
+		SelectionStartX:          shared.SelectionStartX,
+		SelectionStartY:          shared.SelectionStartY,
+		SelectionEndX:            shared.SelectionEndX,
+		SelectionEndY:            shared.SelectionEndY,
+		IsSelectionActive:        shared.IsSelectionActive,
 	})
 	if err != nil {
 		return nil, err
@@ -115,6 +131,11 @@ func NewViewportEntry(existingViewportEntry ...*ViewportEntryType) ViewportEntry
 		viewportEntry.IsHistoryEnabled = existingViewportEntry[0].IsHistoryEnabled
 		viewportEntry.IsTransparent = existingViewportEntry[0].IsTransparent
 		viewportEntry.IsLinesWrapped = existingViewportEntry[0].IsLinesWrapped
+		viewportEntry.SelectionStartX = existingViewportEntry[0].SelectionStartX
+		viewportEntry.SelectionStartY = existingViewportEntry[0].SelectionStartY
+		viewportEntry.SelectionEndX = existingViewportEntry[0].SelectionEndX
+		viewportEntry.SelectionEndY = existingViewportEntry[0].SelectionEndY
+		viewportEntry.IsSelectionActive = existingViewportEntry[0].IsSelectionActive
 	}
 	return viewportEntry
 }
@@ -136,7 +157,12 @@ func IsViewportEntryEqual(sourceViewportEntry *ViewportEntryType, targetViewport
 		sourceViewportEntry.MaxHistoryLines == targetViewportEntry.MaxHistoryLines &&
 		sourceViewportEntry.IsHistoryEnabled == targetViewportEntry.IsHistoryEnabled &&
 		sourceViewportEntry.IsTransparent == targetViewportEntry.IsTransparent &&
-		sourceViewportEntry.IsLinesWrapped == targetViewportEntry.IsLinesWrapped
+		sourceViewportEntry.IsLinesWrapped == targetViewportEntry.IsLinesWrapped &&
+		sourceViewportEntry.SelectionStartX == targetViewportEntry.SelectionStartX &&
+		sourceViewportEntry.SelectionStartY == targetViewportEntry.SelectionStartY &&
+		sourceViewportEntry.SelectionEndX == targetViewportEntry.SelectionEndX &&
+		sourceViewportEntry.SelectionEndY == targetViewportEntry.SelectionEndY &&
+		sourceViewportEntry.IsSelectionActive == targetViewportEntry.IsSelectionActive
 }
 
 /*
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/supercom32/consolizer/constants"
 	"github.com/supercom32/consolizer/types"
+	"strings"
 )
 
 /*
@@ -19,6 +20,338 @@ func validateTextFieldWidth(width int) {
 	}
 }
 
+/*
+validateLineMarkerType is a method which allows you to validate that a specified textbox line marker type is one of
+the supported marker types.
+
+Example:
+
+	validateLineMarkerType(constants.LineMarkerError)
+*/
+func validateLineMarkerType(markerType int) {
+	if markerType < constants.LineMarkerNone || markerType > constants.LineMarkerBookmark {
+		safeSttyPanic(fmt.Sprintf("The specified line marker type '%d' is invalid.", markerType))
+	}
+}
+
+/*
+validateCharacterFilterType is a method which allows you to validate that a specified text field character filter is
+one of the supported filter types.
+
+Example:
+
+	validateCharacterFilterType(constants.CharacterFilterDigits)
+*/
+func validateCharacterFilterType(filterType int) {
+	if filterType < constants.CharacterFilterNone || filterType > constants.CharacterFilterDecimal {
+		safeSttyPanic(fmt.Sprintf("The specified character filter type '%d' is invalid.", filterType))
+	}
+}
+
+/*
+validateSuggestionTrayHeight is a method which allows you to validate that the suggestion tray of a text field is
+tall enough to show at least one suggestion.
+
+Example:
+
+	validateSuggestionTrayHeight(5)
+*/
+func validateSuggestionTrayHeight(trayHeight int) {
+	if trayHeight <= 0 {
+		safeSttyPanic(fmt.Sprintf("The specified suggestion tray height '%d' is invalid.", trayHeight))
+	}
+}
+
+/*
+validateSpinnerWidth is a method which allows you to validate that a spinner is wide enough to show its arrows along
+with at least one digit.
+
+Example:
+
+	validateSpinnerWidth(10)
+*/
+func validateSpinnerWidth(width int) {
+	if width < 3 {
+		safeSttyPanic(fmt.Sprintf("The specified spinner width '%d' is invalid.", width))
+	}
+}
+
+/*
+validateSpinnerRange is a method which allows you to validate that the range of a spinner is usable. The minimum value
+must not be greater than the maximum value, the step must be greater than 0, and the number of decimal places must not
+be negative.
+
+Example:
+
+	validateSpinnerRange(0, 100, 1, 0)
+*/
+func validateSpinnerRange(minimumValue float64, maximumValue float64, step float64, decimalPlaces int) {
+	if minimumValue > maximumValue {
+		safeSttyPanic(fmt.Sprintf("The specified spinner minimum value '%v' is greater than its maximum value '%v'.", minimumValue, maximumValue))
+	}
+	if step <= 0 {
+		safeSttyPanic(fmt.Sprintf("The specified spinner step '%v' is invalid.", step))
+	}
+	if decimalPlaces < 0 {
+		safeSttyPanic(fmt.Sprintf("The specified number of spinner decimal places '%d' is invalid.", decimalPlaces))
+	}
+}
+
+/*
+validateSliderLength is a method which allows you to validate that a slider is long enough to have a track for its
+handle to move along.
+
+Example:
+
+	validateSliderLength(20)
+*/
+func validateSliderLength(length int) {
+	if length < 2 {
+		safeSttyPanic(fmt.Sprintf("The specified slider length '%d' is invalid.", length))
+	}
+}
+
+/*
+validateSliderRange is a method which allows you to validate that the range of a slider is usable. The minimum value
+must be less than the maximum value, and the step must be greater than 0.
+
+Example:
+
+	validateSliderRange(0, 100, 5)
+*/
+func validateSliderRange(minimumValue int, maximumValue int, step int) {
+	if minimumValue >= maximumValue {
+		safeSttyPanic(fmt.Sprintf("The specified slider minimum value '%d' is not less than its maximum value '%d'.", minimumValue, maximumValue))
+	}
+	if step <= 0 {
+		safeSttyPanic(fmt.Sprintf("The specified slider step '%d' is invalid.", step))
+	}
+}
+
+/*
+validateTreeViewSize is a method which allows you to validate that a tree view is large enough to show at least one
+column of text, and tall enough for its scroll bar to be drawn.
+
+Example:
+
+	validateTreeViewSize(30, 10)
+*/
+func validateTreeViewSize(width int, height int) {
+	if width < 1 || height < 3 {
+		safeSttyPanic(fmt.Sprintf("The specified tree view size '%dx%d' is invalid.", width, height))
+	}
+}
+
+/*
+validateTreeNodeAlias is a method which allows you to validate that no node of a tree view already uses a given
+alias.
+
+Example:
+
+	validateTreeNodeAlias(treeViewEntry, "documents")
+*/
+func validateTreeNodeAlias(treeViewEntry *types.TreeViewEntryType, nodeAlias string) {
+	if node, _ := TreeView.findNode(treeViewEntry.Nodes, nil, nodeAlias); node != nil {
+		safeSttyPanic(fmt.Sprintf("The specified tree node alias '%s' is already in use.", nodeAlias))
+	}
+}
+
+/*
+validateTreeNodeParent is a method which allows you to validate that the parent given for a new tree view node
+exists. A parent alias of "" refers to the top level of the tree, and is always valid.
+
+Example:
+
+	validateTreeNodeParent(treeViewEntry, "documents")
+*/
+func validateTreeNodeParent(treeViewEntry *types.TreeViewEntryType, parentAlias string) {
+	if parentAlias == "" {
+		return
+	}
+	if node, _ := TreeView.findNode(treeViewEntry.Nodes, nil, parentAlias); node == nil {
+		safeSttyPanic(fmt.Sprintf("The specified parent tree node '%s' does not exist.", parentAlias))
+	}
+}
+
+/*
+validateTableSize is a method which allows you to validate that a table is large enough for its header, at least one
+row, and both of its scroll bars to be drawn.
+
+Example:
+
+	validateTableSize(40, 10)
+*/
+func validateTableSize(width int, height int) {
+	if width < 3 || height < 3 {
+		safeSttyPanic(fmt.Sprintf("The specified table size '%dx%d' is invalid.", width, height))
+	}
+}
+
+/*
+validateTableColumns is a method which allows you to validate that a table has at least one column, and that every
+column has a usable width and a supported alignment.
+
+Example:
+
+	validateTableColumns(columns)
+*/
+func validateTableColumns(columns []types.TableColumnEntryType) {
+	if len(columns) == 0 {
+		safeSttyPanic(fmt.Sprintf("A table must have at least one column."))
+	}
+	for _, column := range columns {
+		if column.Width < 1 {
+			safeSttyPanic(fmt.Sprintf("The specified width '%d' of table column '%s' is invalid.", column.Width, column.Header))
+		}
+		if column.Alignment != constants.AlignmentLeft && column.Alignment != constants.AlignmentRight && column.Alignment != constants.AlignmentCenter {
+			safeSttyPanic(fmt.Sprintf("The specified alignment '%d' of table column '%s' is invalid.", column.Alignment, column.Header))
+		}
+	}
+}
+
+/*
+validateTableColumnIndex is a method which allows you to validate that a column index refers to a column of a table.
+
+Example:
+
+	validateTableColumnIndex(tableEntry, 2)
+*/
+func validateTableColumnIndex(tableEntry *types.TableEntryType, columnIndex int) {
+	if columnIndex < 0 || columnIndex >= len(tableEntry.Columns) {
+		safeSttyPanic(fmt.Sprintf("The specified table column '%d' does not exist.", columnIndex))
+	}
+}
+
+/*
+validateTableFrozenRows is a method which allows you to validate that the number of frozen rows of a table still
+leaves at least one line below its header for the rest of its rows to scroll through.
+
+Example:
+
+	validateTableFrozenRows(tableEntry, 1)
+*/
+func validateTableFrozenRows(tableEntry *types.TableEntryType, numberOfRows int) {
+	if numberOfRows < 0 || numberOfRows >= tableEntry.Height-1 {
+		safeSttyPanic(fmt.Sprintf("The specified number of frozen table rows '%d' is invalid.", numberOfRows))
+	}
+}
+
+/*
+validateTabControlSize is a method which allows you to validate that a tab control is large enough for its tab strip,
+its border, and a page inside it.
+
+Example:
+
+	validateTabControlSize(60, 20)
+*/
+func validateTabControlSize(width int, height int) {
+	if width < 4 || height < 5 {
+		safeSttyPanic(fmt.Sprintf("The specified tab control size '%dx%d' is invalid.", width, height))
+	}
+}
+
+/*
+validateTabIndex is a method which allows you to validate that a tab index refers to a tab of a tab control.
+
+Example:
+
+	validateTabIndex(tabControlEntry, 2)
+*/
+func validateTabIndex(tabControlEntry *types.TabControlEntryType, tabIndex int) {
+	if tabIndex < 0 || tabIndex >= len(tabControlEntry.Tabs) {
+		safeSttyPanic(fmt.Sprintf("The specified tab '%d' does not exist.", tabIndex))
+	}
+}
+
+/*
+validateMenuItems is a method which allows you to validate that every item of a menu, and of its nested menus, can
+be drawn and selected. Items which are not separators must have a label.
+
+Example:
+
+	validateMenuItems(menuEntry.Items)
+*/
+func validateMenuItems(menuItems []types.MenuItemEntryType) {
+	for _, menuItem := range menuItems {
+		if !menuItem.IsSeparator && strings.TrimSpace(menuItem.Label) == "" {
+			safeSttyPanic("A menu item must have a label.")
+		}
+		validateMenuItems(menuItem.SubMenuItems)
+	}
+}
+
+/*
+validateFileMenuEntries is a method which allows you to validate that a file menu has exactly one menu entry for each
+of its headings, and that the items of every menu entry can be drawn and selected.
+
+Example:
+
+	validateFileMenuEntries(menuHeadings, menuEntries)
+*/
+func validateFileMenuEntries(menuHeadings []string, menuEntries []types.MenuEntryType) {
+	if len(menuHeadings) != len(menuEntries) {
+		safeSttyPanic(fmt.Sprintf("The file menu has '%d' headings, but '%d' menu entries were specified.", len(menuHeadings), len(menuEntries)))
+	}
+	for _, menuEntry := range menuEntries {
+		validateMenuItems(menuEntry.Items)
+	}
+}
+
+/*
+validateTabOrder is a method which allows you to validate that a tab order is not negative.
+
+Example:
+
+	validateTabOrder(1)
+*/
+func validateTabOrder(tabOrder int) {
+	if tabOrder < 0 {
+		safeSttyPanic(fmt.Sprintf("The tab order '%d' is invalid since it must not be negative.", tabOrder))
+	}
+}
+
+/*
+validateModalDialogNotInEventHandler is a method which allows you to validate that a dialog which waits for the user
+is not opened while an event is being dispatched, since the dialog could never receive the events needed to close it.
+
+Example:
+
+	validateModalDialogNotInEventHandler()
+*/
+func validateModalDialogNotInEventHandler() {
+	if eventStateMemory.dispatchDepth > 0 {
+		safeSttyPanic("A dialog which waits for the user can not be opened from an event handler. Use ShowMessageBox, ShowConfirm, or ShowInputPrompt instead.")
+	}
+}
+
+/*
+validateShortcutChord is a method which allows you to validate that the chord of a keyboard shortcut has at least one
+keystroke.
+
+Example:
+
+	validateShortcutChord("Ctrl+S", []string{"ctrl+s"})
+*/
+func validateShortcutChord(chord string, keystrokes []string) {
+	if len(keystrokes) == 0 {
+		safeSttyPanic(fmt.Sprintf("The shortcut chord '%s' is invalid since it has no keystrokes.", chord))
+	}
+}
+
+/*
+validateShortcutNotConflicting is a method which allows you to validate that a keyboard shortcut does not conflict
+with another shortcut of the same scope, since the two could not be told apart while being pressed.
+
+Example:
+
+	validateShortcutNotConflicting("ctrl+k", "ctrl+k ctrl+c", true)
+*/
+func validateShortcutNotConflicting(chord string, existingChord string, isConflicting bool) {
+	if isConflicting {
+		safeSttyPanic(fmt.Sprintf("The shortcut chord '%s' conflicts with the existing shortcut chord '%s'.", chord, existingChord))
+	}
+}
+
 /*
 validateLayerLocationByLayerAlias is a method which allows you to validate that a specific coordinate location is within
 the bounds of a layer, identified by its alias.
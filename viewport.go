@@ -5,6 +5,7 @@ import (
 	"github.com/supercom32/consolizer/memory"
 	"github.com/supercom32/consolizer/stringformat"
 	"math"
+	"strings"
 
 	"github.com/supercom32/consolizer/types"
 )
@@ -123,6 +124,7 @@ func (shared *ViewportInstanceType) SetContent(text string) *ViewportInstanceTyp
 	viewportEntry.TextData = [][]rune{}
 	viewportEntry.ViewportXLocation = 0
 	viewportEntry.ViewportYLocation = 0
+	viewportEntry.IsSelectionActive = false
 
 	// Process and add the new text.
 	viewport.printText(viewportEntry, text, false)
@@ -243,6 +245,7 @@ func (shared *ViewportInstanceType) Clear() *ViewportInstanceType {
 	viewportEntry.TextData = [][]rune{}
 	viewportEntry.ViewportXLocation = 0
 	viewportEntry.ViewportYLocation = 0
+	viewportEntry.IsSelectionActive = false
 
 	// Update scrollbars
 	viewport.setViewportMaxScrollBarValues(shared.layerAlias, shared.controlAlias)
@@ -268,6 +271,37 @@ func (shared *ViewportInstanceType) SetTransparent(isTransparent bool) *Viewport
 	return shared
 }
 
+/*
+GetSelectedText is a method which allows you to obtain the text currently selected in a viewport, without any of the
+markup codes used to color it. If no text is selected, or the viewport instance no longer exists, then an empty string
+is returned.
+
+Example:
+
+	selectedText := vp.GetSelectedText()
+*/
+func (shared *ViewportInstanceType) GetSelectedText() string {
+	if !Viewports.IsExists(shared.layerAlias, shared.controlAlias) {
+		return ""
+	}
+	return viewport.getSelectedText(Viewports.Get(shared.layerAlias, shared.controlAlias))
+}
+
+/*
+Copy is a method which allows you to copy the text selected in a viewport to the clipboard, just as if the user had
+pressed ctrl+c. If no text is selected, or the viewport instance no longer exists, then no operation takes place.
+
+Example:
+
+	vp.Copy()
+*/
+func (shared *ViewportInstanceType) Copy() *ViewportInstanceType {
+	if Viewports.IsExists(shared.layerAlias, shared.controlAlias) {
+		viewport.updateKeyboardEventManually(shared.layerAlias, shared.controlAlias, []rune("ctrl+c"))
+	}
+	return shared
+}
+
 /*
 printText is a method which allows you to process and add text to the viewport.
 
@@ -373,6 +407,13 @@ Example:
 */
 func (shared *viewportType) trimHistory(viewportEntry *types.ViewportEntryType) {
 	if viewportEntry.IsHistoryEnabled && len(viewportEntry.TextData) > viewportEntry.MaxHistoryLines {
+		// Move any selection up along with the lines it covers, dropping it once they are gone.
+		numberOfLinesRemoved := len(viewportEntry.TextData) - viewportEntry.MaxHistoryLines
+		viewportEntry.SelectionStartY -= numberOfLinesRemoved
+		viewportEntry.SelectionEndY -= numberOfLinesRemoved
+		if viewportEntry.SelectionStartY < 0 || viewportEntry.SelectionEndY < 0 {
+			viewportEntry.IsSelectionActive = false
+		}
 		// Keep only the most recent MaxHistoryLines
 		viewportEntry.TextData = viewportEntry.TextData[len(viewportEntry.TextData)-viewportEntry.MaxHistoryLines:]
 		// Adjust viewport position
@@ -633,6 +674,42 @@ func (shared *viewportType) setViewportMaxScrollBarValues(layerAlias string, vie
 	}
 }
 
+/*
+updateScrollbarBounds is a method which allows you to place the scroll bars of a viewport inside its bottom and right
+edges, based on its current position and size. In addition, the following should be noted:
+
+- If a border is drawn, the scroll bars are placed inside it.
+
+  - The lengths set here are used while only one scroll bar is shown, and are shortened when the viewport is drawn
+    with both of them.
+
+Example:
+
+	viewport.updateScrollbarBounds("main", "myVP")
+*/
+func (shared *viewportType) updateScrollbarBounds(layerAlias string, viewportAlias string) {
+	viewportEntry := GetViewport(layerAlias, viewportAlias)
+	if viewportEntry == nil {
+		return
+	}
+	borderWidth := 0
+	if viewportEntry.IsBorderDrawn {
+		borderWidth = 1
+	}
+	if viewportEntry.HorizontalScrollbarAlias != "" && ScrollBars.IsExists(layerAlias, viewportEntry.HorizontalScrollbarAlias) {
+		hScrollbarEntry := ScrollBars.Get(layerAlias, viewportEntry.HorizontalScrollbarAlias)
+		hScrollbarEntry.XLocation = viewportEntry.XLocation + borderWidth
+		hScrollbarEntry.YLocation = viewportEntry.YLocation + viewportEntry.Height - 1 - borderWidth
+		hScrollbarEntry.Length = viewportEntry.Width - borderWidth*2
+	}
+	if viewportEntry.VerticalScrollbarAlias != "" && ScrollBars.IsExists(layerAlias, viewportEntry.VerticalScrollbarAlias) {
+		vScrollbarEntry := ScrollBars.Get(layerAlias, viewportEntry.VerticalScrollbarAlias)
+		vScrollbarEntry.XLocation = viewportEntry.XLocation + viewportEntry.Width - 1 - borderWidth
+		vScrollbarEntry.YLocation = viewportEntry.YLocation + borderWidth
+		vScrollbarEntry.Length = viewportEntry.Height - borderWidth*2
+	}
+}
+
 /*
 Add is a method which allows you to add a new viewport to the specified layer.
 
@@ -663,22 +740,8 @@ func (shared *viewportType) Add(layerAlias string, viewportAlias string, styleEn
 	horizontalScrollbarAlias := viewportAlias + "_hscroll"
 	scrollbarStyleEntry := styleEntry
 
-	// Calculate scrollbar positions and lengths
-	// For horizontal scrollbar - position it inside the frame at the bottom
-	scrollbarXLocation := xLocation
-	if isBorderDrawn {
-		scrollbarXLocation++
-	}
-	scrollbarYLocation := yLocation + height - 1
-	if isBorderDrawn {
-		scrollbarYLocation--
-	}
-	horizontalScrollbarLength := width
-	if isBorderDrawn {
-		horizontalScrollbarLength -= 2
-	}
-	// Add horizontal scrollbar
-	scrollbar.Add(layerAlias, horizontalScrollbarAlias, scrollbarStyleEntry, scrollbarXLocation, scrollbarYLocation, horizontalScrollbarLength, 0, 0, 1, true)
+	// Add horizontal scrollbar, which is positioned inside the frame at the bottom once both scrollbars exist
+	scrollbar.Add(layerAlias, horizontalScrollbarAlias, scrollbarStyleEntry, 0, 0, 0, 0, 0, 1, true)
 	viewportEntry.HorizontalScrollbarAlias = horizontalScrollbarAlias
 
 	// Set parent control information for horizontal scrollbar
@@ -688,23 +751,9 @@ func (shared *viewportType) Add(layerAlias string, viewportAlias string, styleEn
 		hScrollbarEntry.ParentControlType = constants.CellTypeTextbox // Viewports use textbox cell type
 	}
 
-	// For vertical scrollbar - position it inside the frame on the right
+	// For vertical scrollbar - positioned inside the frame on the right
 	verticalScrollbarAlias := viewportAlias + "_vscroll"
-
-	scrollbarXLocation = xLocation + width - 1
-	if isBorderDrawn {
-		scrollbarXLocation--
-	}
-	scrollbarYLocation = yLocation
-	if isBorderDrawn {
-		scrollbarYLocation++
-	}
-	verticalScrollbarLength := height
-	if isBorderDrawn {
-		verticalScrollbarLength -= 2
-	}
-	// Add vertical scrollbar
-	scrollbar.Add(layerAlias, verticalScrollbarAlias, scrollbarStyleEntry, scrollbarXLocation, scrollbarYLocation, verticalScrollbarLength, 0, 0, 1, false)
+	scrollbar.Add(layerAlias, verticalScrollbarAlias, scrollbarStyleEntry, 0, 0, 0, 0, 0, 1, false)
 	viewportEntry.VerticalScrollbarAlias = verticalScrollbarAlias
 
 	// Set parent control information for vertical scrollbar
@@ -713,6 +762,7 @@ func (shared *viewportType) Add(layerAlias string, viewportAlias string, styleEn
 		vScrollbarEntry.ParentControlAlias = viewportAlias
 		vScrollbarEntry.ParentControlType = constants.CellTypeTextbox // Viewports use textbox cell type
 	}
+	shared.updateScrollbarBounds(layerAlias, viewportAlias)
 
 	// Set up the viewport instance
 	viewportInstance.layerAlias = layerAlias
@@ -885,14 +935,20 @@ func (shared *viewportType) drawContent(layerEntry *types.LayerEntryType, viewpo
 	if !viewportEntry.IsTransparent {
 		// Fill the background with the style's background color
 		for y := 0; y < contentHeight; y++ {
+			// Blank cells record the line they belong to, so that clicking past the end of a line selects up to it.
+			fillAttributeEntry := localAttributeEntry
+			fillAttributeEntry.CellControlLocation = y + viewportEntry.ViewportYLocation
 			for x := 0; x < contentWidth; x++ {
 				// Create a space character with the background color
 				spaceChar := []rune{' '}
-				layer.printLayer(layerEntry, localAttributeEntry, contentXLocation+x, contentYLocation+y, spaceChar)
+				layer.printLayer(layerEntry, fillAttributeEntry, contentXLocation+x, contentYLocation+y, spaceChar)
 			}
 		}
 	}
 
+	// Text selected with the mouse is only shown while the viewport is focused, the same as a textbox.
+	isSelectionShown := viewportEntry.IsSelectionActive && isControlCurrentlyFocused(layerEntry.LayerAlias, viewportAlias, constants.CellTypeTextbox)
+
 	// Draw each line of text in the viewport
 	for y := 0; y < contentHeight; y++ {
 		textDataY := y + viewportEntry.ViewportYLocation
@@ -931,7 +987,14 @@ func (shared *viewportType) drawContent(layerEntry *types.LayerEntryType, viewpo
 					}
 
 					// Print the character with the current attribute entry
-					layer.printLayer(layerEntry, currentAttributeEntry, cursorXLocation, contentYLocation+y, []rune{line[currentCharacterIndex]})
+					printAttributeEntry := currentAttributeEntry
+					printAttributeEntry.CellControlId = currentCharacterIndex
+					printAttributeEntry.CellControlLocation = textDataY
+					if isSelectionShown && isTextLocationSelected(currentCharacterIndex, textDataY, viewportEntry.SelectionStartX, viewportEntry.SelectionStartY, viewportEntry.SelectionEndX, viewportEntry.SelectionEndY) {
+						printAttributeEntry.ForegroundColor = styleEntry.Textbox.HighlightForegroundColor
+						printAttributeEntry.BackgroundColor = styleEntry.Textbox.HighlightBackgroundColor
+					}
+					layer.printLayer(layerEntry, printAttributeEntry, cursorXLocation, contentYLocation+y, []rune{line[currentCharacterIndex]})
 					cursorXLocation++
 				}
 			}
@@ -1052,11 +1115,27 @@ Example:
 func (shared *viewportType) updateMouseEvent() bool {
 	// Handle mouse wheel events for scrolling
 	isScreenUpdateRequired := false
-	mouseXLocation, mouseYLocation, _, wheelState := GetMouseStatus()
+	mouseXLocation, mouseYLocation, buttonPressed, wheelState := GetMouseStatus()
 
 	// Check for scrollbar events and update viewports
 	isScreenUpdateRequired = shared.checkScrollbarEvents() || isScreenUpdateRequired
 
+	// Select text when the left mouse button is pressed over a viewport, or dragged after being pressed over one.
+	if buttonPressed == constants.MouseButtonLeft &&
+		eventStateMemory.stateId != constants.EventStateDragAndDropScrollbar &&
+		eventStateMemory.stateId != constants.EventStateDragAndDrop {
+		characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
+		layerAlias := characterEntry.LayerAlias
+		viewportAlias := characterEntry.AttributeEntry.CellControlAlias
+		if characterEntry.AttributeEntry.CellType == constants.CellTypeTextbox && Viewports.IsExists(layerAlias, viewportAlias) {
+			viewportEntry := Viewports.Get(layerAlias, viewportAlias)
+			if shared.updateMouseSelection(layerAlias, viewportAlias, viewportEntry, characterEntry.AttributeEntry.CellControlId, characterEntry.AttributeEntry.CellControlLocation) {
+				setFocusedControl(layerAlias, viewportAlias, constants.CellTypeTextbox)
+				isScreenUpdateRequired = true
+			}
+		}
+	}
+
 	if wheelState != "" {
 		// Find the viewport under the mouse cursor
 		characterEntry := getCellInformationUnderMouseCursor(mouseXLocation, mouseYLocation)
@@ -1112,6 +1191,158 @@ func (shared *viewportType) updateMouseEvent() bool {
 	return isScreenUpdateRequired
 }
 
+/*
+updateMouseSelection is a method which allows you to select text in a viewport with the left mouse button. In
+addition, the following should be noted:
+
+- Dragging selects the text passed over, double clicking selects a word, and triple clicking selects a line.
+
+- Clicking while shift is held down selects from the location last clicked to the location clicked.
+
+- Locations past the end of a line or past the last line select up to the end of the text.
+
+Example:
+
+	isUpdated := viewport.updateMouseSelection("layer1", "viewport1", entry, 4, 2)
+*/
+func (shared *viewportType) updateMouseSelection(layerAlias string, viewportAlias string, viewportEntry *types.ViewportEntryType, xLocation int, yLocation int) bool {
+	if len(viewportEntry.TextData) == 0 {
+		return false
+	}
+	if yLocation < 0 || yLocation > len(viewportEntry.TextData)-1 {
+		yLocation = len(viewportEntry.TextData) - 1
+	}
+	if xLocation == constants.NullCellControlId || xLocation > len(viewportEntry.TextData[yLocation])-1 {
+		xLocation = len(viewportEntry.TextData[yLocation]) - 1
+	}
+	if xLocation < 0 {
+		xLocation = 0
+	}
+	_, _, previousButtonPressed, _ := GetPreviousMouseStatus()
+	if previousButtonPressed == 0 {
+		if IsShiftPressed() {
+			extendMouseSelection(layerAlias, viewportAlias, viewportEntry.SelectionStartX, viewportEntry.SelectionStartY)
+		} else {
+			startMouseSelection(layerAlias, viewportAlias, viewportEntry.TextData, xLocation, yLocation, GetMouseClickCount())
+		}
+	} else if !isMouseSelectionInProgress(layerAlias, viewportAlias) {
+		return false
+	}
+	selectionStartX, selectionStartY, selectionEndX, selectionEndY, isSelectionActive := getMouseSelection(viewportEntry.TextData, xLocation, yLocation)
+	viewportEntry.SelectionStartX = selectionStartX
+	viewportEntry.SelectionStartY = selectionStartY
+	viewportEntry.SelectionEndX = selectionEndX
+	viewportEntry.SelectionEndY = selectionEndY
+	viewportEntry.IsSelectionActive = isSelectionActive
+	return true
+}
+
+/*
+getSelectedText is a method which allows you to obtain the text selected in a viewport. In addition, the following
+should be noted:
+
+- Markup codes are removed, so that only the text shown to the user is returned.
+
+- Each line selected is separated by a new line.
+
+Example:
+
+	selectedText := viewport.getSelectedText(entry)
+*/
+func (shared *viewportType) getSelectedText(viewportEntry *types.ViewportEntryType) string {
+	if !viewportEntry.IsSelectionActive {
+		return ""
+	}
+	startX, startY, endX, endY := viewportEntry.SelectionStartX, viewportEntry.SelectionStartY, viewportEntry.SelectionEndX, viewportEntry.SelectionEndY
+	if isTextLocationBefore(endX, endY, startX, startY) {
+		startX, startY, endX, endY = endX, endY, startX, startY
+	}
+	var result strings.Builder
+	for currentLine := startY; currentLine <= endY && currentLine < len(viewportEntry.TextData); currentLine++ {
+		if currentLine < 0 {
+			continue
+		}
+		line := viewportEntry.TextData[currentLine]
+		lineStartX := 0
+		lineEndX := len(line) - 1
+		if currentLine == startY {
+			lineStartX = startX
+		}
+		if currentLine == endY && endX < lineEndX {
+			lineEndX = endX
+		}
+		if currentLine > startY {
+			result.WriteString("\n")
+		}
+		result.WriteString(shared.getTextWithoutMarkup(line, lineStartX, lineEndX))
+	}
+	return result.String()
+}
+
+/*
+getTextWithoutMarkup is a method which allows you to obtain part of a line of viewport text with its markup codes
+removed. Both the start and end characters are included.
+
+Example:
+
+	text := viewport.getTextWithoutMarkup(line, 0, 10)
+*/
+func (shared *viewportType) getTextWithoutMarkup(line []rune, startX int, endX int) string {
+	var result []rune
+	lineString := string(line)
+	for currentCharacterIndex := 0; currentCharacterIndex <= endX && currentCharacterIndex < len(line); currentCharacterIndex++ {
+		if currentCharacterIndex+1 < len(line) && line[currentCharacterIndex] == '{' && line[currentCharacterIndex+1] == '{' {
+			attributeTag := getAttributeTag(lineString, currentCharacterIndex)
+			if attributeTag != "" {
+				currentCharacterIndex += len(attributeTag) - 1
+				continue
+			}
+		}
+		if currentCharacterIndex >= startX {
+			result = append(result, line[currentCharacterIndex])
+		}
+	}
+	return string(result)
+}
+
+/*
+updateKeyboardEvent is a method which allows you to handle keystrokes for the viewport currently focused, so that the
+text selected in it can be copied.
+
+Example:
+
+	isUpdated, isConsumed := viewport.updateKeyboardEvent([]rune("ctrl+c"))
+*/
+func (shared *viewportType) updateKeyboardEvent(keystroke []rune) (bool, bool) {
+	focusedLayerAlias := eventStateMemory.currentlyFocusedControl.layerAlias
+	focusedControlAlias := eventStateMemory.currentlyFocusedControl.controlAlias
+	focusedControlType := eventStateMemory.currentlyFocusedControl.controlType
+	if focusedControlType != constants.CellTypeTextbox || !Viewports.IsExists(focusedLayerAlias, focusedControlAlias) {
+		return false, false
+	}
+	return shared.updateKeyboardEventManually(focusedLayerAlias, focusedControlAlias, keystroke)
+}
+
+/*
+updateKeyboardEventManually is a method which allows you to process a keystroke for a specific viewport. Copying with
+ctrl+c or ctrl+insert places the text selected on the clipboard.
+
+Example:
+
+	isUpdated, isConsumed := viewport.updateKeyboardEventManually("layer1", "viewport1", []rune("ctrl+c"))
+*/
+func (shared *viewportType) updateKeyboardEventManually(layerAlias string, viewportAlias string, keystroke []rune) (bool, bool) {
+	viewportEntry := Viewports.Get(layerAlias, viewportAlias)
+	switch string(keystroke) {
+	case "ctrl+c", "ctrl+insert":
+		if viewportEntry.IsSelectionActive {
+			setClipboardText(shared.getSelectedText(viewportEntry))
+		}
+		return true, true
+	}
+	return false, false
+}
+
 /*
 checkScrollbarEvents is a method which allows you to check for scrollbar events and update all viewports accordingly.
 